@@ -0,0 +1,421 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util"
+)
+
+// AddAnnotation creates a new annotation
+func (ra *RestApi) AddAnnotation(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewAnnotation object in the request body.
+	var n rest.NewAnnotation
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	timeseries, things, ok := parseAnnotationLinks(w, n.Timeseries, n.Things)
+	if ok == false {
+		return
+	} else if len(timeseries)+len(things) == 0 {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("timeseries or things is required")))
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	if ok := ra.checkAnnotationLinks(w, r, db, timeseries, things); ok == false {
+		return
+	}
+
+	if ok := ra.checkAnnotationAccess(w, r, db, "update", timeseries, things); ok == false {
+		return
+	}
+
+	u := services.NewUserService(db)
+	createdBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	params := services.AddAnnotationParams{
+		Timeseries: timeseries,
+		Things:     things,
+		Start:      n.Start,
+		End:        n.Start,
+		Text:       n.Text,
+		CreatedBy:  createdBy,
+	}
+
+	if n.End != nil {
+		params.End = *n.End
+	}
+
+	if n.Tags != nil {
+		params.Tags = *n.Tags
+	}
+
+	svc := services.NewAnnotationService(db)
+	annotation, err := svc.AddAnnotation(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(annotation)
+}
+
+// FindAnnotations lists the annotations of time series and things within a time range
+func (ra *RestApi) FindAnnotations(w http.ResponseWriter, r *http.Request, p rest.FindAnnotationsParams) {
+	timeseries, things, ok := parseAnnotationLinks(w, p.Timeseries, p.Things)
+	if ok == false {
+		return
+	} else if len(timeseries)+len(things) == 0 {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("timeseries or things is required")))
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	if ok := ra.checkAnnotationAccess(w, r, db, "read", timeseries, things); ok == false {
+		return
+	}
+
+	params := services.FindAllAnnotationsParams{
+		Timeseries: timeseries,
+		Things:     things,
+		Start:      time.Time(p.Start),
+		End:        time.Time(p.End),
+	}
+
+	if p.Tags != nil {
+		params.Tags = *p.Tags
+	}
+
+	if p.Limit != nil {
+		params.ArgLimit = int64(*p.Limit)
+	} else {
+		params.ArgLimit = 20
+	}
+
+	if p.Offset != nil {
+		params.ArgOffset = int64(*p.Offset)
+	}
+
+	svc := services.NewAnnotationService(db)
+	annotations, err := svc.FindAll(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(annotations)
+}
+
+// FindAnnotationByUuid returns a specific annotation by its UUID
+func (ra *RestApi) FindAnnotationByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	annotationUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	annotation, ok := ra.findAnnotation(w, r, db, annotationUUID)
+	if ok == false {
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Read access to any of the time series or things grants access to the annotation
+	timeseries, things := annotationLinks(annotation)
+	policySvc := services.NewPolicyCheckService(db)
+	for _, res := range services.AnnotationResources(timeseries, things) {
+		ok, err := policySvc.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "read", res)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		} else if ok {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(annotation)
+			return
+		}
+	}
+
+	ie.SendHTTPError(w, ie.ErrorForbidden)
+}
+
+// UpdateAnnotationByUuid updates a specific annotation by its UUID
+func (ra *RestApi) UpdateAnnotationByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	annotationUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a UpdateAnnotation object in the request body.
+	var upd rest.UpdateAnnotation
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	timeseries, things, ok := parseAnnotationLinks(w, upd.Timeseries, upd.Things)
+	if ok == false {
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	annotation, ok := ra.findAnnotation(w, r, db, annotationUUID)
+	if ok == false {
+		return
+	}
+
+	// The annotation must keep at least one time series or thing, like when it is created
+	currentTimeseries, currentThings := annotationLinks(annotation)
+	remaining := len(timeseries) + len(things)
+	if upd.Timeseries == nil {
+		remaining += len(currentTimeseries)
+	}
+	if upd.Things == nil {
+		remaining += len(currentThings)
+	}
+	if remaining == 0 {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("timeseries or things is required")))
+		return
+	}
+
+	if ok := ra.checkAnnotationLinks(w, r, db, timeseries, things); ok == false {
+		return
+	}
+
+	// The user must be allowed to change the annotation both where it is and where it is moved to
+	allTimeseries := append(currentTimeseries, timeseries...)
+	allThings := append(currentThings, things...)
+	if ok := ra.checkAnnotationAccess(w, r, db, "update", allTimeseries, allThings); ok == false {
+		return
+	}
+
+	params := services.UpdateAnnotationByUuidParams{
+		Start: upd.Start,
+		End:   upd.End,
+		Text:  upd.Text,
+		Tags:  upd.Tags,
+	}
+
+	if upd.Timeseries != nil {
+		params.Timeseries = &timeseries
+	}
+
+	if upd.Things != nil {
+		params.Things = &things
+	}
+
+	svc := services.NewAnnotationService(db)
+	count, err := svc.UpdateAnnotationByUuid(r.Context(), annotationUUID, params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteAnnotationByUuid deletes a specific annotation by its UUID
+func (ra *RestApi) DeleteAnnotationByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	annotationUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	annotation, ok := ra.findAnnotation(w, r, db, annotationUUID)
+	if ok == false {
+		return
+	}
+
+	timeseries, things := annotationLinks(annotation)
+	if ok := ra.checkAnnotationAccess(w, r, db, "update", timeseries, things); ok == false {
+		return
+	}
+
+	svc := services.NewAnnotationService(db)
+	count, err := svc.DeleteAnnotation(r.Context(), annotationUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findAnnotation returns an annotation, or responds with not found when there is no such annotation
+func (ra *RestApi) findAnnotation(w http.ResponseWriter, r *http.Request, db *sql.DB, id uuid.UUID) (*rest.Annotation, bool) {
+	svc := services.NewAnnotationService(db)
+
+	found, err := svc.Exists(r.Context(), id)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return nil, false
+	} else if found == false {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return nil, false
+	}
+
+	annotation, err := svc.FindAnnotationByUuid(r.Context(), id)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return nil, false
+	}
+
+	return annotation, true
+}
+
+// checkAnnotationLinks ensures that all time series and things to link to an annotation exist
+func (ra *RestApi) checkAnnotationLinks(w http.ResponseWriter, r *http.Request, db *sql.DB, timeseries, things []uuid.UUID) bool {
+	tsSvc := services.NewTimeseriesService(db)
+	for _, id := range timeseries {
+		ok, err := tsSvc.Exists(r.Context(), id)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return false
+		} else if ok == false {
+			ie.SendHTTPError(w, ie.ErrorNotFound)
+			return false
+		}
+	}
+
+	thingSvc := services.NewThingService(db)
+	for _, id := range things {
+		ok, err := thingSvc.Exists(r.Context(), id)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return false
+		} else if ok == false {
+			ie.SendHTTPError(w, ie.ErrorNotFound)
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkAnnotationAccess ensures that the user has access to every one of the time series and things
+func (ra *RestApi) checkAnnotationAccess(w http.ResponseWriter, r *http.Request, db *sql.DB, action string, timeseries, things []uuid.UUID) bool {
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return false
+	}
+
+	// An annotation without time series or things is out of reach
+	resources := services.AnnotationResources(timeseries, things)
+	if len(resources) == 0 {
+		ie.SendHTTPError(w, ie.ErrorForbidden)
+		return false
+	}
+
+	policySvc := services.NewPolicyCheckService(db)
+	ok, err := policySvc.UserHasManyAccessViaToken(r.Context(), []byte(domaintoken.Token), action, resources)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return false
+	} else if ok == false {
+		// Access denied to one or more requested resources
+		ie.SendHTTPError(w, ie.ErrorForbidden)
+		return false
+	}
+
+	return true
+}
+
+// parseAnnotationLinks parses the time series and things of an annotation given in a request
+func parseAnnotationLinks(w http.ResponseWriter, timeseries, things *[]string) ([]uuid.UUID, []uuid.UUID, bool) {
+	var tsList, thingList []uuid.UUID
+
+	if timeseries != nil {
+		list, err := util.StringSliceToUuidSlice(*timeseries)
+		if err != nil {
+			ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("timeseries has invalid format")))
+			return nil, nil, false
+		}
+		tsList = list
+	}
+
+	if things != nil {
+		list, err := util.StringSliceToUuidSlice(*things)
+		if err != nil {
+			ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("things has invalid format")))
+			return nil, nil, false
+		}
+		thingList = list
+	}
+
+	return tsList, thingList, true
+}
+
+// annotationLinks returns the time series and things of a stored annotation
+func annotationLinks(annotation *rest.Annotation) ([]uuid.UUID, []uuid.UUID) {
+	// Both were UUIDs in the database
+	timeseries, _ := util.StringSliceToUuidSlice(annotation.Timeseries)
+	things, _ := util.StringSliceToUuidSlice(annotation.Things)
+	return timeseries, things
+}
@@ -11,7 +11,9 @@ package aapije
 import (
 	"database/sql"
 	"errors"
+	"mime"
 	"net/http"
+	"strings"
 )
 
 // Error struct
@@ -36,3 +38,14 @@ func (ra *RestApi) GetDB(r *http.Request) (*sql.DB, error) {
 	}
 	return db, nil
 }
+
+// Accepts reports if the request lists mediaType in its Accept header
+func (ra *RestApi) Accepts(r *http.Request, mediaType string) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mt, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mt == mediaType {
+			return true
+		}
+	}
+	return false
+}
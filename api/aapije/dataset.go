@@ -143,8 +143,14 @@ func (ra *RestApi) FindDatasetByUuid(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
 	svc := services.NewDatasetService(db)
-	datasets, err := svc.FindDatasetByUuid(r.Context(), datasetUUID)
+	datasets, err := svc.FindDatasetByUuid(r.Context(), []byte(domaintoken.Token), datasetUUID)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
 		return
@@ -225,8 +231,14 @@ func (ra *RestApi) GetRawDatasetByUuid(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
 	svc := services.NewDatasetService(db)
-	f, err := svc.GetDatasetContentByUuid(r.Context(), datasetUUID)
+	f, err := svc.GetDatasetContentByUuid(r.Context(), []byte(domaintoken.Token), datasetUUID)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
 		return
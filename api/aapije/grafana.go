@@ -0,0 +1,185 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// GrafanaTestConnection lets Grafana test the connection of a datasource
+func (ra *RestApi) GrafanaTestConnection(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// GrafanaSearch finds the time series to offer in the Grafana query editor
+func (ra *RestApi) GrafanaSearch(w http.ResponseWriter, r *http.Request) {
+	var req rest.GrafanaSearchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	text := ""
+	if req.Target != nil {
+		text = *req.Target
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+	result, err := svc.SearchGrafana(r.Context(), []byte(domaintoken.Token), text)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+// GrafanaQuery queries the time series of the targets of a Grafana panel for data
+func (ra *RestApi) GrafanaQuery(w http.ResponseWriter, r *http.Request) {
+	// Allow max of 1 MB read from body
+	r.Body = http.MaxBytesReader(w, r.Body, 1048576)
+
+	var req rest.GrafanaQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	if req.Range.To.Sub(req.Range.From) > 31622401*time.Second {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("start to end range exceeds limit")))
+		return
+	}
+
+	targets := make([]services.GrafanaTarget, 0, len(req.Targets))
+	list := make([]string, 0, len(req.Targets))
+	for _, t := range req.Targets {
+		if t.Hide != nil && *t.Hide {
+			continue
+		}
+
+		id, err := uuid.Parse(t.Target)
+		if err != nil {
+			ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("target %v is not the uuid of a timeseries", t.Target)))
+			return
+		}
+
+		target := services.GrafanaTarget{
+			RefId:     t.RefId,
+			Uuid:      id,
+			Aggregate: "avg",
+		}
+		if t.Payload != nil {
+			if t.Payload.Aggregate != nil {
+				target.Aggregate = *t.Payload.Aggregate
+			}
+			target.Unit = t.Payload.Unit
+		}
+
+		targets = append(targets, target)
+		list = append(list, t.Target)
+	}
+
+	if len(targets) == 0 {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode([]*rest.GrafanaTimeSeries{})
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	// Same checks as a query listing uuids
+	if _, ok := ra.checkTsQueryUuids(w, r, svc, services.NewPolicyCheckService(db), []byte(domaintoken.Token), list); ok == false {
+		return
+	}
+
+	result, err := svc.QueryGrafana(r.Context(), services.QueryGrafanaParams{
+		Targets:   targets,
+		Start:     req.Range.From,
+		End:       req.Range.To,
+		Precision: services.GrafanaPrecision(req.IntervalMs, req.MaxDataPoints, req.Range.From, req.Range.To),
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+// GrafanaAnnotations returns the alerts within the range of a Grafana dashboard as annotations
+func (ra *RestApi) GrafanaAnnotations(w http.ResponseWriter, r *http.Request) {
+	var req rest.GrafanaAnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	query := ""
+	if req.Annotation != nil && req.Annotation.Query != nil {
+		query = *req.Annotation.Query
+	}
+
+	params, err := services.ParseGrafanaAnnotationQuery(query, req.Range.From, req.Range.To)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewAlertService(db)
+	alerts, err := svc.FindByTimeRange(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	result := make([]*rest.GrafanaAnnotation, 0, len(alerts))
+	for _, alert := range alerts {
+		result = append(result, services.NewGrafanaAnnotation(alert))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/snappy"
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/prompb"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// readSnappyBody reads a snappy-compressed request body, as sent by Prometheus
+func readSnappyBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	// Allow max of 5 MB read from body
+	compressed, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 5242880))
+	if err != nil {
+		return nil, err
+	}
+
+	// Limit the size after decompression as well
+	n, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, err
+	} else if n > 52428800 {
+		return nil, fmt.Errorf("decoded body is too large")
+	}
+
+	return snappy.Decode(nil, compressed)
+}
+
+// PrometheusRemoteWrite adds the samples of a Prometheus remote write request
+func (ra *RestApi) PrometheusRemoteWrite(w http.ResponseWriter, r *http.Request, p rest.PrometheusRemoteWriteParams) {
+	buf, err := readSnappyBody(w, r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	req, err := prompb.UnmarshalWriteRequest(buf)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	var thing *uuid.UUID
+	if p.Thing != nil {
+		id, err := uuid.Parse(*p.Thing)
+		if err != nil {
+			ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("thing has invalid format")))
+			return
+		}
+		thing = &id
+	}
+
+	series, err := services.ParsePrometheusWrite(req)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	createdBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Time series are created for new series, under the thing when given
+	policySvc := services.NewPolicyCheckService(db)
+	if thing != nil {
+		ok, err := policySvc.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "update", fmt.Sprintf("things/%v", thing.String()))
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		} else if ok == false {
+			ie.SendHTTPError(w, ie.ErrorForbidden)
+			return
+		}
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	// The access to create time series, and to the data of every time series,
+	// is checked in the same transaction that creates the missing ones
+	err = svc.WritePrometheus(r.Context(), services.WritePrometheusParams{
+		Series:    series,
+		CreatedBy: createdBy,
+		Token:     []byte(domaintoken.Token),
+		Thing:     thing,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PrometheusRemoteRead answers the queries of a Prometheus remote read request
+func (ra *RestApi) PrometheusRemoteRead(w http.ResponseWriter, r *http.Request) {
+	buf, err := readSnappyBody(w, r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	req, err := prompb.UnmarshalReadRequest(buf)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	resp, err := svc.ReadPrometheus(r.Context(), []byte(domaintoken.Token), req)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Encoding", "snappy")
+	w.WriteHeader(http.StatusOK)
+	w.Write(snappy.Encode(nil, resp.Marshal()))
+}
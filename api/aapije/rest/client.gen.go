@@ -112,6 +112,25 @@ type ClientInterface interface {
 
 	UpdateAlertByUuid(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindAnnotations request
+	FindAnnotations(ctx context.Context, params *FindAnnotationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddAnnotation request with any body
+	AddAnnotationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddAnnotation(ctx context.Context, body AddAnnotationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAnnotationByUuid request
+	DeleteAnnotationByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindAnnotationByUuid request
+	FindAnnotationByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAnnotationByUuid request with any body
+	UpdateAnnotationByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAnnotationByUuid(ctx context.Context, uuid UuidParam, body UpdateAnnotationByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindDatasets request
 	FindDatasets(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -149,6 +168,24 @@ type ClientInterface interface {
 	// InitializeDatasetUploadByUuid request
 	InitializeDatasetUploadByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GrafanaTestConnection request
+	GrafanaTestConnection(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GrafanaAnnotations request with any body
+	GrafanaAnnotationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GrafanaAnnotations(ctx context.Context, body GrafanaAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GrafanaQuery request with any body
+	GrafanaQueryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GrafanaQuery(ctx context.Context, body GrafanaQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GrafanaSearch request with any body
+	GrafanaSearchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GrafanaSearch(ctx context.Context, body GrafanaSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindGroups request
 	FindGroups(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -230,6 +267,12 @@ type ClientInterface interface {
 	// ExecuteProgramWebhook request
 	ExecuteProgramWebhook(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PrometheusRemoteRead request with any body
+	PrometheusRemoteReadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PrometheusRemoteWrite request with any body
+	PrometheusRemoteWriteWithBody(ctx context.Context, params *PrometheusRemoteWriteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindThings request
 	FindThings(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -270,9 +313,9 @@ type ClientInterface interface {
 	FindTimeSeriesByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateTimeseriesByUuid request with any body
-	UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateTimeseriesByUuid(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateTimeseriesByUuid(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteDataFromTimeSeries request
 	DeleteDataFromTimeSeries(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -285,6 +328,29 @@ type ClientInterface interface {
 
 	AddDataToTimeseries(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindQuarantinedDataOfTimeseries request
+	FindQuarantinedDataOfTimeseries(ctx context.Context, uuid UuidParam, params *FindQuarantinedDataOfTimeseriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplayQuarantinedDataToTimeseries request
+	ReplayQuarantinedDataToTimeseries(ctx context.Context, uuid UuidParam, params *ReplayQuarantinedDataToTimeseriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindStatsOfTimeseries request
+	FindStatsOfTimeseries(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddDataToManyTimeseries request with any body
+	AddDataToManyTimeseriesWithBody(ctx context.Context, params *AddDataToManyTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddDataToManyTimeseries(ctx context.Context, params *AddDataToManyTimeseriesParams, body AddDataToManyTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindLatestTsdata request
+	FindLatestTsdata(ctx context.Context, params *FindLatestTsdataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindStatsOfManyTimeseries request
+	FindStatsOfManyTimeseries(ctx context.Context, params *FindStatsOfManyTimeseriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamTsdata request
+	StreamTsdata(ctx context.Context, params *StreamTsdataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindTsdataByQuery request
 	FindTsdataByQuery(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -328,6 +394,9 @@ type ClientInterface interface {
 
 	// DeleteTokenForUser request
 	DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WriteLineProtocol request with any body
+	WriteLineProtocolWithBody(ctx context.Context, params *WriteLineProtocolParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) FindAlerts(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -414,6 +483,90 @@ func (c *Client) UpdateAlertByUuid(ctx context.Context, uuid UuidParam, body Upd
 	return c.Client.Do(req)
 }
 
+func (c *Client) FindAnnotations(ctx context.Context, params *FindAnnotationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAnnotationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddAnnotationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAnnotationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddAnnotation(ctx context.Context, body AddAnnotationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAnnotationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAnnotationByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAnnotationByUuidRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindAnnotationByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAnnotationByUuidRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAnnotationByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAnnotationByUuidRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAnnotationByUuid(ctx context.Context, uuid UuidParam, body UpdateAnnotationByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAnnotationByUuidRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FindDatasets(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFindDatasetsRequest(c.Server, params)
 	if err != nil {
@@ -570,6 +723,90 @@ func (c *Client) InitializeDatasetUploadByUuid(ctx context.Context, uuid UuidPar
 	return c.Client.Do(req)
 }
 
+func (c *Client) GrafanaTestConnection(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrafanaTestConnectionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrafanaAnnotationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrafanaAnnotationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrafanaAnnotations(ctx context.Context, body GrafanaAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrafanaAnnotationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrafanaQueryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrafanaQueryRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrafanaQuery(ctx context.Context, body GrafanaQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrafanaQueryRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrafanaSearchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrafanaSearchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GrafanaSearch(ctx context.Context, body GrafanaSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGrafanaSearchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FindGroups(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFindGroupsRequest(c.Server, params)
 	if err != nil {
@@ -918,6 +1155,30 @@ func (c *Client) ExecuteProgramWebhook(ctx context.Context, uuid UuidParam, reqE
 	return c.Client.Do(req)
 }
 
+func (c *Client) PrometheusRemoteReadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPrometheusRemoteReadRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PrometheusRemoteWriteWithBody(ctx context.Context, params *PrometheusRemoteWriteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPrometheusRemoteWriteRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FindThings(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFindThingsRequest(c.Server, params)
 	if err != nil {
@@ -1086,8 +1347,8 @@ func (c *Client) FindTimeSeriesByUuid(ctx context.Context, uuid UuidParam, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTimeseriesByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimeseriesByUuidRequestWithBody(c.Server, uuid, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1098,8 +1359,8 @@ func (c *Client) UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidPa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTimeseriesByUuid(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTimeseriesByUuidRequest(c.Server, uuid, body)
+func (c *Client) UpdateTimeseriesByUuid(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimeseriesByUuidRequest(c.Server, uuid, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1158,8 +1419,8 @@ func (c *Client) AddDataToTimeseries(ctx context.Context, uuid UuidParam, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindTsdataByQuery(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindTsdataByQueryRequest(c.Server, params)
+func (c *Client) FindQuarantinedDataOfTimeseries(ctx context.Context, uuid UuidParam, params *FindQuarantinedDataOfTimeseriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindQuarantinedDataOfTimeseriesRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1170,8 +1431,8 @@ func (c *Client) FindTsdataByQuery(ctx context.Context, params *FindTsdataByQuer
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindUsers(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindUsersRequest(c.Server, params)
+func (c *Client) ReplayQuarantinedDataToTimeseries(ctx context.Context, uuid UuidParam, params *ReplayQuarantinedDataToTimeseriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplayQuarantinedDataToTimeseriesRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1182,8 +1443,8 @@ func (c *Client) FindUsers(ctx context.Context, params *FindUsersParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) FindStatsOfTimeseries(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindStatsOfTimeseriesRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1194,8 +1455,8 @@ func (c *Client) AddUserWithBody(ctx context.Context, contentType string, body i
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddUser(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddUserRequest(c.Server, body)
+func (c *Client) AddDataToManyTimeseriesWithBody(ctx context.Context, params *AddDataToManyTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDataToManyTimeseriesRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1206,8 +1467,8 @@ func (c *Client) AddUser(ctx context.Context, body AddUserJSONRequestBody, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWhoamiRequest(c.Server)
+func (c *Client) AddDataToManyTimeseries(ctx context.Context, params *AddDataToManyTimeseriesParams, body AddDataToManyTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDataToManyTimeseriesRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1218,8 +1479,8 @@ func (c *Client) Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*ht
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUserByUuidRequest(c.Server, uuid)
+func (c *Client) FindLatestTsdata(ctx context.Context, params *FindLatestTsdataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindLatestTsdataRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1230,8 +1491,8 @@ func (c *Client) DeleteUserByUuid(ctx context.Context, uuid UuidParam, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindUserByUuidRequest(c.Server, uuid)
+func (c *Client) FindStatsOfManyTimeseries(ctx context.Context, params *FindStatsOfManyTimeseriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindStatsOfManyTimeseriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1242,8 +1503,8 @@ func (c *Client) FindUserByUuid(ctx context.Context, uuid UuidParam, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) StreamTsdata(ctx context.Context, params *StreamTsdataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamTsdataRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1254,8 +1515,8 @@ func (c *Client) UpdateUserByUuidWithBody(ctx context.Context, uuid UuidParam, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserByUuid(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserByUuidRequest(c.Server, uuid, body)
+func (c *Client) FindTsdataByQuery(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTsdataByQueryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1266,8 +1527,8 @@ func (c *Client) UpdateUserByUuid(ctx context.Context, uuid UuidParam, body Upda
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindPoliciesForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindPoliciesForUserRequest(c.Server, uuid)
+func (c *Client) FindUsers(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindUsersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1278,8 +1539,8 @@ func (c *Client) FindPoliciesForUser(ctx context.Context, uuid UuidParam, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetRequestRateForUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetRequestRateForUserRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) AddUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1290,8 +1551,8 @@ func (c *Client) SetRequestRateForUserWithBody(ctx context.Context, uuid UuidPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetRequestRateForUser(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetRequestRateForUserRequest(c.Server, uuid, body)
+func (c *Client) AddUser(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1302,8 +1563,8 @@ func (c *Client) SetRequestRateForUser(ctx context.Context, uuid UuidParam, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindTokensForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindTokensForUserRequest(c.Server, uuid)
+func (c *Client) Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWhoamiRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1314,8 +1575,8 @@ func (c *Client) FindTokensForUser(ctx context.Context, uuid UuidParam, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddNewTokenToUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddNewTokenToUserRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) DeleteUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1326,8 +1587,8 @@ func (c *Client) AddNewTokenToUserWithBody(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddNewTokenToUser(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddNewTokenToUserRequest(c.Server, uuid, body)
+func (c *Client) FindUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindUserByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1338,8 +1599,8 @@ func (c *Client) AddNewTokenToUser(ctx context.Context, uuid UuidParam, body Add
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTokenForUserRequest(c.Server, uuid, tokenUuid)
+func (c *Client) UpdateUserByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1350,28 +1611,136 @@ func (c *Client) DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUu
 	return c.Client.Do(req)
 }
 
-// NewFindAlertsRequest generates requests for FindAlerts
-func NewFindAlertsRequest(server string, params *FindAlertsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateUserByUuid(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/alerts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindPoliciesForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindPoliciesForUserRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Offset != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetRequestRateForUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetRequestRateForUserRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetRequestRateForUser(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetRequestRateForUserRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindTokensForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTokensForUserRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddNewTokenToUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNewTokenToUserRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddNewTokenToUser(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNewTokenToUserRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTokenForUserRequest(c.Server, uuid, tokenUuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WriteLineProtocolWithBody(ctx context.Context, params *WriteLineProtocolParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWriteLineProtocolRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewFindAlertsRequest generates requests for FindAlerts
+func NewFindAlertsRequest(server string, params *FindAlertsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/alerts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Offset != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 			return nil, err
@@ -1728,8 +2097,8 @@ func NewUpdateAlertByUuidRequestWithBody(server string, uuid UuidParam, contentT
 	return req, nil
 }
 
-// NewFindDatasetsRequest generates requests for FindDatasets
-func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Request, error) {
+// NewFindAnnotationsRequest generates requests for FindAnnotations
+func NewFindAnnotationsRequest(server string, params *FindAnnotationsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1737,7 +2106,7 @@ func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/datasets")
+	operationPath := fmt.Sprintf("/v2/annotations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1749,9 +2118,9 @@ func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Re
 
 	queryValues := queryURL.Query()
 
-	if params.Offset != nil {
+	if params.Timeseries != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeseries", runtime.ParamLocationQuery, *params.Timeseries); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1765,9 +2134,9 @@ func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Re
 
 	}
 
-	if params.Limit != nil {
+	if params.Things != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "things", runtime.ParamLocationQuery, *params.Things); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1781,6 +2150,30 @@ func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Re
 
 	}
 
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
 	if params.Tags != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
@@ -1797,6 +2190,38 @@ func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Re
 
 	}
 
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
 	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -1807,19 +2232,19 @@ func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Re
 	return req, nil
 }
 
-// NewAddDatasetsRequest calls the generic AddDatasets builder with application/json body
-func NewAddDatasetsRequest(server string, body AddDatasetsJSONRequestBody) (*http.Request, error) {
+// NewAddAnnotationRequest calls the generic AddAnnotation builder with application/json body
+func NewAddAnnotationRequest(server string, body AddAnnotationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddDatasetsRequestWithBody(server, "application/json", bodyReader)
+	return NewAddAnnotationRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewAddDatasetsRequestWithBody generates requests for AddDatasets with any type of body
-func NewAddDatasetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAddAnnotationRequestWithBody generates requests for AddAnnotation with any type of body
+func NewAddAnnotationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1827,7 +2252,7 @@ func NewAddDatasetsRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/datasets")
+	operationPath := fmt.Sprintf("/v2/annotations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1847,8 +2272,8 @@ func NewAddDatasetsRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
-// NewDeleteDatasetByUuidRequest generates requests for DeleteDatasetByUuid
-func NewDeleteDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewDeleteAnnotationByUuidRequest generates requests for DeleteAnnotationByUuid
+func NewDeleteAnnotationByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1863,7 +2288,7 @@ func NewDeleteDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/annotations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1881,8 +2306,8 @@ func NewDeleteDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request
 	return req, nil
 }
 
-// NewFindDatasetByUuidRequest generates requests for FindDatasetByUuid
-func NewFindDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindAnnotationByUuidRequest generates requests for FindAnnotationByUuid
+func NewFindAnnotationByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1897,7 +2322,7 @@ func NewFindDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/annotations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1915,19 +2340,19 @@ func NewFindDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request,
 	return req, nil
 }
 
-// NewUpdateDatasetByUuidRequest calls the generic UpdateDatasetByUuid builder with application/json body
-func NewUpdateDatasetByUuidRequest(server string, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody) (*http.Request, error) {
+// NewUpdateAnnotationByUuidRequest calls the generic UpdateAnnotationByUuid builder with application/json body
+func NewUpdateAnnotationByUuidRequest(server string, uuid UuidParam, body UpdateAnnotationByUuidJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatasetByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	return NewUpdateAnnotationByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
 }
 
-// NewUpdateDatasetByUuidRequestWithBody generates requests for UpdateDatasetByUuid with any type of body
-func NewUpdateDatasetByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAnnotationByUuidRequestWithBody generates requests for UpdateAnnotationByUuid with any type of body
+func NewUpdateAnnotationByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1942,7 +2367,7 @@ func NewUpdateDatasetByUuidRequestWithBody(server string, uuid UuidParam, conten
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/annotations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1962,23 +2387,16 @@ func NewUpdateDatasetByUuidRequestWithBody(server string, uuid UuidParam, conten
 	return req, nil
 }
 
-// NewAssembleDatasetPartsByKeyRequest generates requests for AssembleDatasetPartsByKey
-func NewAssembleDatasetPartsByKeyRequest(server string, uuid UuidParam, params *AssembleDatasetPartsByKeyParams) (*http.Request, error) {
+// NewFindDatasetsRequest generates requests for FindDatasets
+func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/datasets/%s/assemble", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1990,43 +2408,284 @@ func NewAssembleDatasetPartsByKeyRequest(server string, uuid UuidParam, params *
 
 	queryValues := queryURL.Query()
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "partNumber", runtime.ParamLocationQuery, params.PartNumber); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
 			}
 		}
+
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadId", runtime.ParamLocationQuery, params.UploadId); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
 			}
 		}
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	var headerParam0 string
+	if params.Tags != nil {
 
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, params.ContentMD5)
-	if err != nil {
-		return nil, err
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddDatasetsRequest calls the generic AddDatasets builder with application/json body
+func NewAddDatasetsRequest(server string, body AddDatasetsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddDatasetsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddDatasetsRequestWithBody generates requests for AddDatasets with any type of body
+func NewAddDatasetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/datasets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatasetByUuidRequest generates requests for DeleteDatasetByUuid
+func NewDeleteDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindDatasetByUuidRequest generates requests for FindDatasetByUuid
+func NewFindDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatasetByUuidRequest calls the generic UpdateDatasetByUuid builder with application/json body
+func NewUpdateDatasetByUuidRequest(server string, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatasetByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateDatasetByUuidRequestWithBody generates requests for UpdateDatasetByUuid with any type of body
+func NewUpdateDatasetByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAssembleDatasetPartsByKeyRequest generates requests for AssembleDatasetPartsByKey
+func NewAssembleDatasetPartsByKeyRequest(server string, uuid UuidParam, params *AssembleDatasetPartsByKeyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/datasets/%s/assemble", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "partNumber", runtime.ParamLocationQuery, params.PartNumber); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadId", runtime.ParamLocationQuery, params.UploadId); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, params.ContentMD5)
+	if err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Content-MD5", headerParam0)
 
@@ -2283,8 +2942,8 @@ func NewInitializeDatasetUploadByUuidRequest(server string, uuid UuidParam) (*ht
 	return req, nil
 }
 
-// NewFindGroupsRequest generates requests for FindGroups
-func NewFindGroupsRequest(server string, params *FindGroupsParams) (*http.Request, error) {
+// NewGrafanaTestConnectionRequest generates requests for GrafanaTestConnection
+func NewGrafanaTestConnectionRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2292,7 +2951,7 @@ func NewFindGroupsRequest(server string, params *FindGroupsParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/groups")
+	operationPath := fmt.Sprintf("/v2/grafana")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2302,11 +2961,158 @@ func NewFindGroupsRequest(server string, params *FindGroupsParams) (*http.Reques
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.Limit != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+// NewGrafanaAnnotationsRequest calls the generic GrafanaAnnotations builder with application/json body
+func NewGrafanaAnnotationsRequest(server string, body GrafanaAnnotationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGrafanaAnnotationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGrafanaAnnotationsRequestWithBody generates requests for GrafanaAnnotations with any type of body
+func NewGrafanaAnnotationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/grafana/annotations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGrafanaQueryRequest calls the generic GrafanaQuery builder with application/json body
+func NewGrafanaQueryRequest(server string, body GrafanaQueryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGrafanaQueryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGrafanaQueryRequestWithBody generates requests for GrafanaQuery with any type of body
+func NewGrafanaQueryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/grafana/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGrafanaSearchRequest calls the generic GrafanaSearch builder with application/json body
+func NewGrafanaSearchRequest(server string, body GrafanaSearchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGrafanaSearchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGrafanaSearchRequestWithBody generates requests for GrafanaSearch with any type of body
+func NewGrafanaSearchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/grafana/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindGroupsRequest generates requests for FindGroups
+func NewFindGroupsRequest(server string, params *FindGroupsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3285,6 +4091,84 @@ func NewExecuteProgramWebhookRequest(server string, uuid UuidParam) (*http.Reque
 	return req, nil
 }
 
+// NewPrometheusRemoteReadRequestWithBody generates requests for PrometheusRemoteRead with any type of body
+func NewPrometheusRemoteReadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/prometheus/read")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPrometheusRemoteWriteRequestWithBody generates requests for PrometheusRemoteWrite with any type of body
+func NewPrometheusRemoteWriteRequestWithBody(server string, params *PrometheusRemoteWriteParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/prometheus/write")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Thing != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "thing", runtime.ParamLocationQuery, *params.Thing); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewFindThingsRequest generates requests for FindThings
 func NewFindThingsRequest(server string, params *FindThingsParams) (*http.Request, error) {
 	var err error
@@ -3775,18 +4659,18 @@ func NewFindTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Reques
 }
 
 // NewUpdateTimeseriesByUuidRequest calls the generic UpdateTimeseriesByUuid builder with application/json body
-func NewUpdateTimeseriesByUuidRequest(server string, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody) (*http.Request, error) {
+func NewUpdateTimeseriesByUuidRequest(server string, uuid UuidParam, params *UpdateTimeseriesByUuidParams, body UpdateTimeseriesByUuidJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTimeseriesByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	return NewUpdateTimeseriesByUuidRequestWithBody(server, uuid, params, "application/json", bodyReader)
 }
 
 // NewUpdateTimeseriesByUuidRequestWithBody generates requests for UpdateTimeseriesByUuid with any type of body
-func NewUpdateTimeseriesByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+func NewUpdateTimeseriesByUuidRequestWithBody(server string, uuid UuidParam, params *UpdateTimeseriesByUuidParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3811,6 +4695,26 @@ func NewUpdateTimeseriesByUuidRequestWithBody(server string, uuid UuidParam, con
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.ConvertData != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "convert_data", runtime.ParamLocationQuery, *params.ConvertData); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -4031,6 +4935,22 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 
 	}
 
+	if params.Origin != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "origin", runtime.ParamLocationQuery, *params.Origin); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
 	if params.Aggregate != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregate", runtime.ParamLocationQuery, *params.Aggregate); err != nil {
@@ -4063,58 +4983,25 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	if params.Fill != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fill", runtime.ParamLocationQuery, *params.Fill); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	return req, nil
-}
+	if params.ExcludeQuality != nil {
 
-// NewAddDataToTimeseriesRequest calls the generic AddDataToTimeseries builder with application/json body
-func NewAddDataToTimeseriesRequest(server string, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddDataToTimeseriesRequestWithBody(server, uuid, params, "application/json", bodyReader)
-}
-
-// NewAddDataToTimeseriesRequestWithBody generates requests for AddDataToTimeseries with any type of body
-func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	queryValues := queryURL.Query()
-
-	if params.Unit != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_quality", runtime.ParamLocationQuery, *params.ExcludeQuality); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4130,108 +5017,56 @@ func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params
 
 	queryURL.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewFindTsdataByQueryRequest generates requests for FindTsdataByQuery
-func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// NewAddDataToTimeseriesRequest calls the generic AddDataToTimeseries builder with application/json body
+func NewAddDataToTimeseriesRequest(server string, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddDataToTimeseriesRequestWithBody(server, uuid, params, "application/json", bodyReader)
+}
 
-	operationPath := fmt.Sprintf("/v2/tsquery")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// NewAddDataToTimeseriesRequestWithBody generates requests for AddDataToTimeseries with any type of body
+func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	queryURL, err := serverURL.Parse(operationPath)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uuids", runtime.ParamLocationQuery, params.Uuids); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	if params.Ge != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
 	}
 
-	if params.Le != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
+	queryValues := queryURL.Query()
 
-	if params.Precision != nil {
+	if params.Unit != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "precision", runtime.ParamLocationQuery, *params.Precision); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4245,9 +5080,9 @@ func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams)
 
 	}
 
-	if params.Aggregate != nil {
+	if params.OnConflict != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregate", runtime.ParamLocationQuery, *params.Aggregate); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "on_conflict", runtime.ParamLocationQuery, *params.OnConflict); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4261,9 +5096,9 @@ func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams)
 
 	}
 
-	if params.Timezone != nil {
+	if params.Quarantine != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quarantine", runtime.ParamLocationQuery, *params.Quarantine); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4279,24 +5114,33 @@ func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams)
 
 	queryURL.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFindUsersRequest generates requests for FindUsers
-func NewFindUsersRequest(server string, params *FindUsersParams) (*http.Request, error) {
+// NewFindQuarantinedDataOfTimeseriesRequest generates requests for FindQuarantinedDataOfTimeseries
+func NewFindQuarantinedDataOfTimeseriesRequest(server string, uuid UuidParam, params *FindQuarantinedDataOfTimeseriesParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users")
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/quarantine", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4308,6 +5152,30 @@ func NewFindUsersRequest(server string, params *FindUsersParams) (*http.Request,
 
 	queryValues := queryURL.Query()
 
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
 	if params.Limit != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
@@ -4350,27 +5218,23 @@ func NewFindUsersRequest(server string, params *FindUsersParams) (*http.Request,
 	return req, nil
 }
 
-// NewAddUserRequest calls the generic AddUser builder with application/json body
-func NewAddUserRequest(server string, body AddUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewReplayQuarantinedDataToTimeseriesRequest generates requests for ReplayQuarantinedDataToTimeseries
+func NewReplayQuarantinedDataToTimeseriesRequest(server string, uuid UuidParam, params *ReplayQuarantinedDataToTimeseriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddUserRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewAddUserRequestWithBody generates requests for AddUser with any type of body
-func NewAddUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users")
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/quarantine/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4380,36 +5244,35 @@ func NewAddUserRequestWithBody(server string, contentType string, body io.Reader
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewWhoamiRequest generates requests for Whoami
-func NewWhoamiRequest(server string) (*http.Request, error) {
-	var err error
+	queryValues := queryURL.Query()
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
 		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4417,8 +5280,8 @@ func NewWhoamiRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeleteUserByUuidRequest generates requests for DeleteUserByUuid
-func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindStatsOfTimeseriesRequest generates requests for FindStatsOfTimeseries
+func NewFindStatsOfTimeseriesRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4433,7 +5296,7 @@ func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/stats", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4443,7 +5306,7 @@ func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4451,23 +5314,27 @@ func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, e
 	return req, nil
 }
 
-// NewFindUserByUuidRequest generates requests for FindUserByUuid
-func NewFindUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+// NewAddDataToManyTimeseriesRequest calls the generic AddDataToManyTimeseries builder with application/json body
+func NewAddDataToManyTimeseriesRequest(server string, params *AddDataToManyTimeseriesParams, body AddDataToManyTimeseriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddDataToManyTimeseriesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewAddDataToManyTimeseriesRequestWithBody generates requests for AddDataToManyTimeseries with any type of body
+func NewAddDataToManyTimeseriesRequestWithBody(server string, params *AddDataToManyTimeseriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/tsdata")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4477,52 +5344,43 @@ func NewFindUserByUuidRequest(server string, uuid UuidParam) (*http.Request, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewUpdateUserByUuidRequest calls the generic UpdateUserByUuid builder with application/json body
-func NewUpdateUserByUuidRequest(server string, uuid UuidParam, body UpdateUserByUuidJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateUserByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
-}
+	queryValues := queryURL.Query()
 
-// NewUpdateUserByUuidRequestWithBody generates requests for UpdateUserByUuid with any type of body
-func NewUpdateUserByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	if params.OnConflict != nil {
 
-	var pathParam0 string
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "on_conflict", runtime.ParamLocationQuery, *params.OnConflict); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	if params.Quarantine != nil {
 
-	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quarantine", runtime.ParamLocationQuery, *params.Quarantine); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4532,23 +5390,16 @@ func NewUpdateUserByUuidRequestWithBody(server string, uuid UuidParam, contentTy
 	return req, nil
 }
 
-// NewFindPoliciesForUserRequest generates requests for FindPoliciesForUser
-func NewFindPoliciesForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindLatestTsdataRequest generates requests for FindLatestTsdata
+func NewFindLatestTsdataRequest(server string, params *FindLatestTsdataParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/policies", pathParam0)
+	operationPath := fmt.Sprintf("/v2/tsdata/latest")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4558,78 +5409,56 @@ func NewFindPoliciesForUserRequest(server string, uuid UuidParam) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	queryValues := queryURL.Query()
 
-// NewSetRequestRateForUserRequest calls the generic SetRequestRateForUser builder with application/json body
-func NewSetRequestRateForUserRequest(server string, uuid UuidParam, body SetRequestRateForUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uuids", runtime.ParamLocationQuery, params.Uuids); err != nil {
 		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetRequestRateForUserRequestWithBody(server, uuid, "application/json", bodyReader)
-}
-
-// NewSetRequestRateForUserRequestWithBody generates requests for SetRequestRateForUser with any type of body
-func NewSetRequestRateForUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	if params.Count != nil {
 
-	operationPath := fmt.Sprintf("/v2/users/%s/rate", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewFindTokensForUserRequest generates requests for FindTokensForUser
-func NewFindTokensForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindStatsOfManyTimeseriesRequest generates requests for FindStatsOfManyTimeseries
+func NewFindStatsOfManyTimeseriesRequest(server string, params *FindStatsOfManyTimeseriesParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	operationPath := fmt.Sprintf("/v2/tsdata/stats")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4639,42 +5468,40 @@ func NewFindTokensForUserRequest(server string, uuid UuidParam) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uuids", runtime.ParamLocationQuery, params.Uuids); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	return req, nil
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// NewAddNewTokenToUserRequest calls the generic AddNewTokenToUser builder with application/json body
-func NewAddNewTokenToUserRequest(server string, uuid UuidParam, body AddNewTokenToUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddNewTokenToUserRequestWithBody(server, uuid, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewAddNewTokenToUserRequestWithBody generates requests for AddNewTokenToUser with any type of body
-func NewAddNewTokenToUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewStreamTsdataRequest generates requests for StreamTsdata
+func NewStreamTsdataRequest(server string, params *StreamTsdataParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	operationPath := fmt.Sprintf("/v2/tsdata/stream")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4684,40 +5511,40 @@ func NewAddNewTokenToUserRequestWithBody(server string, uuid UuidParam, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uuids", runtime.ParamLocationQuery, params.Uuids); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewDeleteTokenForUserRequest generates requests for DeleteTokenForUser
-func NewDeleteTokenForUserRequest(server string, uuid UuidParam, tokenUuid string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	queryURL.RawQuery = queryValues.Encode()
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "token_uuid", runtime.ParamLocationPath, tokenUuid)
-	if err != nil {
-		return nil, err
-	}
+// NewFindTsdataByQueryRequest generates requests for FindTsdataByQuery
+func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/tokens/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v2/tsquery")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4727,302 +5554,1563 @@ func NewDeleteTokenForUserRequest(server string, uuid UuidParam, tokenUuid strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	queryValues := queryURL.Query()
 
-	return req, nil
-}
+	if params.Uuids != nil {
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uuids", runtime.ParamLocationQuery, *params.Uuids); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	if params.Tags != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
-
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+	if params.TagsMatch != nil {
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags_match", runtime.ParamLocationQuery, *params.TagsMatch); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		c.Server = newBaseURL.String()
-		return nil
+
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// FindAlerts request
-	FindAlertsWithResponse(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*FindAlertsResponse, error)
+	if params.Thing != nil {
 
-	// CreateAlert request with any body
-	CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "thing", runtime.ParamLocationQuery, *params.Thing); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+	}
 
-	// DeleteAlertByUuid request
-	DeleteAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertByUuidResponse, error)
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
 
-	// FindAlertByUuid request
-	FindAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertByUuidResponse, error)
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
 
-	// UpdateAlertByUuid request with any body
-	UpdateAlertByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+	if params.Ge != nil {
 
-	UpdateAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// FindDatasets request
-	FindDatasetsWithResponse(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*FindDatasetsResponse, error)
+	}
 
-	// AddDatasets request with any body
-	AddDatasetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+	if params.Le != nil {
 
-	AddDatasetsWithResponse(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// DeleteDatasetByUuid request
-	DeleteDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetByUuidResponse, error)
+	}
 
-	// FindDatasetByUuid request
-	FindDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetByUuidResponse, error)
+	if params.Precision != nil {
 
-	// UpdateDatasetByUuid request with any body
-	UpdateDatasetByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "precision", runtime.ParamLocationQuery, *params.Precision); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	UpdateDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+	}
 
-	// AssembleDatasetPartsByKey request
-	AssembleDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*AssembleDatasetPartsByKeyResponse, error)
+	if params.Origin != nil {
 
-	// ListDatasetPartsByKey request
-	ListDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*ListDatasetPartsByKeyResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "origin", runtime.ParamLocationQuery, *params.Origin); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// UploadDatasetContentByKey request
-	UploadDatasetContentByKeyWithResponse(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*UploadDatasetContentByKeyResponse, error)
+	}
 
-	// GetRawDatasetByUuid request
-	GetRawDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*GetRawDatasetByUuidResponse, error)
+	if params.Aggregate != nil {
 
-	// DeleteDatasetUploadByKey request
-	DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregate", runtime.ParamLocationQuery, *params.Aggregate); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// InitializeDatasetUploadByUuid request
-	InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error)
+	}
 
-	// FindGroups request
-	FindGroupsWithResponse(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*FindGroupsResponse, error)
+	if params.Timezone != nil {
 
-	// AddGroup request with any body
-	AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+	}
 
-	// DeleteGroupByUuid request
-	DeleteGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteGroupByUuidResponse, error)
+	if params.Fill != nil {
 
-	// FindGroupByUuid request
-	FindGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindGroupByUuidResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fill", runtime.ParamLocationQuery, *params.Fill); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// UpdateGroupByUuid request with any body
-	UpdateGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+	}
 
-	UpdateGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+	if params.ExcludeQuality != nil {
 
-	// FindPoliciesForGroup request
-	FindPoliciesForGroupWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForGroupResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_quality", runtime.ParamLocationQuery, *params.ExcludeQuality); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// FindPolicies request
-	FindPoliciesWithResponse(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*FindPoliciesResponse, error)
+	}
 
-	// AddPolicy request with any body
-	AddPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+	if params.Unit != nil {
 
-	AddPolicyWithResponse(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// DeletePolicyByUuid request
-	DeletePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeletePolicyByUuidResponse, error)
+	}
 
-	// FindPolicyByUuid request
-	FindPolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPolicyByUuidResponse, error)
+	if params.Units != nil {
 
-	// UpdatePolicyByUuid request with any body
-	UpdatePolicyByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "units", runtime.ParamLocationQuery, *params.Units); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	UpdatePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+	}
 
-	// FindPrograms request
-	FindProgramsWithResponse(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*FindProgramsResponse, error)
+	if params.Annotations != nil {
 
-	// AddProgram request with any body
-	AddProgramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "annotations", runtime.ParamLocationQuery, *params.Annotations); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	AddProgramWithResponse(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+	}
 
-	// DeleteProgramByUuid request
-	DeleteProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteProgramByUuidResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// FindProgramByUuid request
-	FindProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindProgramByUuidResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateProgramByUuid request with any body
-	UpdateProgramByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+	return req, nil
+}
 
-	UpdateProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+// NewFindUsersRequest generates requests for FindUsers
+func NewFindUsersRequest(server string, params *FindUsersParams) (*http.Request, error) {
+	var err error
 
-	// GetCodeFromProgram request
-	GetCodeFromProgramWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetCodeFromProgramResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddProgramCodeRevision request with any body
-	AddProgramCodeRevisionWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramCodeRevisionResponse, error)
+	operationPath := fmt.Sprintf("/v2/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetProgramCodeRevisionsDiff request
-	GetProgramCodeRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsDiffResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetProgramCodeRevisions request
-	GetProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsResponse, error)
+	queryValues := queryURL.Query()
 
-	// DeleteProgramCodeRevisions request
-	DeleteProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*DeleteProgramCodeRevisionsResponse, error)
+	if params.Limit != nil {
 
-	// SignProgramCodeRevisions request
-	SignProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*SignProgramCodeRevisionsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// ExecuteProgramWebhook request
-	ExecuteProgramWebhookWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ExecuteProgramWebhookResponse, error)
+	}
 
-	// FindThings request
-	FindThingsWithResponse(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*FindThingsResponse, error)
+	if params.Offset != nil {
 
-	// AddThing request with any body
-	AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+	}
 
-	// DeleteThingByUuid request
-	DeleteThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingByUuidResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// FindThingByUuid request
-	FindThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingByUuidResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateThingByUuid request with any body
-	UpdateThingByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+	return req, nil
+}
 
-	UpdateThingByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+// NewAddUserRequest calls the generic AddUser builder with application/json body
+func NewAddUserRequest(server string, body AddUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddUserRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// FindDatasetsForThing request
-	FindDatasetsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetsForThingResponse, error)
+// NewAddUserRequestWithBody generates requests for AddUser with any type of body
+func NewAddUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// FindTimeSeriesForThing request
-	FindTimeSeriesForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesForThingResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindTimeSeries request
-	FindTimeSeriesWithResponse(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*FindTimeSeriesResponse, error)
+	operationPath := fmt.Sprintf("/v2/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AddTimeSeries request with any body
-	AddTimeSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AddTimeSeriesWithResponse(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteTimeSeriesByUuid request
-	DeleteTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteTimeSeriesByUuidResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// FindTimeSeriesByUuid request
-	FindTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesByUuidResponse, error)
+	return req, nil
+}
 
-	// UpdateTimeseriesByUuid request with any body
-	UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+// NewWhoamiRequest generates requests for Whoami
+func NewWhoamiRequest(server string) (*http.Request, error) {
+	var err error
 
-	UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteDataFromTimeSeries request
-	DeleteDataFromTimeSeriesWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*DeleteDataFromTimeSeriesResponse, error)
+	operationPath := fmt.Sprintf("/v2/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// QueryTimeseriesForData request
-	QueryTimeseriesForDataWithResponse(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*QueryTimeseriesForDataResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddDataToTimeseries request with any body
-	AddDataToTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	AddDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+	return req, nil
+}
 
-	// FindTsdataByQuery request
-	FindTsdataByQueryWithResponse(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*FindTsdataByQueryResponse, error)
+// NewDeleteUserByUuidRequest generates requests for DeleteUserByUuid
+func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
 
-	// FindUsers request
-	FindUsersWithResponse(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*FindUsersResponse, error)
+	var pathParam0 string
 
-	// AddUser request with any body
-	AddUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-	AddUserWithResponse(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// Whoami request
-	WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoamiResponse, error)
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteUserByUuid request
-	DeleteUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteUserByUuidResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindUserByUuid request
-	FindUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindUserByUuidResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateUserByUuid request with any body
-	UpdateUserByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+	return req, nil
+}
 
-	UpdateUserByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+// NewFindUserByUuidRequest generates requests for FindUserByUuid
+func NewFindUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserByUuidRequest calls the generic UpdateUserByUuid builder with application/json body
+func NewUpdateUserByUuidRequest(server string, uuid UuidParam, body UpdateUserByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateUserByUuidRequestWithBody generates requests for UpdateUserByUuid with any type of body
+func NewUpdateUserByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindPoliciesForUserRequest generates requests for FindPoliciesForUser
+func NewFindPoliciesForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/policies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetRequestRateForUserRequest calls the generic SetRequestRateForUser builder with application/json body
+func NewSetRequestRateForUserRequest(server string, uuid UuidParam, body SetRequestRateForUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetRequestRateForUserRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewSetRequestRateForUserRequestWithBody generates requests for SetRequestRateForUser with any type of body
+func NewSetRequestRateForUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/rate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindTokensForUserRequest generates requests for FindTokensForUser
+func NewFindTokensForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddNewTokenToUserRequest calls the generic AddNewTokenToUser builder with application/json body
+func NewAddNewTokenToUserRequest(server string, uuid UuidParam, body AddNewTokenToUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddNewTokenToUserRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewAddNewTokenToUserRequestWithBody generates requests for AddNewTokenToUser with any type of body
+func NewAddNewTokenToUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTokenForUserRequest generates requests for DeleteTokenForUser
+func NewDeleteTokenForUserRequest(server string, uuid UuidParam, tokenUuid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "token_uuid", runtime.ParamLocationPath, tokenUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/tokens/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWriteLineProtocolRequestWithBody generates requests for WriteLineProtocol with any type of body
+func NewWriteLineProtocolRequestWithBody(server string, params *WriteLineProtocolParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/write")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Precision != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "precision", runtime.ParamLocationQuery, *params.Precision); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Thing != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "thing", runtime.ParamLocationQuery, *params.Thing); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Unit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// FindAlerts request
+	FindAlertsWithResponse(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*FindAlertsResponse, error)
+
+	// CreateAlert request with any body
+	CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+
+	CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+
+	// DeleteAlertByUuid request
+	DeleteAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertByUuidResponse, error)
+
+	// FindAlertByUuid request
+	FindAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertByUuidResponse, error)
+
+	// UpdateAlertByUuid request with any body
+	UpdateAlertByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+
+	UpdateAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+
+	// FindAnnotations request
+	FindAnnotationsWithResponse(ctx context.Context, params *FindAnnotationsParams, reqEditors ...RequestEditorFn) (*FindAnnotationsResponse, error)
+
+	// AddAnnotation request with any body
+	AddAnnotationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAnnotationResponse, error)
+
+	AddAnnotationWithResponse(ctx context.Context, body AddAnnotationJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAnnotationResponse, error)
+
+	// DeleteAnnotationByUuid request
+	DeleteAnnotationByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAnnotationByUuidResponse, error)
+
+	// FindAnnotationByUuid request
+	FindAnnotationByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAnnotationByUuidResponse, error)
+
+	// UpdateAnnotationByUuid request with any body
+	UpdateAnnotationByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAnnotationByUuidResponse, error)
+
+	UpdateAnnotationByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAnnotationByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAnnotationByUuidResponse, error)
+
+	// FindDatasets request
+	FindDatasetsWithResponse(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*FindDatasetsResponse, error)
+
+	// AddDatasets request with any body
+	AddDatasetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+
+	AddDatasetsWithResponse(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+
+	// DeleteDatasetByUuid request
+	DeleteDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetByUuidResponse, error)
+
+	// FindDatasetByUuid request
+	FindDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetByUuidResponse, error)
+
+	// UpdateDatasetByUuid request with any body
+	UpdateDatasetByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+
+	UpdateDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+
+	// AssembleDatasetPartsByKey request
+	AssembleDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*AssembleDatasetPartsByKeyResponse, error)
+
+	// ListDatasetPartsByKey request
+	ListDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*ListDatasetPartsByKeyResponse, error)
+
+	// UploadDatasetContentByKey request
+	UploadDatasetContentByKeyWithResponse(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*UploadDatasetContentByKeyResponse, error)
+
+	// GetRawDatasetByUuid request
+	GetRawDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*GetRawDatasetByUuidResponse, error)
+
+	// DeleteDatasetUploadByKey request
+	DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error)
+
+	// InitializeDatasetUploadByUuid request
+	InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error)
+
+	// GrafanaTestConnection request
+	GrafanaTestConnectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GrafanaTestConnectionResponse, error)
+
+	// GrafanaAnnotations request with any body
+	GrafanaAnnotationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrafanaAnnotationsResponse, error)
+
+	GrafanaAnnotationsWithResponse(ctx context.Context, body GrafanaAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*GrafanaAnnotationsResponse, error)
+
+	// GrafanaQuery request with any body
+	GrafanaQueryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrafanaQueryResponse, error)
+
+	GrafanaQueryWithResponse(ctx context.Context, body GrafanaQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*GrafanaQueryResponse, error)
+
+	// GrafanaSearch request with any body
+	GrafanaSearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrafanaSearchResponse, error)
+
+	GrafanaSearchWithResponse(ctx context.Context, body GrafanaSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*GrafanaSearchResponse, error)
+
+	// FindGroups request
+	FindGroupsWithResponse(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*FindGroupsResponse, error)
+
+	// AddGroup request with any body
+	AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+
+	AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+
+	// DeleteGroupByUuid request
+	DeleteGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteGroupByUuidResponse, error)
+
+	// FindGroupByUuid request
+	FindGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindGroupByUuidResponse, error)
+
+	// UpdateGroupByUuid request with any body
+	UpdateGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+
+	UpdateGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+
+	// FindPoliciesForGroup request
+	FindPoliciesForGroupWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForGroupResponse, error)
+
+	// FindPolicies request
+	FindPoliciesWithResponse(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*FindPoliciesResponse, error)
+
+	// AddPolicy request with any body
+	AddPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+
+	AddPolicyWithResponse(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+
+	// DeletePolicyByUuid request
+	DeletePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeletePolicyByUuidResponse, error)
+
+	// FindPolicyByUuid request
+	FindPolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPolicyByUuidResponse, error)
+
+	// UpdatePolicyByUuid request with any body
+	UpdatePolicyByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+
+	UpdatePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+
+	// FindPrograms request
+	FindProgramsWithResponse(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*FindProgramsResponse, error)
+
+	// AddProgram request with any body
+	AddProgramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+
+	AddProgramWithResponse(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+
+	// DeleteProgramByUuid request
+	DeleteProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteProgramByUuidResponse, error)
+
+	// FindProgramByUuid request
+	FindProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindProgramByUuidResponse, error)
+
+	// UpdateProgramByUuid request with any body
+	UpdateProgramByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+
+	UpdateProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+
+	// GetCodeFromProgram request
+	GetCodeFromProgramWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetCodeFromProgramResponse, error)
+
+	// AddProgramCodeRevision request with any body
+	AddProgramCodeRevisionWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramCodeRevisionResponse, error)
+
+	// GetProgramCodeRevisionsDiff request
+	GetProgramCodeRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsDiffResponse, error)
+
+	// GetProgramCodeRevisions request
+	GetProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsResponse, error)
+
+	// DeleteProgramCodeRevisions request
+	DeleteProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*DeleteProgramCodeRevisionsResponse, error)
+
+	// SignProgramCodeRevisions request
+	SignProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*SignProgramCodeRevisionsResponse, error)
+
+	// ExecuteProgramWebhook request
+	ExecuteProgramWebhookWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ExecuteProgramWebhookResponse, error)
+
+	// PrometheusRemoteRead request with any body
+	PrometheusRemoteReadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PrometheusRemoteReadResponse, error)
+
+	// PrometheusRemoteWrite request with any body
+	PrometheusRemoteWriteWithBodyWithResponse(ctx context.Context, params *PrometheusRemoteWriteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PrometheusRemoteWriteResponse, error)
+
+	// FindThings request
+	FindThingsWithResponse(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*FindThingsResponse, error)
+
+	// AddThing request with any body
+	AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+
+	AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+
+	// DeleteThingByUuid request
+	DeleteThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingByUuidResponse, error)
+
+	// FindThingByUuid request
+	FindThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingByUuidResponse, error)
+
+	// UpdateThingByUuid request with any body
+	UpdateThingByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+
+	UpdateThingByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+
+	// FindDatasetsForThing request
+	FindDatasetsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetsForThingResponse, error)
+
+	// FindTimeSeriesForThing request
+	FindTimeSeriesForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesForThingResponse, error)
+
+	// FindTimeSeries request
+	FindTimeSeriesWithResponse(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*FindTimeSeriesResponse, error)
+
+	// AddTimeSeries request with any body
+	AddTimeSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+
+	AddTimeSeriesWithResponse(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+
+	// DeleteTimeSeriesByUuid request
+	DeleteTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteTimeSeriesByUuidResponse, error)
+
+	// FindTimeSeriesByUuid request
+	FindTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesByUuidResponse, error)
+
+	// UpdateTimeseriesByUuid request with any body
+	UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+
+	UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+
+	// DeleteDataFromTimeSeries request
+	DeleteDataFromTimeSeriesWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*DeleteDataFromTimeSeriesResponse, error)
+
+	// QueryTimeseriesForData request
+	QueryTimeseriesForDataWithResponse(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*QueryTimeseriesForDataResponse, error)
+
+	// AddDataToTimeseries request with any body
+	AddDataToTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+
+	AddDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+
+	// FindQuarantinedDataOfTimeseries request
+	FindQuarantinedDataOfTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *FindQuarantinedDataOfTimeseriesParams, reqEditors ...RequestEditorFn) (*FindQuarantinedDataOfTimeseriesResponse, error)
+
+	// ReplayQuarantinedDataToTimeseries request
+	ReplayQuarantinedDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *ReplayQuarantinedDataToTimeseriesParams, reqEditors ...RequestEditorFn) (*ReplayQuarantinedDataToTimeseriesResponse, error)
+
+	// FindStatsOfTimeseries request
+	FindStatsOfTimeseriesWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindStatsOfTimeseriesResponse, error)
+
+	// AddDataToManyTimeseries request with any body
+	AddDataToManyTimeseriesWithBodyWithResponse(ctx context.Context, params *AddDataToManyTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToManyTimeseriesResponse, error)
+
+	AddDataToManyTimeseriesWithResponse(ctx context.Context, params *AddDataToManyTimeseriesParams, body AddDataToManyTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToManyTimeseriesResponse, error)
+
+	// FindLatestTsdata request
+	FindLatestTsdataWithResponse(ctx context.Context, params *FindLatestTsdataParams, reqEditors ...RequestEditorFn) (*FindLatestTsdataResponse, error)
+
+	// FindStatsOfManyTimeseries request
+	FindStatsOfManyTimeseriesWithResponse(ctx context.Context, params *FindStatsOfManyTimeseriesParams, reqEditors ...RequestEditorFn) (*FindStatsOfManyTimeseriesResponse, error)
+
+	// StreamTsdata request
+	StreamTsdataWithResponse(ctx context.Context, params *StreamTsdataParams, reqEditors ...RequestEditorFn) (*StreamTsdataResponse, error)
+
+	// FindTsdataByQuery request
+	FindTsdataByQueryWithResponse(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*FindTsdataByQueryResponse, error)
+
+	// FindUsers request
+	FindUsersWithResponse(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*FindUsersResponse, error)
+
+	// AddUser request with any body
+	AddUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+
+	AddUserWithResponse(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+
+	// Whoami request
+	WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoamiResponse, error)
+
+	// DeleteUserByUuid request
+	DeleteUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteUserByUuidResponse, error)
+
+	// FindUserByUuid request
+	FindUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindUserByUuidResponse, error)
+
+	// UpdateUserByUuid request with any body
+	UpdateUserByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+
+	UpdateUserByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+
+	// FindPoliciesForUser request
+	FindPoliciesForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForUserResponse, error)
+
+	// SetRequestRateForUser request with any body
+	SetRequestRateForUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+
+	SetRequestRateForUserWithResponse(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+
+	// FindTokensForUser request
+	FindTokensForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTokensForUserResponse, error)
+
+	// AddNewTokenToUser request with any body
+	AddNewTokenToUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+
+	AddNewTokenToUserWithResponse(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+
+	// DeleteTokenForUser request
+	DeleteTokenForUserWithResponse(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*DeleteTokenForUserResponse, error)
+
+	// WriteLineProtocol request with any body
+	WriteLineProtocolWithBodyWithResponse(ctx context.Context, params *WriteLineProtocolParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteLineProtocolResponse, error)
+}
+
+type FindAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NewAlertReply
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAlertByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAlertByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAlertByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAnnotationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Annotation
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAnnotationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAnnotationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddAnnotationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Annotation
+}
+
+// Status returns HTTPResponse.Status
+func (r AddAnnotationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddAnnotationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAnnotationByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAnnotationByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAnnotationByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAnnotationByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Annotation
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAnnotationByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAnnotationByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAnnotationByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAnnotationByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAnnotationByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDatasetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Dataset
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDatasetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDatasetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddDatasetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Dataset
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDatasetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDatasetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatasetByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatasetByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatasetByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDatasetByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dataset
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDatasetByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDatasetByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatasetByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatasetByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatasetByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssembleDatasetPartsByKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Message string `json:"message"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AssembleDatasetPartsByKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// FindPoliciesForUser request
-	FindPoliciesForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForUserResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssembleDatasetPartsByKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// SetRequestRateForUser request with any body
-	SetRequestRateForUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+type ListDatasetPartsByKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	SetRequestRateForUserWithResponse(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+// Status returns HTTPResponse.Status
+func (r ListDatasetPartsByKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// FindTokensForUser request
-	FindTokensForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTokensForUserResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatasetPartsByKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// AddNewTokenToUser request with any body
-	AddNewTokenToUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+type UploadDatasetContentByKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message string `json:"message"`
+	}
+}
 
-	AddNewTokenToUserWithResponse(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+// Status returns HTTPResponse.Status
+func (r UploadDatasetContentByKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteTokenForUser request
-	DeleteTokenForUserWithResponse(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*DeleteTokenForUserResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadDatasetContentByKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type FindAlertsResponse struct {
+type GetRawDatasetByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Alert
+	JSON200      *string
+	XML200       *string
+	YAML200      *string
 }
 
 // Status returns HTTPResponse.Status
-func (r FindAlertsResponse) Status() string {
+func (r GetRawDatasetByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5030,21 +7118,20 @@ func (r FindAlertsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindAlertsResponse) StatusCode() int {
+func (r GetRawDatasetByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAlertResponse struct {
+type DeleteDatasetUploadByKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *NewAlertReply
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAlertResponse) Status() string {
+func (r DeleteDatasetUploadByKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5052,20 +7139,23 @@ func (r CreateAlertResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAlertResponse) StatusCode() int {
+func (r DeleteDatasetUploadByKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAlertByUuidResponse struct {
+type InitializeDatasetUploadByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *struct {
+		UploadId *string `json:"uploadId,omitempty"`
+	}
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAlertByUuidResponse) Status() string {
+func (r InitializeDatasetUploadByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5073,21 +7163,20 @@ func (r DeleteAlertByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAlertByUuidResponse) StatusCode() int {
+func (r InitializeDatasetUploadByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindAlertByUuidResponse struct {
+type GrafanaTestConnectionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Alert
 }
 
 // Status returns HTTPResponse.Status
-func (r FindAlertByUuidResponse) Status() string {
+func (r GrafanaTestConnectionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5095,20 +7184,21 @@ func (r FindAlertByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindAlertByUuidResponse) StatusCode() int {
+func (r GrafanaTestConnectionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAlertByUuidResponse struct {
+type GrafanaAnnotationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]GrafanaAnnotation
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAlertByUuidResponse) Status() string {
+func (r GrafanaAnnotationsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5116,21 +7206,21 @@ func (r UpdateAlertByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAlertByUuidResponse) StatusCode() int {
+func (r GrafanaAnnotationsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindDatasetsResponse struct {
+type GrafanaQueryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Dataset
+	JSON200      *[]GrafanaTimeSeries
 }
 
 // Status returns HTTPResponse.Status
-func (r FindDatasetsResponse) Status() string {
+func (r GrafanaQueryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5138,21 +7228,21 @@ func (r FindDatasetsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindDatasetsResponse) StatusCode() int {
+func (r GrafanaQueryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddDatasetsResponse struct {
+type GrafanaSearchResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Dataset
+	JSON200      *[]GrafanaSearchResult
 }
 
 // Status returns HTTPResponse.Status
-func (r AddDatasetsResponse) Status() string {
+func (r GrafanaSearchResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5160,20 +7250,21 @@ func (r AddDatasetsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddDatasetsResponse) StatusCode() int {
+func (r GrafanaSearchResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteDatasetByUuidResponse struct {
+type FindGroupsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]Group
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteDatasetByUuidResponse) Status() string {
+func (r FindGroupsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5181,21 +7272,21 @@ func (r DeleteDatasetByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatasetByUuidResponse) StatusCode() int {
+func (r FindGroupsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindDatasetByUuidResponse struct {
+type AddGroupResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Dataset
+	JSON201      *Group
 }
 
 // Status returns HTTPResponse.Status
-func (r FindDatasetByUuidResponse) Status() string {
+func (r AddGroupResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5203,20 +7294,20 @@ func (r FindDatasetByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindDatasetByUuidResponse) StatusCode() int {
+func (r AddGroupResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateDatasetByUuidResponse struct {
+type DeleteGroupByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateDatasetByUuidResponse) Status() string {
+func (r DeleteGroupByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5224,23 +7315,21 @@ func (r UpdateDatasetByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatasetByUuidResponse) StatusCode() int {
+func (r DeleteGroupByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AssembleDatasetPartsByKeyResponse struct {
+type FindGroupByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
-		Message string `json:"message"`
-	}
+	JSON200      *Group
 }
 
 // Status returns HTTPResponse.Status
-func (r AssembleDatasetPartsByKeyResponse) Status() string {
+func (r FindGroupByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5248,20 +7337,20 @@ func (r AssembleDatasetPartsByKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AssembleDatasetPartsByKeyResponse) StatusCode() int {
+func (r FindGroupByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListDatasetPartsByKeyResponse struct {
+type UpdateGroupByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r ListDatasetPartsByKeyResponse) Status() string {
+func (r UpdateGroupByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5269,23 +7358,21 @@ func (r ListDatasetPartsByKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListDatasetPartsByKeyResponse) StatusCode() int {
+func (r UpdateGroupByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UploadDatasetContentByKeyResponse struct {
+type FindPoliciesForGroupResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Message string `json:"message"`
-	}
+	JSON200      *[]Policy
 }
 
 // Status returns HTTPResponse.Status
-func (r UploadDatasetContentByKeyResponse) Status() string {
+func (r FindPoliciesForGroupResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5293,23 +7380,21 @@ func (r UploadDatasetContentByKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UploadDatasetContentByKeyResponse) StatusCode() int {
+func (r FindPoliciesForGroupResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRawDatasetByUuidResponse struct {
+type FindPoliciesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *string
-	XML200       *string
-	YAML200      *string
+	JSON200      *[]Policy
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRawDatasetByUuidResponse) Status() string {
+func (r FindPoliciesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5317,20 +7402,21 @@ func (r GetRawDatasetByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRawDatasetByUuidResponse) StatusCode() int {
+func (r FindPoliciesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteDatasetUploadByKeyResponse struct {
+type AddPolicyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *Policy
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteDatasetUploadByKeyResponse) Status() string {
+func (r AddPolicyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5338,23 +7424,20 @@ func (r DeleteDatasetUploadByKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatasetUploadByKeyResponse) StatusCode() int {
+func (r AddPolicyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type InitializeDatasetUploadByUuidResponse struct {
+type DeletePolicyByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		UploadId *string `json:"uploadId,omitempty"`
-	}
 }
 
 // Status returns HTTPResponse.Status
-func (r InitializeDatasetUploadByUuidResponse) Status() string {
+func (r DeletePolicyByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5362,21 +7445,21 @@ func (r InitializeDatasetUploadByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r InitializeDatasetUploadByUuidResponse) StatusCode() int {
+func (r DeletePolicyByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindGroupsResponse struct {
+type FindPolicyByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Group
+	JSON200      *Policy
 }
 
 // Status returns HTTPResponse.Status
-func (r FindGroupsResponse) Status() string {
+func (r FindPolicyByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5384,21 +7467,20 @@ func (r FindGroupsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindGroupsResponse) StatusCode() int {
+func (r FindPolicyByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddGroupResponse struct {
+type UpdatePolicyByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Group
 }
 
 // Status returns HTTPResponse.Status
-func (r AddGroupResponse) Status() string {
+func (r UpdatePolicyByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5406,20 +7488,21 @@ func (r AddGroupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddGroupResponse) StatusCode() int {
+func (r UpdatePolicyByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteGroupByUuidResponse struct {
+type FindProgramsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]Program
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteGroupByUuidResponse) Status() string {
+func (r FindProgramsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5427,21 +7510,21 @@ func (r DeleteGroupByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteGroupByUuidResponse) StatusCode() int {
+func (r FindProgramsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindGroupByUuidResponse struct {
+type AddProgramResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Group
+	JSON201      *Program
 }
 
 // Status returns HTTPResponse.Status
-func (r FindGroupByUuidResponse) Status() string {
+func (r AddProgramResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5449,20 +7532,20 @@ func (r FindGroupByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindGroupByUuidResponse) StatusCode() int {
+func (r AddProgramResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateGroupByUuidResponse struct {
+type DeleteProgramByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateGroupByUuidResponse) Status() string {
+func (r DeleteProgramByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5470,21 +7553,21 @@ func (r UpdateGroupByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateGroupByUuidResponse) StatusCode() int {
+func (r DeleteProgramByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindPoliciesForGroupResponse struct {
+type FindProgramByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Policy
+	JSON200      *Program
 }
 
 // Status returns HTTPResponse.Status
-func (r FindPoliciesForGroupResponse) Status() string {
+func (r FindProgramByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5492,21 +7575,20 @@ func (r FindPoliciesForGroupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindPoliciesForGroupResponse) StatusCode() int {
+func (r FindProgramByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindPoliciesResponse struct {
+type UpdateProgramByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Policy
 }
 
 // Status returns HTTPResponse.Status
-func (r FindPoliciesResponse) Status() string {
+func (r UpdateProgramByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5514,21 +7596,21 @@ func (r FindPoliciesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindPoliciesResponse) StatusCode() int {
+func (r UpdateProgramByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddPolicyResponse struct {
+type GetCodeFromProgramResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Policy
+	JSON200      *Program
 }
 
 // Status returns HTTPResponse.Status
-func (r AddPolicyResponse) Status() string {
+func (r GetCodeFromProgramResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5536,20 +7618,21 @@ func (r AddPolicyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddPolicyResponse) StatusCode() int {
+func (r GetCodeFromProgramResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeletePolicyByUuidResponse struct {
+type AddProgramCodeRevisionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *CodeRevision
 }
 
 // Status returns HTTPResponse.Status
-func (r DeletePolicyByUuidResponse) Status() string {
+func (r AddProgramCodeRevisionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5557,21 +7640,20 @@ func (r DeletePolicyByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeletePolicyByUuidResponse) StatusCode() int {
+func (r AddProgramCodeRevisionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindPolicyByUuidResponse struct {
+type GetProgramCodeRevisionsDiffResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Policy
 }
 
 // Status returns HTTPResponse.Status
-func (r FindPolicyByUuidResponse) Status() string {
+func (r GetProgramCodeRevisionsDiffResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5579,20 +7661,21 @@ func (r FindPolicyByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindPolicyByUuidResponse) StatusCode() int {
+func (r GetProgramCodeRevisionsDiffResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdatePolicyByUuidResponse struct {
+type GetProgramCodeRevisionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]CodeRevision
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdatePolicyByUuidResponse) Status() string {
+func (r GetProgramCodeRevisionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5600,21 +7683,20 @@ func (r UpdatePolicyByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePolicyByUuidResponse) StatusCode() int {
+func (r GetProgramCodeRevisionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindProgramsResponse struct {
+type DeleteProgramCodeRevisionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Program
 }
 
 // Status returns HTTPResponse.Status
-func (r FindProgramsResponse) Status() string {
+func (r DeleteProgramCodeRevisionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5622,21 +7704,20 @@ func (r FindProgramsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindProgramsResponse) StatusCode() int {
+func (r DeleteProgramCodeRevisionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddProgramResponse struct {
+type SignProgramCodeRevisionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Program
 }
 
 // Status returns HTTPResponse.Status
-func (r AddProgramResponse) Status() string {
+func (r SignProgramCodeRevisionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5644,20 +7725,20 @@ func (r AddProgramResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddProgramResponse) StatusCode() int {
+func (r SignProgramCodeRevisionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteProgramByUuidResponse struct {
+type ExecuteProgramWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteProgramByUuidResponse) Status() string {
+func (r ExecuteProgramWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5665,21 +7746,20 @@ func (r DeleteProgramByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProgramByUuidResponse) StatusCode() int {
+func (r ExecuteProgramWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindProgramByUuidResponse struct {
+type PrometheusRemoteReadResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Program
 }
 
 // Status returns HTTPResponse.Status
-func (r FindProgramByUuidResponse) Status() string {
+func (r PrometheusRemoteReadResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5687,20 +7767,20 @@ func (r FindProgramByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindProgramByUuidResponse) StatusCode() int {
+func (r PrometheusRemoteReadResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateProgramByUuidResponse struct {
+type PrometheusRemoteWriteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateProgramByUuidResponse) Status() string {
+func (r PrometheusRemoteWriteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5708,21 +7788,21 @@ func (r UpdateProgramByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProgramByUuidResponse) StatusCode() int {
+func (r PrometheusRemoteWriteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetCodeFromProgramResponse struct {
+type FindThingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Program
+	JSON200      *[]Thing
 }
 
 // Status returns HTTPResponse.Status
-func (r GetCodeFromProgramResponse) Status() string {
+func (r FindThingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5730,21 +7810,21 @@ func (r GetCodeFromProgramResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetCodeFromProgramResponse) StatusCode() int {
+func (r FindThingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddProgramCodeRevisionResponse struct {
+type AddThingResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *CodeRevision
+	JSON201      *Thing
 }
 
 // Status returns HTTPResponse.Status
-func (r AddProgramCodeRevisionResponse) Status() string {
+func (r AddThingResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5752,20 +7832,20 @@ func (r AddProgramCodeRevisionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddProgramCodeRevisionResponse) StatusCode() int {
+func (r AddThingResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetProgramCodeRevisionsDiffResponse struct {
+type DeleteThingByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProgramCodeRevisionsDiffResponse) Status() string {
+func (r DeleteThingByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5773,21 +7853,21 @@ func (r GetProgramCodeRevisionsDiffResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProgramCodeRevisionsDiffResponse) StatusCode() int {
+func (r DeleteThingByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetProgramCodeRevisionsResponse struct {
+type FindThingByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CodeRevision
+	JSON200      *Thing
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProgramCodeRevisionsResponse) Status() string {
+func (r FindThingByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5795,20 +7875,20 @@ func (r GetProgramCodeRevisionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProgramCodeRevisionsResponse) StatusCode() int {
+func (r FindThingByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteProgramCodeRevisionsResponse struct {
+type UpdateThingByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteProgramCodeRevisionsResponse) Status() string {
+func (r UpdateThingByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5816,20 +7896,21 @@ func (r DeleteProgramCodeRevisionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProgramCodeRevisionsResponse) StatusCode() int {
+func (r UpdateThingByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SignProgramCodeRevisionsResponse struct {
+type FindDatasetsForThingResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]Timeseries
 }
 
 // Status returns HTTPResponse.Status
-func (r SignProgramCodeRevisionsResponse) Status() string {
+func (r FindDatasetsForThingResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5837,20 +7918,21 @@ func (r SignProgramCodeRevisionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SignProgramCodeRevisionsResponse) StatusCode() int {
+func (r FindDatasetsForThingResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ExecuteProgramWebhookResponse struct {
+type FindTimeSeriesForThingResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]Timeseries
 }
 
 // Status returns HTTPResponse.Status
-func (r ExecuteProgramWebhookResponse) Status() string {
+func (r FindTimeSeriesForThingResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5858,21 +7940,21 @@ func (r ExecuteProgramWebhookResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ExecuteProgramWebhookResponse) StatusCode() int {
+func (r FindTimeSeriesForThingResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindThingsResponse struct {
+type FindTimeSeriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Thing
+	JSON200      *[]Timeseries
 }
 
 // Status returns HTTPResponse.Status
-func (r FindThingsResponse) Status() string {
+func (r FindTimeSeriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5880,21 +7962,21 @@ func (r FindThingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindThingsResponse) StatusCode() int {
+func (r FindTimeSeriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddThingResponse struct {
+type AddTimeSeriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Thing
+	JSON201      *Timeseries
 }
 
 // Status returns HTTPResponse.Status
-func (r AddThingResponse) Status() string {
+func (r AddTimeSeriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5902,20 +7984,20 @@ func (r AddThingResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddThingResponse) StatusCode() int {
+func (r AddTimeSeriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteThingByUuidResponse struct {
+type DeleteTimeSeriesByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteThingByUuidResponse) Status() string {
+func (r DeleteTimeSeriesByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5923,21 +8005,21 @@ func (r DeleteThingByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteThingByUuidResponse) StatusCode() int {
+func (r DeleteTimeSeriesByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindThingByUuidResponse struct {
+type FindTimeSeriesByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Thing
+	JSON200      *Timeseries
 }
 
 // Status returns HTTPResponse.Status
-func (r FindThingByUuidResponse) Status() string {
+func (r FindTimeSeriesByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5945,20 +8027,20 @@ func (r FindThingByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindThingByUuidResponse) StatusCode() int {
+func (r FindTimeSeriesByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateThingByUuidResponse struct {
+type UpdateTimeseriesByUuidResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateThingByUuidResponse) Status() string {
+func (r UpdateTimeseriesByUuidResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5966,21 +8048,20 @@ func (r UpdateThingByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateThingByUuidResponse) StatusCode() int {
+func (r UpdateTimeseriesByUuidResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindDatasetsForThingResponse struct {
+type DeleteDataFromTimeSeriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Timeseries
 }
 
 // Status returns HTTPResponse.Status
-func (r FindDatasetsForThingResponse) Status() string {
+func (r DeleteDataFromTimeSeriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5988,21 +8069,21 @@ func (r FindDatasetsForThingResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindDatasetsForThingResponse) StatusCode() int {
+func (r DeleteDataFromTimeSeriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindTimeSeriesForThingResponse struct {
+type QueryTimeseriesForDataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Timeseries
+	JSON200      *[]TsRow
 }
 
 // Status returns HTTPResponse.Status
-func (r FindTimeSeriesForThingResponse) Status() string {
+func (r QueryTimeseriesForDataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6010,21 +8091,24 @@ func (r FindTimeSeriesForThingResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindTimeSeriesForThingResponse) StatusCode() int {
+func (r QueryTimeseriesForDataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindTimeSeriesResponse struct {
+type AddDataToTimeseriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Timeseries
+	JSON200      *TsInsertResult
+	JSON201      *TsInsertResult
+	JSON400      *TsInsertError
+	JSON500      *TsInsertError
 }
 
 // Status returns HTTPResponse.Status
-func (r FindTimeSeriesResponse) Status() string {
+func (r AddDataToTimeseriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6032,21 +8116,21 @@ func (r FindTimeSeriesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindTimeSeriesResponse) StatusCode() int {
+func (r AddDataToTimeseriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddTimeSeriesResponse struct {
+type FindQuarantinedDataOfTimeseriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Timeseries
+	JSON200      *[]TsQuarantinedPoint
 }
 
 // Status returns HTTPResponse.Status
-func (r AddTimeSeriesResponse) Status() string {
+func (r FindQuarantinedDataOfTimeseriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6054,20 +8138,21 @@ func (r AddTimeSeriesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddTimeSeriesResponse) StatusCode() int {
+func (r FindQuarantinedDataOfTimeseriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteTimeSeriesByUuidResponse struct {
+type ReplayQuarantinedDataToTimeseriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TsInsertResult
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteTimeSeriesByUuidResponse) Status() string {
+func (r ReplayQuarantinedDataToTimeseriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6075,21 +8160,21 @@ func (r DeleteTimeSeriesByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTimeSeriesByUuidResponse) StatusCode() int {
+func (r ReplayQuarantinedDataToTimeseriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindTimeSeriesByUuidResponse struct {
+type FindStatsOfTimeseriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Timeseries
+	JSON200      *TsStats
 }
 
 // Status returns HTTPResponse.Status
-func (r FindTimeSeriesByUuidResponse) Status() string {
+func (r FindStatsOfTimeseriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6097,20 +8182,21 @@ func (r FindTimeSeriesByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindTimeSeriesByUuidResponse) StatusCode() int {
+func (r FindStatsOfTimeseriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateTimeseriesByUuidResponse struct {
+type AddDataToManyTimeseriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *[]TsBatchInsertResult
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateTimeseriesByUuidResponse) Status() string {
+func (r AddDataToManyTimeseriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6118,20 +8204,21 @@ func (r UpdateTimeseriesByUuidResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTimeseriesByUuidResponse) StatusCode() int {
+func (r AddDataToManyTimeseriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteDataFromTimeSeriesResponse struct {
+type FindLatestTsdataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]TsResults
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteDataFromTimeSeriesResponse) Status() string {
+func (r FindLatestTsdataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6139,21 +8226,21 @@ func (r DeleteDataFromTimeSeriesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDataFromTimeSeriesResponse) StatusCode() int {
+func (r FindLatestTsdataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type QueryTimeseriesForDataResponse struct {
+type FindStatsOfManyTimeseriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]TsRow
+	JSON200      *[]TsStats
 }
 
 // Status returns HTTPResponse.Status
-func (r QueryTimeseriesForDataResponse) Status() string {
+func (r FindStatsOfManyTimeseriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6161,20 +8248,20 @@ func (r QueryTimeseriesForDataResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r QueryTimeseriesForDataResponse) StatusCode() int {
+func (r FindStatsOfManyTimeseriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddDataToTimeseriesResponse struct {
+type StreamTsdataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r AddDataToTimeseriesResponse) Status() string {
+func (r StreamTsdataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6182,7 +8269,7 @@ func (r AddDataToTimeseriesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddDataToTimeseriesResponse) StatusCode() int {
+func (r StreamTsdataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -6449,6 +8536,27 @@ func (r DeleteTokenForUserResponse) StatusCode() int {
 	return 0
 }
 
+type WriteLineProtocolResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r WriteLineProtocolResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WriteLineProtocolResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // FindAlertsWithResponse request returning *FindAlertsResponse
 func (c *ClientWithResponses) FindAlertsWithResponse(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*FindAlertsResponse, error) {
 	rsp, err := c.FindAlerts(ctx, params, reqEditors...)
@@ -6510,6 +8618,67 @@ func (c *ClientWithResponses) UpdateAlertByUuidWithResponse(ctx context.Context,
 	return ParseUpdateAlertByUuidResponse(rsp)
 }
 
+// FindAnnotationsWithResponse request returning *FindAnnotationsResponse
+func (c *ClientWithResponses) FindAnnotationsWithResponse(ctx context.Context, params *FindAnnotationsParams, reqEditors ...RequestEditorFn) (*FindAnnotationsResponse, error) {
+	rsp, err := c.FindAnnotations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAnnotationsResponse(rsp)
+}
+
+// AddAnnotationWithBodyWithResponse request with arbitrary body returning *AddAnnotationResponse
+func (c *ClientWithResponses) AddAnnotationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAnnotationResponse, error) {
+	rsp, err := c.AddAnnotationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAnnotationResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddAnnotationWithResponse(ctx context.Context, body AddAnnotationJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAnnotationResponse, error) {
+	rsp, err := c.AddAnnotation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAnnotationResponse(rsp)
+}
+
+// DeleteAnnotationByUuidWithResponse request returning *DeleteAnnotationByUuidResponse
+func (c *ClientWithResponses) DeleteAnnotationByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAnnotationByUuidResponse, error) {
+	rsp, err := c.DeleteAnnotationByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAnnotationByUuidResponse(rsp)
+}
+
+// FindAnnotationByUuidWithResponse request returning *FindAnnotationByUuidResponse
+func (c *ClientWithResponses) FindAnnotationByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAnnotationByUuidResponse, error) {
+	rsp, err := c.FindAnnotationByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAnnotationByUuidResponse(rsp)
+}
+
+// UpdateAnnotationByUuidWithBodyWithResponse request with arbitrary body returning *UpdateAnnotationByUuidResponse
+func (c *ClientWithResponses) UpdateAnnotationByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAnnotationByUuidResponse, error) {
+	rsp, err := c.UpdateAnnotationByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAnnotationByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAnnotationByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAnnotationByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAnnotationByUuidResponse, error) {
+	rsp, err := c.UpdateAnnotationByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAnnotationByUuidResponse(rsp)
+}
+
 // FindDatasetsWithResponse request returning *FindDatasetsResponse
 func (c *ClientWithResponses) FindDatasetsWithResponse(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*FindDatasetsResponse, error) {
 	rsp, err := c.FindDatasets(ctx, params, reqEditors...)
@@ -6604,25 +8773,85 @@ func (c *ClientWithResponses) GetRawDatasetByUuidWithResponse(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRawDatasetByUuidResponse(rsp)
+	return ParseGetRawDatasetByUuidResponse(rsp)
+}
+
+// DeleteDatasetUploadByKeyWithResponse request returning *DeleteDatasetUploadByKeyResponse
+func (c *ClientWithResponses) DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error) {
+	rsp, err := c.DeleteDatasetUploadByKey(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatasetUploadByKeyResponse(rsp)
+}
+
+// InitializeDatasetUploadByUuidWithResponse request returning *InitializeDatasetUploadByUuidResponse
+func (c *ClientWithResponses) InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error) {
+	rsp, err := c.InitializeDatasetUploadByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInitializeDatasetUploadByUuidResponse(rsp)
+}
+
+// GrafanaTestConnectionWithResponse request returning *GrafanaTestConnectionResponse
+func (c *ClientWithResponses) GrafanaTestConnectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GrafanaTestConnectionResponse, error) {
+	rsp, err := c.GrafanaTestConnection(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrafanaTestConnectionResponse(rsp)
+}
+
+// GrafanaAnnotationsWithBodyWithResponse request with arbitrary body returning *GrafanaAnnotationsResponse
+func (c *ClientWithResponses) GrafanaAnnotationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrafanaAnnotationsResponse, error) {
+	rsp, err := c.GrafanaAnnotationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrafanaAnnotationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) GrafanaAnnotationsWithResponse(ctx context.Context, body GrafanaAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*GrafanaAnnotationsResponse, error) {
+	rsp, err := c.GrafanaAnnotations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrafanaAnnotationsResponse(rsp)
+}
+
+// GrafanaQueryWithBodyWithResponse request with arbitrary body returning *GrafanaQueryResponse
+func (c *ClientWithResponses) GrafanaQueryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrafanaQueryResponse, error) {
+	rsp, err := c.GrafanaQueryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrafanaQueryResponse(rsp)
+}
+
+func (c *ClientWithResponses) GrafanaQueryWithResponse(ctx context.Context, body GrafanaQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*GrafanaQueryResponse, error) {
+	rsp, err := c.GrafanaQuery(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrafanaQueryResponse(rsp)
 }
 
-// DeleteDatasetUploadByKeyWithResponse request returning *DeleteDatasetUploadByKeyResponse
-func (c *ClientWithResponses) DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error) {
-	rsp, err := c.DeleteDatasetUploadByKey(ctx, uuid, params, reqEditors...)
+// GrafanaSearchWithBodyWithResponse request with arbitrary body returning *GrafanaSearchResponse
+func (c *ClientWithResponses) GrafanaSearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GrafanaSearchResponse, error) {
+	rsp, err := c.GrafanaSearchWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatasetUploadByKeyResponse(rsp)
+	return ParseGrafanaSearchResponse(rsp)
 }
 
-// InitializeDatasetUploadByUuidWithResponse request returning *InitializeDatasetUploadByUuidResponse
-func (c *ClientWithResponses) InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error) {
-	rsp, err := c.InitializeDatasetUploadByUuid(ctx, uuid, reqEditors...)
+func (c *ClientWithResponses) GrafanaSearchWithResponse(ctx context.Context, body GrafanaSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*GrafanaSearchResponse, error) {
+	rsp, err := c.GrafanaSearch(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseInitializeDatasetUploadByUuidResponse(rsp)
+	return ParseGrafanaSearchResponse(rsp)
 }
 
 // FindGroupsWithResponse request returning *FindGroupsResponse
@@ -6880,6 +9109,24 @@ func (c *ClientWithResponses) ExecuteProgramWebhookWithResponse(ctx context.Cont
 	return ParseExecuteProgramWebhookResponse(rsp)
 }
 
+// PrometheusRemoteReadWithBodyWithResponse request with arbitrary body returning *PrometheusRemoteReadResponse
+func (c *ClientWithResponses) PrometheusRemoteReadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PrometheusRemoteReadResponse, error) {
+	rsp, err := c.PrometheusRemoteReadWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrometheusRemoteReadResponse(rsp)
+}
+
+// PrometheusRemoteWriteWithBodyWithResponse request with arbitrary body returning *PrometheusRemoteWriteResponse
+func (c *ClientWithResponses) PrometheusRemoteWriteWithBodyWithResponse(ctx context.Context, params *PrometheusRemoteWriteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PrometheusRemoteWriteResponse, error) {
+	rsp, err := c.PrometheusRemoteWriteWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrometheusRemoteWriteResponse(rsp)
+}
+
 // FindThingsWithResponse request returning *FindThingsResponse
 func (c *ClientWithResponses) FindThingsWithResponse(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*FindThingsResponse, error) {
 	rsp, err := c.FindThings(ctx, params, reqEditors...)
@@ -7004,16 +9251,16 @@ func (c *ClientWithResponses) FindTimeSeriesByUuidWithResponse(ctx context.Conte
 }
 
 // UpdateTimeseriesByUuidWithBodyWithResponse request with arbitrary body returning *UpdateTimeseriesByUuidResponse
-func (c *ClientWithResponses) UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
-	rsp, err := c.UpdateTimeseriesByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
+	rsp, err := c.UpdateTimeseriesByUuidWithBody(ctx, uuid, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseUpdateTimeseriesByUuidResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
-	rsp, err := c.UpdateTimeseriesByUuid(ctx, uuid, body, reqEditors...)
+func (c *ClientWithResponses) UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, params *UpdateTimeseriesByUuidParams, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
+	rsp, err := c.UpdateTimeseriesByUuid(ctx, uuid, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -7055,6 +9302,77 @@ func (c *ClientWithResponses) AddDataToTimeseriesWithResponse(ctx context.Contex
 	return ParseAddDataToTimeseriesResponse(rsp)
 }
 
+// FindQuarantinedDataOfTimeseriesWithResponse request returning *FindQuarantinedDataOfTimeseriesResponse
+func (c *ClientWithResponses) FindQuarantinedDataOfTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *FindQuarantinedDataOfTimeseriesParams, reqEditors ...RequestEditorFn) (*FindQuarantinedDataOfTimeseriesResponse, error) {
+	rsp, err := c.FindQuarantinedDataOfTimeseries(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindQuarantinedDataOfTimeseriesResponse(rsp)
+}
+
+// ReplayQuarantinedDataToTimeseriesWithResponse request returning *ReplayQuarantinedDataToTimeseriesResponse
+func (c *ClientWithResponses) ReplayQuarantinedDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *ReplayQuarantinedDataToTimeseriesParams, reqEditors ...RequestEditorFn) (*ReplayQuarantinedDataToTimeseriesResponse, error) {
+	rsp, err := c.ReplayQuarantinedDataToTimeseries(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplayQuarantinedDataToTimeseriesResponse(rsp)
+}
+
+// FindStatsOfTimeseriesWithResponse request returning *FindStatsOfTimeseriesResponse
+func (c *ClientWithResponses) FindStatsOfTimeseriesWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindStatsOfTimeseriesResponse, error) {
+	rsp, err := c.FindStatsOfTimeseries(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindStatsOfTimeseriesResponse(rsp)
+}
+
+// AddDataToManyTimeseriesWithBodyWithResponse request with arbitrary body returning *AddDataToManyTimeseriesResponse
+func (c *ClientWithResponses) AddDataToManyTimeseriesWithBodyWithResponse(ctx context.Context, params *AddDataToManyTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToManyTimeseriesResponse, error) {
+	rsp, err := c.AddDataToManyTimeseriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDataToManyTimeseriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddDataToManyTimeseriesWithResponse(ctx context.Context, params *AddDataToManyTimeseriesParams, body AddDataToManyTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToManyTimeseriesResponse, error) {
+	rsp, err := c.AddDataToManyTimeseries(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDataToManyTimeseriesResponse(rsp)
+}
+
+// FindLatestTsdataWithResponse request returning *FindLatestTsdataResponse
+func (c *ClientWithResponses) FindLatestTsdataWithResponse(ctx context.Context, params *FindLatestTsdataParams, reqEditors ...RequestEditorFn) (*FindLatestTsdataResponse, error) {
+	rsp, err := c.FindLatestTsdata(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindLatestTsdataResponse(rsp)
+}
+
+// FindStatsOfManyTimeseriesWithResponse request returning *FindStatsOfManyTimeseriesResponse
+func (c *ClientWithResponses) FindStatsOfManyTimeseriesWithResponse(ctx context.Context, params *FindStatsOfManyTimeseriesParams, reqEditors ...RequestEditorFn) (*FindStatsOfManyTimeseriesResponse, error) {
+	rsp, err := c.FindStatsOfManyTimeseries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindStatsOfManyTimeseriesResponse(rsp)
+}
+
+// StreamTsdataWithResponse request returning *StreamTsdataResponse
+func (c *ClientWithResponses) StreamTsdataWithResponse(ctx context.Context, params *StreamTsdataParams, reqEditors ...RequestEditorFn) (*StreamTsdataResponse, error) {
+	rsp, err := c.StreamTsdata(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamTsdataResponse(rsp)
+}
+
 // FindTsdataByQueryWithResponse request returning *FindTsdataByQueryResponse
 func (c *ClientWithResponses) FindTsdataByQueryWithResponse(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*FindTsdataByQueryResponse, error) {
 	rsp, err := c.FindTsdataByQuery(ctx, params, reqEditors...)
@@ -7195,6 +9513,15 @@ func (c *ClientWithResponses) DeleteTokenForUserWithResponse(ctx context.Context
 	return ParseDeleteTokenForUserResponse(rsp)
 }
 
+// WriteLineProtocolWithBodyWithResponse request with arbitrary body returning *WriteLineProtocolResponse
+func (c *ClientWithResponses) WriteLineProtocolWithBodyWithResponse(ctx context.Context, params *WriteLineProtocolParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteLineProtocolResponse, error) {
+	rsp, err := c.WriteLineProtocolWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWriteLineProtocolResponse(rsp)
+}
+
 // ParseFindAlertsResponse parses an HTTP response from a FindAlertsWithResponse call
 func ParseFindAlertsResponse(rsp *http.Response) (*FindAlertsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -7305,6 +9632,116 @@ func ParseUpdateAlertByUuidResponse(rsp *http.Response) (*UpdateAlertByUuidRespo
 	return response, nil
 }
 
+// ParseFindAnnotationsResponse parses an HTTP response from a FindAnnotationsWithResponse call
+func ParseFindAnnotationsResponse(rsp *http.Response) (*FindAnnotationsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindAnnotationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Annotation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddAnnotationResponse parses an HTTP response from a AddAnnotationWithResponse call
+func ParseAddAnnotationResponse(rsp *http.Response) (*AddAnnotationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddAnnotationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Annotation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAnnotationByUuidResponse parses an HTTP response from a DeleteAnnotationByUuidWithResponse call
+func ParseDeleteAnnotationByUuidResponse(rsp *http.Response) (*DeleteAnnotationByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAnnotationByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseFindAnnotationByUuidResponse parses an HTTP response from a FindAnnotationByUuidWithResponse call
+func ParseFindAnnotationByUuidResponse(rsp *http.Response) (*FindAnnotationByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindAnnotationByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Annotation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAnnotationByUuidResponse parses an HTTP response from a UpdateAnnotationByUuidWithResponse call
+func ParseUpdateAnnotationByUuidResponse(rsp *http.Response) (*UpdateAnnotationByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAnnotationByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseFindDatasetsResponse parses an HTTP response from a FindDatasetsWithResponse call
 func ParseFindDatasetsResponse(rsp *http.Response) (*FindDatasetsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -7487,83 +9924,177 @@ func ParseUploadDatasetContentByKeyResponse(rsp *http.Response) (*UploadDatasetC
 	return response, nil
 }
 
-// ParseGetRawDatasetByUuidResponse parses an HTTP response from a GetRawDatasetByUuidWithResponse call
-func ParseGetRawDatasetByUuidResponse(rsp *http.Response) (*GetRawDatasetByUuidResponse, error) {
+// ParseGetRawDatasetByUuidResponse parses an HTTP response from a GetRawDatasetByUuidWithResponse call
+func ParseGetRawDatasetByUuidResponse(rsp *http.Response) (*GetRawDatasetByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRawDatasetByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest string
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain; charset=utf-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatasetUploadByKeyResponse parses an HTTP response from a DeleteDatasetUploadByKeyWithResponse call
+func ParseDeleteDatasetUploadByKeyResponse(rsp *http.Response) (*DeleteDatasetUploadByKeyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatasetUploadByKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseInitializeDatasetUploadByUuidResponse parses an HTTP response from a InitializeDatasetUploadByUuidWithResponse call
+func ParseInitializeDatasetUploadByUuidResponse(rsp *http.Response) (*InitializeDatasetUploadByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InitializeDatasetUploadByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			UploadId *string `json:"uploadId,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGrafanaTestConnectionResponse parses an HTTP response from a GrafanaTestConnectionWithResponse call
+func ParseGrafanaTestConnectionResponse(rsp *http.Response) (*GrafanaTestConnectionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GrafanaTestConnectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGrafanaAnnotationsResponse parses an HTTP response from a GrafanaAnnotationsWithResponse call
+func ParseGrafanaAnnotationsResponse(rsp *http.Response) (*GrafanaAnnotationsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRawDatasetByUuidResponse{
+	response := &GrafanaAnnotationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest string
+		var dest []GrafanaAnnotation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
-		var dest string
-		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.XML200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.YAML200 = &dest
-
-	case rsp.StatusCode == 200:
-		// Content-type (text/plain; charset=utf-8) unsupported
-
 	}
 
 	return response, nil
 }
 
-// ParseDeleteDatasetUploadByKeyResponse parses an HTTP response from a DeleteDatasetUploadByKeyWithResponse call
-func ParseDeleteDatasetUploadByKeyResponse(rsp *http.Response) (*DeleteDatasetUploadByKeyResponse, error) {
+// ParseGrafanaQueryResponse parses an HTTP response from a GrafanaQueryWithResponse call
+func ParseGrafanaQueryResponse(rsp *http.Response) (*GrafanaQueryResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatasetUploadByKeyResponse{
+	response := &GrafanaQueryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []GrafanaTimeSeries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseInitializeDatasetUploadByUuidResponse parses an HTTP response from a InitializeDatasetUploadByUuidWithResponse call
-func ParseInitializeDatasetUploadByUuidResponse(rsp *http.Response) (*InitializeDatasetUploadByUuidResponse, error) {
+// ParseGrafanaSearchResponse parses an HTTP response from a GrafanaSearchWithResponse call
+func ParseGrafanaSearchResponse(rsp *http.Response) (*GrafanaSearchResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &InitializeDatasetUploadByUuidResponse{
+	response := &GrafanaSearchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			UploadId *string `json:"uploadId,omitempty"`
-		}
+		var dest []GrafanaSearchResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8072,6 +10603,38 @@ func ParseExecuteProgramWebhookResponse(rsp *http.Response) (*ExecuteProgramWebh
 	return response, nil
 }
 
+// ParsePrometheusRemoteReadResponse parses an HTTP response from a PrometheusRemoteReadWithResponse call
+func ParsePrometheusRemoteReadResponse(rsp *http.Response) (*PrometheusRemoteReadResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PrometheusRemoteReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePrometheusRemoteWriteResponse parses an HTTP response from a PrometheusRemoteWriteWithResponse call
+func ParsePrometheusRemoteWriteResponse(rsp *http.Response) (*PrometheusRemoteWriteResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PrometheusRemoteWriteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseFindThingsResponse parses an HTTP response from a FindThingsWithResponse call
 func ParseFindThingsResponse(rsp *http.Response) (*FindThingsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -8399,6 +10962,215 @@ func ParseAddDataToTimeseriesResponse(rsp *http.Response) (*AddDataToTimeseriesR
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TsInsertResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TsInsertResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest TsInsertError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest TsInsertError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (text/plain; charset=utf-8) unsupported
+
+	case rsp.StatusCode == 500:
+		// Content-type (text/plain; charset=utf-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseFindQuarantinedDataOfTimeseriesResponse parses an HTTP response from a FindQuarantinedDataOfTimeseriesWithResponse call
+func ParseFindQuarantinedDataOfTimeseriesResponse(rsp *http.Response) (*FindQuarantinedDataOfTimeseriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindQuarantinedDataOfTimeseriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TsQuarantinedPoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplayQuarantinedDataToTimeseriesResponse parses an HTTP response from a ReplayQuarantinedDataToTimeseriesWithResponse call
+func ParseReplayQuarantinedDataToTimeseriesResponse(rsp *http.Response) (*ReplayQuarantinedDataToTimeseriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplayQuarantinedDataToTimeseriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TsInsertResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindStatsOfTimeseriesResponse parses an HTTP response from a FindStatsOfTimeseriesWithResponse call
+func ParseFindStatsOfTimeseriesResponse(rsp *http.Response) (*FindStatsOfTimeseriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindStatsOfTimeseriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TsStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddDataToManyTimeseriesResponse parses an HTTP response from a AddDataToManyTimeseriesWithResponse call
+func ParseAddDataToManyTimeseriesResponse(rsp *http.Response) (*AddDataToManyTimeseriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddDataToManyTimeseriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest []TsBatchInsertResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindLatestTsdataResponse parses an HTTP response from a FindLatestTsdataWithResponse call
+func ParseFindLatestTsdataResponse(rsp *http.Response) (*FindLatestTsdataResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindLatestTsdataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TsResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindStatsOfManyTimeseriesResponse parses an HTTP response from a FindStatsOfManyTimeseriesWithResponse call
+func ParseFindStatsOfManyTimeseriesResponse(rsp *http.Response) (*FindStatsOfManyTimeseriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindStatsOfManyTimeseriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TsStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamTsdataResponse parses an HTTP response from a StreamTsdataWithResponse call
+func ParseStreamTsdataResponse(rsp *http.Response) (*StreamTsdataResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamTsdataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
 	return response, nil
 }
 
@@ -8423,6 +11195,9 @@ func ParseFindTsdataByQueryResponse(rsp *http.Response) (*FindTsdataByQueryRespo
 		}
 		response.JSON200 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
 	}
 
 	return response, nil
@@ -8673,3 +11448,19 @@ func ParseDeleteTokenForUserResponse(rsp *http.Response) (*DeleteTokenForUserRes
 
 	return response, nil
 }
+
+// ParseWriteLineProtocolResponse parses an HTTP response from a WriteLineProtocolWithResponse call
+func ParseWriteLineProtocolResponse(rsp *http.Response) (*WriteLineProtocolResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WriteLineProtocolResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
@@ -36,6 +36,21 @@ type ServerInterface interface {
 	// Update a specific alert.
 	// (PUT /v2/alerts/{uuid})
 	UpdateAlertByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Get annotations.
+	// (GET /v2/annotations)
+	FindAnnotations(w http.ResponseWriter, r *http.Request, params FindAnnotationsParams)
+	// Create a new annotation.
+	// (POST /v2/annotations)
+	AddAnnotation(w http.ResponseWriter, r *http.Request)
+	// Delete a specific annotation.
+	// (DELETE /v2/annotations/{uuid})
+	DeleteAnnotationByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Get a specific annotation.
+	// (GET /v2/annotations/{uuid})
+	FindAnnotationByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Update a specific annotation.
+	// (PUT /v2/annotations/{uuid})
+	UpdateAnnotationByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 	// Get datasets.
 	// (GET /v2/datasets)
 	FindDatasets(w http.ResponseWriter, r *http.Request, params FindDatasetsParams)
@@ -69,6 +84,18 @@ type ServerInterface interface {
 	// Initialize a content upload. TBD.
 	// (POST /v2/datasets/{uuid}/uploads)
 	InitializeDatasetUploadByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Test the connection of a Grafana datasource.
+	// (GET /v2/grafana)
+	GrafanaTestConnection(w http.ResponseWriter, r *http.Request)
+	// Alerts as Grafana annotations.
+	// (POST /v2/grafana/annotations)
+	GrafanaAnnotations(w http.ResponseWriter, r *http.Request)
+	// Query Timeseries for data from Grafana.
+	// (POST /v2/grafana/query)
+	GrafanaQuery(w http.ResponseWriter, r *http.Request)
+	// Search for Timeseries to query from Grafana.
+	// (POST /v2/grafana/search)
+	GrafanaSearch(w http.ResponseWriter, r *http.Request)
 	// Get groups.
 	// (GET /v2/groups)
 	FindGroups(w http.ResponseWriter, r *http.Request, params FindGroupsParams)
@@ -138,6 +165,12 @@ type ServerInterface interface {
 	// TBD
 	// (POST /v2/programs/{uuid}/webhook)
 	ExecuteProgramWebhook(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Prometheus remote read endpoint.
+	// (POST /v2/prometheus/read)
+	PrometheusRemoteRead(w http.ResponseWriter, r *http.Request)
+	// Prometheus remote write receiver.
+	// (POST /v2/prometheus/write)
+	PrometheusRemoteWrite(w http.ResponseWriter, r *http.Request, params PrometheusRemoteWriteParams)
 
 	// (GET /v2/things)
 	FindThings(w http.ResponseWriter, r *http.Request, params FindThingsParams)
@@ -173,7 +206,7 @@ type ServerInterface interface {
 	FindTimeSeriesByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 	// Update Timeseries.
 	// (PUT /v2/timeseries/{uuid})
-	UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam, params UpdateTimeseriesByUuidParams)
 	// Delete a range of Timeseries data.
 	// (DELETE /v2/timeseries/{uuid}/data)
 	DeleteDataFromTimeSeries(w http.ResponseWriter, r *http.Request, uuid UuidParam, params DeleteDataFromTimeSeriesParams)
@@ -183,6 +216,27 @@ type ServerInterface interface {
 	// Add data to Timeseries
 	// (POST /v2/timeseries/{uuid}/data)
 	AddDataToTimeseries(w http.ResponseWriter, r *http.Request, uuid UuidParam, params AddDataToTimeseriesParams)
+	// List quarantined data of a Timeseries.
+	// (GET /v2/timeseries/{uuid}/quarantine)
+	FindQuarantinedDataOfTimeseries(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindQuarantinedDataOfTimeseriesParams)
+	// Replay quarantined data into a Timeseries.
+	// (POST /v2/timeseries/{uuid}/quarantine/replay)
+	ReplayQuarantinedDataToTimeseries(w http.ResponseWriter, r *http.Request, uuid UuidParam, params ReplayQuarantinedDataToTimeseriesParams)
+	// Statistics of a Timeseries.
+	// (GET /v2/timeseries/{uuid}/stats)
+	FindStatsOfTimeseries(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Add data to several Timeseries.
+	// (POST /v2/tsdata)
+	AddDataToManyTimeseries(w http.ResponseWriter, r *http.Request, params AddDataToManyTimeseriesParams)
+	// Latest data from several Timeseries.
+	// (GET /v2/tsdata/latest)
+	FindLatestTsdata(w http.ResponseWriter, r *http.Request, params FindLatestTsdataParams)
+	// Statistics of several Timeseries.
+	// (GET /v2/tsdata/stats)
+	FindStatsOfManyTimeseries(w http.ResponseWriter, r *http.Request, params FindStatsOfManyTimeseriesParams)
+	// Live data from several Timeseries.
+	// (GET /v2/tsdata/stream)
+	StreamTsdata(w http.ResponseWriter, r *http.Request, params StreamTsdataParams)
 	// Query for data from Time series.
 	// (GET /v2/tsquery)
 	FindTsdataByQuery(w http.ResponseWriter, r *http.Request, params FindTsdataByQueryParams)
@@ -219,6 +273,9 @@ type ServerInterface interface {
 	// Delete access token.
 	// (DELETE /v2/users/{uuid}/tokens/{token_uuid})
 	DeleteTokenForUser(w http.ResponseWriter, r *http.Request, uuid UuidParam, tokenUuid string)
+	// Write data in InfluxDB line protocol.
+	// (POST /v2/write)
+	WriteLineProtocol(w http.ResponseWriter, r *http.Request, params WriteLineProtocolParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -485,6 +542,212 @@ func (siw *ServerInterfaceWrapper) UpdateAlertByUuid(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
+// FindAnnotations operation middleware
+func (siw *ServerInterfaceWrapper) FindAnnotations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:annotations"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindAnnotationsParams
+
+	// ------------- Optional query parameter "timeseries" -------------
+	if paramValue := r.URL.Query().Get("timeseries"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "timeseries", r.URL.Query(), &params.Timeseries)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeseries", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "things" -------------
+	if paramValue := r.URL.Query().Get("things"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "things", r.URL.Query(), &params.Things)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "things", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindAnnotations(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddAnnotation operation middleware
+func (siw *ServerInterfaceWrapper) AddAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:annotations"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddAnnotation(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteAnnotationByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAnnotationByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:annotations"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAnnotationByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindAnnotationByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindAnnotationByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:annotations"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindAnnotationByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateAnnotationByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAnnotationByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:annotations"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAnnotationByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // FindDatasets operation middleware
 func (siw *ServerInterfaceWrapper) FindDatasets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -979,6 +1242,74 @@ func (siw *ServerInterfaceWrapper) InitializeDatasetUploadByUuid(w http.Response
 	handler(w, r.WithContext(ctx))
 }
 
+// GrafanaTestConnection operation middleware
+func (siw *ServerInterfaceWrapper) GrafanaTestConnection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tsquery"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GrafanaTestConnection(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GrafanaAnnotations operation middleware
+func (siw *ServerInterfaceWrapper) GrafanaAnnotations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:alerts"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GrafanaAnnotations(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GrafanaQuery operation middleware
+func (siw *ServerInterfaceWrapper) GrafanaQuery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tsquery"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GrafanaQuery(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GrafanaSearch operation middleware
+func (siw *ServerInterfaceWrapper) GrafanaSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tsquery"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GrafanaSearch(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // FindGroups operation middleware
 func (siw *ServerInterfaceWrapper) FindGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1709,30 +2040,80 @@ func (siw *ServerInterfaceWrapper) ExecuteProgramWebhook(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// FindThings operation middleware
-func (siw *ServerInterfaceWrapper) FindThings(w http.ResponseWriter, r *http.Request) {
+// PrometheusRemoteRead operation middleware
+func (siw *ServerInterfaceWrapper) PrometheusRemoteRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tsdata"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PrometheusRemoteRead(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PrometheusRemoteWrite operation middleware
+func (siw *ServerInterfaceWrapper) PrometheusRemoteWrite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:tsdata"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FindThingsParams
+	var params PrometheusRemoteWriteParams
 
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+	// ------------- Optional query parameter "thing" -------------
+	if paramValue := r.URL.Query().Get("thing"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "thing", r.URL.Query(), &params.Thing)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "thing", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PrometheusRemoteWrite(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindThings operation middleware
+func (siw *ServerInterfaceWrapper) FindThings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindThingsParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
 
 	}
 
@@ -2066,8 +2447,22 @@ func (siw *ServerInterfaceWrapper) UpdateTimeseriesByUuid(w http.ResponseWriter,
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:timeseries/{uuid}"})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTimeseriesByUuidParams
+
+	// ------------- Optional query parameter "convert_data" -------------
+	if paramValue := r.URL.Query().Get("convert_data"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "convert_data", r.URL.Query(), &params.ConvertData)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "convert_data", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTimeseriesByUuid(w, r, uuid)
+		siw.Handler.UpdateTimeseriesByUuid(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2217,63 +2612,419 @@ func (siw *ServerInterfaceWrapper) QueryTimeseriesForData(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Optional query parameter "ge" -------------
-	if paramValue := r.URL.Query().Get("ge"); paramValue != "" {
+	// ------------- Optional query parameter "ge" -------------
+	if paramValue := r.URL.Query().Get("ge"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ge", r.URL.Query(), &params.Ge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ge", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "le" -------------
+	if paramValue := r.URL.Query().Get("le"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "le", r.URL.Query(), &params.Le)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "le", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "precision" -------------
+	if paramValue := r.URL.Query().Get("precision"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "precision", r.URL.Query(), &params.Precision)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "precision", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "origin" -------------
+	if paramValue := r.URL.Query().Get("origin"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "origin", r.URL.Query(), &params.Origin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "origin", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "aggregate" -------------
+	if paramValue := r.URL.Query().Get("aggregate"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "aggregate", r.URL.Query(), &params.Aggregate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "aggregate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+	if paramValue := r.URL.Query().Get("timezone"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fill" -------------
+	if paramValue := r.URL.Query().Get("fill"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "fill", r.URL.Query(), &params.Fill)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fill", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_quality" -------------
+	if paramValue := r.URL.Query().Get("exclude_quality"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_quality", r.URL.Query(), &params.ExcludeQuality)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_quality", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryTimeseriesForData(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddDataToTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) AddDataToTimeseries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries/{uuid}/data"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddDataToTimeseriesParams
+
+	// ------------- Optional query parameter "unit" -------------
+	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "on_conflict" -------------
+	if paramValue := r.URL.Query().Get("on_conflict"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "on_conflict", r.URL.Query(), &params.OnConflict)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "on_conflict", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "quarantine" -------------
+	if paramValue := r.URL.Query().Get("quarantine"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "quarantine", r.URL.Query(), &params.Quarantine)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quarantine", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddDataToTimeseries(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindQuarantinedDataOfTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) FindQuarantinedDataOfTimeseries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries/{uuid}/data"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindQuarantinedDataOfTimeseriesParams
+
+	// ------------- Required query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindQuarantinedDataOfTimeseries(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ReplayQuarantinedDataToTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) ReplayQuarantinedDataToTimeseries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries/{uuid}/data"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplayQuarantinedDataToTimeseriesParams
+
+	// ------------- Required query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplayQuarantinedDataToTimeseries(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindStatsOfTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) FindStatsOfTimeseries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries/{uuid}/data"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindStatsOfTimeseries(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddDataToManyTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) AddDataToManyTimeseries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:tsdata"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddDataToManyTimeseriesParams
+
+	// ------------- Optional query parameter "on_conflict" -------------
+	if paramValue := r.URL.Query().Get("on_conflict"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "ge", r.URL.Query(), &params.Ge)
+	err = runtime.BindQueryParameter("form", true, false, "on_conflict", r.URL.Query(), &params.OnConflict)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ge", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "on_conflict", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "le" -------------
-	if paramValue := r.URL.Query().Get("le"); paramValue != "" {
+	// ------------- Optional query parameter "quarantine" -------------
+	if paramValue := r.URL.Query().Get("quarantine"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "le", r.URL.Query(), &params.Le)
+	err = runtime.BindQueryParameter("form", true, false, "quarantine", r.URL.Query(), &params.Quarantine)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "le", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quarantine", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "precision" -------------
-	if paramValue := r.URL.Query().Get("precision"); paramValue != "" {
-
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddDataToManyTimeseries(w, r, params)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "precision", r.URL.Query(), &params.Precision)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "precision", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "aggregate" -------------
-	if paramValue := r.URL.Query().Get("aggregate"); paramValue != "" {
+	handler(w, r.WithContext(ctx))
+}
+
+// FindLatestTsdata operation middleware
+func (siw *ServerInterfaceWrapper) FindLatestTsdata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tsdata"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindLatestTsdataParams
+
+	// ------------- Required query parameter "uuids" -------------
+	if paramValue := r.URL.Query().Get("uuids"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "uuids"})
+		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "aggregate", r.URL.Query(), &params.Aggregate)
+	err = runtime.BindQueryParameter("form", true, true, "uuids", r.URL.Query(), &params.Uuids)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "aggregate", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuids", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "timezone" -------------
-	if paramValue := r.URL.Query().Get("timezone"); paramValue != "" {
+	// ------------- Optional query parameter "count" -------------
+	if paramValue := r.URL.Query().Get("count"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryTimeseriesForData(w, r, uuid, params)
+		siw.Handler.FindLatestTsdata(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2283,39 +3034,69 @@ func (siw *ServerInterfaceWrapper) QueryTimeseriesForData(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AddDataToTimeseries operation middleware
-func (siw *ServerInterfaceWrapper) AddDataToTimeseries(w http.ResponseWriter, r *http.Request) {
+// FindStatsOfManyTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) FindStatsOfManyTimeseries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tsdata"})
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindStatsOfManyTimeseriesParams
+
+	// ------------- Required query parameter "uuids" -------------
+	if paramValue := r.URL.Query().Get("uuids"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "uuids"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "uuids", r.URL.Query(), &params.Uuids)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuids", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries/{uuid}/data"})
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindStatsOfManyTimeseries(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// StreamTsdata operation middleware
+func (siw *ServerInterfaceWrapper) StreamTsdata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tsdata"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AddDataToTimeseriesParams
+	var params StreamTsdataParams
 
-	// ------------- Optional query parameter "unit" -------------
-	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+	// ------------- Required query parameter "uuids" -------------
+	if paramValue := r.URL.Query().Get("uuids"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "uuids"})
+		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	err = runtime.BindQueryParameter("form", true, true, "uuids", r.URL.Query(), &params.Uuids)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuids", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddDataToTimeseries(w, r, uuid, params)
+		siw.Handler.StreamTsdata(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2336,20 +3117,50 @@ func (siw *ServerInterfaceWrapper) FindTsdataByQuery(w http.ResponseWriter, r *h
 	// Parameter object where we will unmarshal all parameters from the context
 	var params FindTsdataByQueryParams
 
-	// ------------- Required query parameter "uuids" -------------
+	// ------------- Optional query parameter "uuids" -------------
 	if paramValue := r.URL.Query().Get("uuids"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "uuids"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "uuids", r.URL.Query(), &params.Uuids)
+	err = runtime.BindQueryParameter("form", true, false, "uuids", r.URL.Query(), &params.Uuids)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuids", Err: err})
 		return
 	}
 
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags_match" -------------
+	if paramValue := r.URL.Query().Get("tags_match"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags_match", r.URL.Query(), &params.TagsMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags_match", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "thing" -------------
+	if paramValue := r.URL.Query().Get("thing"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "thing", r.URL.Query(), &params.Thing)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "thing", Err: err})
+		return
+	}
+
 	// ------------- Required query parameter "start" -------------
 	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
 
@@ -2411,6 +3222,17 @@ func (siw *ServerInterfaceWrapper) FindTsdataByQuery(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "origin" -------------
+	if paramValue := r.URL.Query().Get("origin"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "origin", r.URL.Query(), &params.Origin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "origin", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "aggregate" -------------
 	if paramValue := r.URL.Query().Get("aggregate"); paramValue != "" {
 
@@ -2433,6 +3255,61 @@ func (siw *ServerInterfaceWrapper) FindTsdataByQuery(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "fill" -------------
+	if paramValue := r.URL.Query().Get("fill"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "fill", r.URL.Query(), &params.Fill)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fill", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_quality" -------------
+	if paramValue := r.URL.Query().Get("exclude_quality"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_quality", r.URL.Query(), &params.ExcludeQuality)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_quality", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "units" -------------
+	if paramValue := r.URL.Query().Get("units"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "units", r.URL.Query(), &params.Units)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "units", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "annotations" -------------
+	if paramValue := r.URL.Query().Get("annotations"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "annotations", r.URL.Query(), &params.Annotations)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotations", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.FindTsdataByQuery(w, r, params)
 	}
@@ -2755,6 +3632,61 @@ func (siw *ServerInterfaceWrapper) DeleteTokenForUser(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
+// WriteLineProtocol operation middleware
+func (siw *ServerInterfaceWrapper) WriteLineProtocol(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:tsdata"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WriteLineProtocolParams
+
+	// ------------- Optional query parameter "precision" -------------
+	if paramValue := r.URL.Query().Get("precision"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "precision", r.URL.Query(), &params.Precision)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "precision", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "thing" -------------
+	if paramValue := r.URL.Query().Get("thing"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "thing", r.URL.Query(), &params.Thing)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "thing", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WriteLineProtocol(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -2883,6 +3815,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/v2/alerts/{uuid}", wrapper.UpdateAlertByUuid)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/annotations", wrapper.FindAnnotations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/annotations", wrapper.AddAnnotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/annotations/{uuid}", wrapper.DeleteAnnotationByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/annotations/{uuid}", wrapper.FindAnnotationByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/annotations/{uuid}", wrapper.UpdateAnnotationByUuid)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/datasets", wrapper.FindDatasets)
 	})
@@ -2916,6 +3863,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v2/datasets/{uuid}/uploads", wrapper.InitializeDatasetUploadByUuid)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/grafana", wrapper.GrafanaTestConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/grafana/annotations", wrapper.GrafanaAnnotations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/grafana/query", wrapper.GrafanaQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/grafana/search", wrapper.GrafanaSearch)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/groups", wrapper.FindGroups)
 	})
@@ -2985,6 +3944,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v2/programs/{uuid}/webhook", wrapper.ExecuteProgramWebhook)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/prometheus/read", wrapper.PrometheusRemoteRead)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/prometheus/write", wrapper.PrometheusRemoteWrite)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/things", wrapper.FindThings)
 	})
@@ -3030,6 +3995,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v2/timeseries/{uuid}/data", wrapper.AddDataToTimeseries)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/timeseries/{uuid}/quarantine", wrapper.FindQuarantinedDataOfTimeseries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/timeseries/{uuid}/quarantine/replay", wrapper.ReplayQuarantinedDataToTimeseries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/timeseries/{uuid}/stats", wrapper.FindStatsOfTimeseries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/tsdata", wrapper.AddDataToManyTimeseries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tsdata/latest", wrapper.FindLatestTsdata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tsdata/stats", wrapper.FindStatsOfManyTimeseries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tsdata/stream", wrapper.StreamTsdata)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/tsquery", wrapper.FindTsdataByQuery)
 	})
@@ -3066,6 +4052,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/v2/users/{uuid}/tokens/{token_uuid}", wrapper.DeleteTokenForUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/write", wrapper.WriteLineProtocol)
+	})
 
 	return r
 }
@@ -3073,185 +4062,355 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9eXPbOLbvV0Gp36uX5IkyJVGbp+YPZ+lM3s02iTN957ZTMUgcSphQgBoAbSspf/dX",
-	"WEiREqnFttxOWlVdHVnCjt9ZcXDwvRHx6YwzYEo2jr83JoAJCPPxhcJj/S8BGQk6U5SzxnHjdALow6/P",
-	"Bp1uB704xWNka6CYQkIQZQgjAXLGmQQ0E/yCEpBITQBFqRDAFAKmqJp7Z0zhMYq5MD9KSCBSQHRdnooI",
-	"WuiEZUV1QSoRZojP8B8pIEr0LzHV3XJxxgiNYzCNX4CQlDOJeGwHYhpD/AIEUnQKTSRgjAVJQEp0OQE1",
-	"AYGmaaLoLIEzllfHAtAFTihBWNkB4imYFpYHFnEmqVS2x2yEZ+yPlOvpSCUoGzfRjEtJw2SOZgJiegUE",
-	"hXOE0SXgr0wPhTJCI6y4aJ2xRrMBV3g6S6Bx3BgQPMCDztCLR23fa7eh742CDvb6w3jQGUbtEA/8RrMh",
-	"owlMsd4tNZ/perbjxvV1s/Hf3ges4DWdUuWZ/69u6gf4IwWpUKJ/RjMQaMJTURxI2/creqFMwRhE41r3",
-	"M8MCT0E59ODxWC+1gvf669Uuf5sAQ6mkbIzOZwIiqhf+vIU+GiQgNdE7nrWB4pRFuiKiTCrARK+23hYC",
-	"MU4Thc7xxfhcbyhDGs+p0u3qAgJkmqgWes5BIsbVRP9gyhV61ehiXCEJqtVoNqge3x8piHmj2WB4qmea",
-	"D6W02MDSaeP49wa+GDeajSnVezfFV7pMOm00GxFPmWp8blbsCrCLX2miQNSsz0kCQhPLBRWcTYGpmoGV",
-	"S9TioNmQam4QFXMx1X/DBTC1zRAu1nR+sXO3YwFYgXgnXvxR0+2/cJICkhOeJgSFgFwNxAWCP1KcIMXR",
-	"o7PU97vw98cGKHWbNoaqsbF0GhrMNhs0fssZvMEqmtQM5tQwJ6H5hwYWFhk3Sygw9X+k5YGPpGY+l1RN",
-	"0KvY0216ptHH9rszpquYkprhUSVzbug4TobnjGM1EWYE0RiFXE00J0pBnrGpbhM9UhOsEJXNUg00wRbE",
-	"0QSzMZDHTcdYzdglMCJRiKOvZwyjrh+gt1yhN5xoLqrZFFapbJrR8lQhjEJO5k10OaHRBClIkuKszXwc",
-	"X4xwNAFSMQ0rAahEUtEkQWPOid64VAJ6FAuQk8fLrG7Y68bxqDvod7DfJySMB51OFEAII0JIv0+Gcb9L",
-	"CAY8GsS9TjvqQhR1fIIH0WjQ9zt+BgIrkBYoKO3IBl6p5cIO0DRiZBWX0QZcJptwafjwGkTaokbmUAVT",
-	"qbsWoFLBars0jL/Yq2OdjeOO3zTUiZXl6P3AcjE61czNMP4pZe6v5irrbzZ4HEvYPN7ScOVXOkMhxFyA",
-	"hp+wHJujiCdOAGTMex1btj1Xz6tyWtlE/OqJCDqmbAumaAvWDSr7cQe2mAujulUUKYu0JMRJYvQQqfB0",
-	"Jg2bmIHQzRTEJZ+BwMoqQsws5VjwdEbZuG4h8/4r5duURoJLiDgj0qxiktDFn/aTXd3USEj7oZd/avuL",
-	"j4tvO4tvu/qj0zkI1uO6BPiqf+ZMaaKdAza/QYSJ7iECplIzAT0YYIziajkrNDd8wUjNur5gpEC0mn3R",
-	"KegVpZy0kMau/YweGZBqhAIjj1GEGXryhHH15AmCqwiAoDbSg2yh5xaABuXnjF+et2pFt140AX+kVABp",
-	"HCuRQmn1c9bY8Tttz+95fvvU94/Nf//X7xz7etVyjBOswNPDb9Suw0c9h5qVML8VBOv9roVpcfvV8G+7",
-	"Gk5qbkHqWdGagRd+3oHctVimm7oXAs/1NrjCZhGtCsDrWI8rWhqMYbmVo5riq9fAxmrSOO7lq4R1t9Vj",
-	"vgBB1XyLNcuK1o4y/3kxzP8lIG4cN345WhikR/ZXeWRa/ZjVWjO2l7DD6NDLhS5q5feG8X5ZUijvZMiv",
-	"dxrya6egbDfe5C7HSz+xtUrJx1coZVQVdEBjeZ2gCEutHCcJ4lGUCq3W6gIhlmBrOPu9Vl/ShRrVvOBZ",
-	"JXlbfXabdTUF63mS/XGXFbR1KtZP4bHcjt51yS1oXRfbC6FrrvmNszrL/SRSCEtroRsJocsusfpPp89q",
-	"WX3W/AZVPE0pWYO23O759OnV85Id0R6O+n4wjLyQRCMv6EaBh+Og7QV4FPTDEe4G7ZyZz7DRLjKcpXS9",
-	"RF4e5bUtDFI95YSCWfy3cGmQoD9HnCltIR9/b+DZLKGRUcqO/iP1NL4XGp4JrbIp10RptkW0vxc80iYH",
-	"0UYbSUGvdcIv0RSm3Czxys4X3QOlpmaCk9T4VCqrXaxUeM4vK4s6bbdU9lIjF0RrzI+jCURf0et2p1tV",
-	"WeBLghVe3eKnWEI/QMAiToAggS+RLtgq7TR++S8ZvhzKV/8gF9H06uurf/K/F3WAcK6gstdMZpcHDWEs",
-	"zIaRqkqZaC3W+V1X0npnPemtEFvGYnfnx4az7MaEDI8ojzimV0YpYlx52COCJsluM9D0y1NVsrW6fX/J",
-	"3Op2GqsmVrNhXBnldX/37k2NjpaR4e9FLavs8Mo9UAuVogik5sIYsz1/NkRbJQoUR5gYH4Xxm8ylgukK",
-	"M7huavp+jhXWZufNKbxQrTyWZ/aHZX/QJtz/vQr3LE0SHOoalo+t7GxWYWHoRfLCsEbaaDbMHLR9JSO9",
-	"P3yaNJqNK/P/OZ4a0CyGZKus9GAZa3G3Y86t1ZcJpG5FtQy2S/vEEM5lJFwplOAQEoke6eKP7VmCwNFX",
-	"ysbGLo6NtNV/zVIx49IqGIuh/H6m9yGm49Qay2eNJjprwJUCwXDiOYI/a3xu7EQeE8rGX4woWZ0BEmBO",
-	"KiLDujE61YVLg+oFnVGv3+l6UQ+6XuAPe97Qj2KvF3S63WHYDiNjK2/Y2yXyMduQ73czh18VNThw70IP",
-	"LwVPZ7eghgwl5YG8xVPI6MC4L0rrZF0cXGwCU9VKVE3bzGGXSb/nCY3mt5g1jnIBn1GfsUdMf1jLoHRG",
-	"7N8EElBQpjhXZlV0xzFEJaLGScIvTStsXm4j+2WlEbPeOYgLPtq2T7rDMPT6eAheQLp9Lxz2ut6g2/PD",
-	"/iAK/aBd1d5MUJ5JvcKZUpWEqBbOxuUFgoI8+t/lLW9v2vLCXAoDyReqmW1EoesqgNj93gkhgo+d/npj",
-	"RRCThLIK4ng1ZlwAMUzvDSdpAtKcTBLHy9AjylDRT/cY4ViBcC59jNzg0CPBU0UZNNElhBPOvz5GcmKc",
-	"jCCmlGEFTTPnC04JSjgbI5EyZpiqbWGJqfaMG2Z1WxPMxikeQxGYCtiYlxFpv9pKkryZZ0OoVNeiCehl",
-	"2WrpjLj4zc5fryN69uHdW5Q1kflQ1XxGI5yg382vlpl+fjRRaiaPj46AtS7pVzoDQnGLi/GR/uvomeDs",
-	"cRPNwR0PyXQ240KZzt3OlNfPR0EPdbroCXqC+pUTU5onFMk7UvTCWjT5xxjTBEiFR/QeZet0rrfHClV8",
-	"CZJPd5el5u+V02qLWHtKD1cQpQrMQT1mSCNOXOCklW+nKRXhJAHizpv1Xn548fEUnbx/1VpAQABKpT2Z",
-	"X/RQwIXxd15p80C3QEV+KI0TquZm+pm73DSpuYJtx7izTSNLLDz/eSvxbQplACggvLngEwU6q+Rhjuh3",
-	"YGJWQ9mnbFcrOtCbea4YPRRFMUxpQihzcOZxfBPNsBLNZqaatwAiECXY8u9S/1nnR7bfu1J5XM87YCEX",
-	"wrcARMIvQXwJecrKuoXXK1qQhKdhUqCM7HC0uQ2g6NQcfOsOl2Glf/qY/bQBXJJ+MZ7Htd5OLCUdM3AL",
-	"mLnEbO9lFD3bpLcU4ezs6t8/L+Hw5Wm3fdZonjXePT+9S8vk3cxysmUDZZU4odPG0Bv1vHYP97wgbre9",
-	"4WjU8Uak2+37fhS1YSvjM53NKnGwFQyqGWS2YZVgX2zLTpDnX4Hthf0dGaaUR8DZjpbgGlMhldYThDHI",
-	"bIk7of0TQhBGDC5ts3azU2niJirXQT53frqtFyIH5jp/1an8wC9Xwbp+/4zLsHqcn/QU9imt3BoVbag7",
-	"ZMl6+FvD85MxDw/O5oOz+eBsvp2zuYoSDXFpkwIbAqulv5s4g1fViSm+QsYbAwRJ+i1nN3rVE1CA3DmT",
-	"CcxDVKK2Hwx7gz7SsJPoURu9efq4hd7bUCCj2OZVjOGJkXMte5ZLuZhorbHYqF9zQOsirkwYd+D7TTTF",
-	"iW7QRGbb1kCILEx5S5/2Enm5ci30STorXE61eSZQOks4XjaIX3/01TP69GvY+dR/9ez/TV69/JD8z3+/",
-	"kq9evhj/z/Rf6t+/XSXuO/qMPr3Ep3z8Zh5cvX3+ov1uSxq9Q0e4+WZbT3jLlT64w/fsDl/j5+bhfyCy",
-	"Dpnc31pD6nfl515MbzrPPNt36MTeYUZ/thM7L/xXcWNrgMuNDux697Pb2zRjnRs3+OCDPvigDz7ogw96",
-	"dx/03TEhd73sgwPNDRmRcNWLVxNKlxP8VTdRxRw+Qna5wOqzuln0aHFDwX0v82twFnnWO9Kqn+R+HOXm",
-	"9kTB/WB6ad3QW56TbcUdJ2XuDRQ88kUwVZH3DEtpP2ERTeiFJfWCUM4K/oxO+5PCEDVNcjHGTJttZiek",
-	"ZgEcLa6l6kaWhrfq07+BDms9+LuS4z7c+Ct7yTOHsimITEHNH63stzfaDL/DUQQzwzwZQVJpsdhC/7K/",
-	"P0lAyidITTCzpqoxUkNNvP8x94WX7pDVnCHUrOyuZwqe8+ov3Vt79w39G7QGip4KGn1FHzgmTfSRp2qC",
-	"XjAlMIvgb+gUpiZiJRWVNFF71uDOGZY7ffanEpZaTMbS1suT0xfmYELL2Ytxe3IfZxOWFy4tTN8ftUe9",
-	"YOD5cTD0guHI90Z+GHntXjhox532KG6HNzieqMe3KXhTfLu7rTtA/EYIv65xqDt/+q4M5JZOdmOVVQA1",
-	"k8xa0NqIL9nSmyxRfrUcUc1bzU1cRcMErEJ7bgt/wYScm2XOvhAw5RdwbpcwB+OSo+/Tq+eaNNyompux",
-	"uuitAhmELOZgPfgSbjKZPQ3arkhVBgD9/WLoseDThzP4jD9v47fVo98W0NZON1eVzTifYuJ01SV4a755",
-	"NEswZX9D0QQLCervqYq9YRnn65zlL4TgovJkq6CMEpemAMXcSBQ5g4jGjrBaeimeGY5B6i5FOJ02uxxx",
-	"iSWyPhZiaj83Pphdaluvja39KxchJWTlQHSfq/OWq9wxrnh+11UD1fqezMheMeul/Giuu9vG7m+MWe/Z",
-	"bXuwBZt68L9mEuQe0eRQU0inYrbS4ipldjPfcpXd/99wwybLLBACMDTN6lw3G6ecv8Fs7khG3ucsOUdT",
-	"zOY5Zql1j+RIKdxcbTSLWW0qs6FUjcHVOVqtYMbzieFUTbig34DcK9RcWppUTYApxxk0jZucODiRZnOt",
-	"nN6Fzi2L1NC4zm48mfXKj5eXDnsWbKh4Mbc98PyB12mftgfH3c5xZ7jTxdzm8mH06u+pVTOMNNjiBHDp",
-	"RLr+6HnllwRL9UVABPQCvpjh3m6qGxXOxdF2hdsZLihP5Zcbn+cWjr53OrBedzD90I+hb3TIvAWmMiNl",
-	"pdn8uHn96U1+y3D7K02Lm6j5/W7bWe1tJ3c5NCPTxRwXWChSUxXGqmjg83WzUd6lghNYQpS6mpGgmjeZ",
-	"s038n+yWhvn3EgtmvVWU2dU2dpSZSpjq77U1ap1NBPITgOVzo7z9lW0owqEwOj4z0VNRwqVZ86sZNaa4",
-	"nEBi3VjRV8YvEyDmlnfK9F+s3K1rY6XLZ5zAB7iwCTRWeeUEoq8ynS6dQQ2iEMIYIIz8XjyIegGORt1u",
-	"PwrCIAwhGnbbnc4A94P2qNfGQUhgAIT0+n7Hj4e9kd8oXevtByXvn/5zZZR74tmu2S/hvELV19r4yg3d",
-	"OO4NMSFtrzPCxAt63cALB/HQGwWDMI6gT3AYVHOmxRJXiTX7q8s2U+wxWJ/5pdmwMZwlBrAT87b1Ny7B",
-	"bre28ukW6biw2vmwi/03F3DTxFqITqkHZYUGOcGdXh9lhRbRKFbJueO0TeuQunJobzfF5Xqz5ZrIGCox",
-	"Ze7o5NdnqNvtjppIgk0b12v1y06se4L9wmVV7j7u9ofdIA69IRn1vSDy217oQ+D5IdG03Q+jTm99oEq5",
-	"w19pAu40ME+NhxXOUift+3pnvd90kc/RZOAzWWLQBF8YF15o8j/8kS4tzpvX2sqABM1Pxxf/PfhW7S/9",
-	"VneSUYqesrdpKSukoDIRU61GRXaoVb5wA11ijSOzjIiCE1PjF6NLbK6XGWes2T5Pgs2nYMMGWtv5Lckm",
-	"0uEx0pLdZYr7U4jHjfJ+iadmUwwCCzlFl2IJwO+MIhJ7QQzgBR3S8UbtUd/DcUjikIQjMow3XuBxKt/K",
-	"NdyMBzs8F/l8to8lRC2x/8IqOqhqlp97P5YyXemv0RSkxGMoTXH5l5WFy+OeNoUzbRULvdiIQppTGAw7",
-	"3SjygiDGXuB3iaflikd6EQRD7PsdCHZa5c82HNzogh9glsxrwi4Nn7Gp84BYoYIZshkBSs6z5WjH1Tng",
-	"UacdjIa+14mGIy/oQOBhf0i8Qbs/HOF42A/7g+3moAe/iMw63BpeCbfawkrb6hrxFsjsRdAj3Yh4cTwa",
-	"eUE36Hi4PQIvJmE77A39Xnsw3BaZN7qJ3GwUYrgOoVmH0Kz7Cc06BEhtCpCq4hbBgGDch9ALSTvyghEB",
-	"bzQYdrw2jIJOB3f8ftzbUVvY7dZvQQ/IA5IqPbeVqteHsm76afmuU48Mo06XDLwuHgy9oN0beRgHvgdd",
-	"iLtkFMbQ621NnbsGLe03GGl3vBfcQiaE5ygL6dlKTV+BDun0usNRMPJGPoy8oN0ZeMNOr+0N+gEO8CDo",
-	"9KNdFc0MMw5CJd1xAZNSNNA6rKz6yMsxQLcIvFkXD3MXW1YyynaN/bjBvGp8xNW7Va/gL91rLa94eZzF",
-	"Hc3urG51ZNPxPb/r+aNTf3QcDI+7fsvv9nY05yrpu/Ly6haE0B4EftyGwCOdqO8Fo6DrjUaDvjeK47YP",
-	"OBz5YWdHQsimnq/Ob1RNPpqRbWPWbD0ZmTe5qGy/80yd1r9xEJLgG+6//PYc49OgS2bJH8Vl1ozskgvy",
-	"py2Vm4JZKfnBXAerYAzZYdJt7vjWGeRLQqjAn0pux/aw3e90Iw9DOPQCDF1viHHPG3R8Mgr8YXvUhW2n",
-	"bmbjZswvV2er5J17Irejq4uq0LN5hYO722oHmy7JX+geLIfIArjq4rK22lbrFVgTrXMHfoEeROGQhJE3",
-	"CgexFwDW+lTY8QZRZ9iHaDQgw/6OAHez/Hxt6dWcWH3UU8pCgCSNTlI9RHdYb6J19beLjrRSb0/nKYt5",
-	"dvyPrQHtkom+pGqShmhmtbJUJK6eNgbG5rdWxKdHEpLYm3CpFp9WDsIbv/yCfoMk4lPIboUblYPiBBEe",
-	"pVNgypp1zu/59t3zE/QRklg3ZzToM3bGTiegP5eerBmiCCsYc01fx7qQZ7Q+qT+YDTafjDOCgvlsI5zN",
-	"p5wy9V/upMGWd7af/mx8KRI9On36/LHu4MUFiLnR9ZHbJInmPHX+4EJcgwl9PGO//PILOilFO5i58FJR",
-	"04I2Dsbc5TRhoM0N519G5zgyd9m/wvzcGFeAowk6J3yKKTs3tS+pnBiOY0rmC5aXMVH41L6DcZ5KEPqL",
-	"czTDQmVv/whCGRZz9I/T0/coB1IWb2Ef5CiNJGsu4/rn+Yzt+SWKONGre5IkNqhocTshu64744xY25Ez",
-	"QDzN/f82gkyvhiy05fY48H30FOeXelv2uzYqRrW4L+1TITZuyH4zQs84ixMauXqdEVqOx5Hml57vo8rY",
-	"KDPNN8XyaIrnCCeS33xOHd9HH9Ns9/Tf7exv5C2CXTKPni0SVBVxftVmFvyGuEBMj2yWzLNMCHmUtGlo",
-	"5UUVrxRdc1QZQmWDJDVrZBKKnOP9a6/b8j3OkvkK6+AzYO5IhYvxkastj1wlG86gDPPMuYCXsYFGs+Fe",
-	"amkcN/xW25bXTeIZbRw3ui2/5RtzSk0MNzy66ByZ++9WQEDF0dNrKlUhbtxelzdei/wVilfEHFExYnlB",
-	"o/xW1O/VYmZR5Kj4vsh1c2PxwuspW5Suegpgi2pL7zdtU2P5waUt6qw+SLJFpdXs59tUqkxHv0PFlzet",
-	"uGO15TzqW/W08trC9eel0N+O7+8U0r4xIKkqei9P8e5o6rrZCPx2XXP5+I5KwYamUndzpUW0rq7RGW2u",
-	"sRzPed00/tqN9aqib4vqlaHxgmL1uzmGOHaL8FnvhUynUyzmmvuBKvAQa+v/3sjLNhszLm/Bhmz89Ekh",
-	"p4fN3j6vn2YhwftRnt39egU/7TvDT/ngqgJHzzJrw55caYGYBdXb0Pe/LrKseK/Bll03l/jKFKnE2HWz",
-	"IPiOvmv74doizhyordqC5nuJsG3TvGxBsmMBvTGrMLRVzC4/nX/KIwKLeAo2L08Wzm82bovlLNxv+MsC",
-	"xG7icXlzl3Bi1xXh/P7FGrA0q9WiD4YyF5CY1wAhV4vqYHAfYsklOSoxjwOcdpVkNWAyAm07JO2mFi9e",
-	"SNEdztIKFC5lscpguILCQiq5Ag53lI3FfHTX1eys6uGFLNLlYaNuG3acX/cxFXqV70dSYj01cBWB/frh",
-	"YdruyHpUZ8jaBthOnhLnIdralMwqtM7YSfZH9hK0veFHTQY9d1pvsiUqLvDYxLiVklnZ27cuz5h9IhjI",
-	"IsjO3W40B9MxjoyjJ3vSbl0fCRb6gxmMRDKNJgjLv5mHVtOZbKIpjiaUAUrARr3bgCPZRHSKxyCb6IIS",
-	"4F6U0JlEoKIWem1ajGkCEj2JMHuCQnDXhhGWNsQCW7eRiXTMbwxqQBF7gxOHkiepMlnl6DSd2pI2zdsj",
-	"Op1xd4L+nks1FvDxn6/Nc39P2i+fPmmhf/BLbZk10aVuHWGibSeEx5gyqQqn8yfvX7kby3ieDUkJzOSU",
-	"Spkv+fJa2ZlN8dxGgWrORC5A6CWfznCktNrkLvlhpvs1J/mCp+NZ6m7BrwrQzPf4sDwLK5bqbW3Ordzy",
-	"WcT3FjlVDb3xOKezg+DfVfDnK1ch83PuVWCJhfJ1huwiM2+xgTLmT0gR8jcxYgso2ZsZm/dRa8AeALe7",
-	"ZVsHOY0b91sN4pbE8E6GbSZ9tzZt3eYfjNs/w7hd3uKN5u164GwycXNwrDNyNwDCvw+2s9AiD5bu7QTe",
-	"drbuJljtzd5dhmSNwbuKyRuZvPXCNKgMHDEjO5i9D9Ts3QDxVcP3JlL3CEsJ09AGoC+RgXmZ1qafWLxN",
-	"m2XvfvO8t/aJ2sKd4G6nHHHTqQiU+V75RO8MC/U2CzJa01d2p7a9GtJf17TN8f1qt3d2m7vyBqdZL2nN",
-	"bskdBb7HQsmn8/+C+bI0CnaURuUwquwiVSmQ6QVTVM1POf84xUmyMWQpa6MqyfM7ZszpR67M47+dMYQ8",
-	"9KTcxZNj9MksNaIyd3y4LGOA3M7l+VKcO0XSb9BCL3A0sXEt01QqE5WjUAJYKtRDb54iykzBpiPm3C9i",
-	"LhXqei03IpesRC/0k2Nkxi3QlIv8fuYiUY2uJlHE04S4QIk85GS5qXeCgHhyjE51l86CtdWzJDeUISwj",
-	"YMRkR9TFW6a0LWXqZDNbjIAyW1SLDDN5G0bXOmMPmt/+iCw0I0SbbsygNINAC50+fb4bJzX1NvgUkyR/",
-	"v6XU3YpeoItX8YcqFr3E2b46RrIvplbFon4KleHHU3MNqDbitVZHNWwZci5buLDvac0weyCjQmnVNR08",
-	"nUKwDqAHHeKuyK3zoDUCzalcEi/L3H42o+LHtBO2JPPdJZ7Al7Xy7mWW3hxf5ukvsmSeRUulzFlegvqA",
-	"L+/URbPIM2aCoisBXmyBRwqUJ5UAe9X55i2ZXCa3auHqtg2YDCo7t2ASB0by4oY1N6cc3NzUqrXxX+X8",
-	"iS8UHm9KmWjKmLa6W5L6m0JWyQPj+jNVm+f8khnGlXnQFjmf7taFt1ke0/gtZ/AGq2iSieUahugeDlt3",
-	"lPEMswiSnCXaGhsOLywLr9GwdprpXdgLn3/eQ5QfnK62O3WpRuB6+6HyhPgVo4rihH4DhDcCelF4CdRO",
-	"xt/GBX+HKnKu0m+X5buwBNM0UdQoWLYN94bhjRB/B2rfus3ZrOktrmCuOXGTCOeOL5ecvfLE7WWWX323",
-	"Pd4tyKUUQXMvAS41907rw1vcoh7O+nbUFPKbsitHfAvUZVDOy9YxrVLovbuVaSpVRre8dK8i3iS0JcfH",
-	"3gJbXA+HsJY7DGupBtsiGCrHygriSqxzi6AWkge1SMrGiYPhamSLScaUUJODr0pJNCj46eNbfg7VrAyO",
-	"unCYjOtUMLV1ATAWPyYTQK0Y3n/YSy1TOkHFZ2EPcLsnubkmQkZDBeGQp2o96PYXHpO9OlwVFLOM1xuF",
-	"xNQJ4S2299PPGRjzIH3Ya6Gao6UWolWi92jmkoXsYMXgJEFZNYSl5BHVELA5ILQ8rsar5q5ZapJfuVgo",
-	"jfs2QVxy1i1sEJdd4gDmP5frGlMwg4p7z3UvjNdRxA1oIK+yDuX3fMGl4nX5R/LxIv0JMslqq5ybi1S3",
-	"stGsIq8NOfvux5Xws9HxAyTLHNbrKLJAhYXym2/IuP2rcCDkv9zEg7CAxd5cCFkXBx/CHfoQ6rBWAZgK",
-	"uC2x7p2ux9QA0RawPx48BT+Ep2B5+w2UKpnT+jsx7qn8uvsHuVCf798zUM9rDtrpfYvBzbDan9Ffw6Ts",
-	"7ytgvJHZXys5/7p2/49/IWZb7GYC1CX/3MX2yapUssnFj3/5y/3ZUyAHi2WPrDrDWxnni2832yWLh0dW",
-	"DZP8pxtZJov9359pkvVxsE3u0jbZhKol7rm1+YFwLdyc+WF/PdgfP4b9sbT/9UyoUrY+B4VpIvPTpTpo",
-	"FATrPRgg9RzlYIHct1jbDKz9WSB1aHTGwwoeb2aD1MrIw+Hjw7IrtkRktWQ8ijiBjddgplwqFKVCAFPo",
-	"kX0m+TFySdAXz8QSqLwT84wT+FXwaVFpO/DIvwyPtBDbE6OsNCHcpTFtQ+i+0SNrT2QvgT+2T+I4rLTW",
-	"2BelF+lXWGkBsfu7OeRum+7VVilN84c1WH5w0lmycLYinhqeTmgcb+TpupBNOnHJLZlk9CGrmHgFRcjn",
-	"up+N3Hx/tHFg6X8WS8+hYrG2B+beXPV32i7RSU2whICLL3jtXbCaBl2KEpPc5AInKaBHXvsxEjATIPUQ",
-	"Db3848XJ8yZySVoYXIJUOcW07Ktc9gq+V3MHv6b3p2umEz7U6Xyu4TwLFrKO/dhXq1zJovroYopqJXMN",
-	"H7qXaLWykDzErP0AzGkvSucm5B99zz5+2dbzWJK+rfUOyA3AP/ghH7IfshYl9yFATzMmm/WMjH8oz6US",
-	"ODk0w2pSEkPZMLfLQOPfRFyUl+NI0jGrSOn3g06+xp/3kY7ZMvGv0L4udGeUf3DL/WluuZ0pv4Zissf1",
-	"b0MctX6TE4aAkRmnTKFHrqfH6HJCo4nWzC6xIFZ5dI6QDX6UF1cQpbng+s2NvFpXO+hOD8XhkCFsKfrz",
-	"9OnzxjqgmufXN0WrFIJVXPmqE7XT7Kd7u3H/UANVzEocwlT2aDs4GJa4cP7d5hAVU7TKgXzqfrhJeEq+",
-	"63tz+LoeDqEpd8hH1yKpxCR3iok3W1UbAG3LmTKHNyL+DAOvvKN1bGS9SFRrtziXiPuPMKllCwd97H7l",
-	"0SY87S+2xECgVRNasgzDGwWW1Em3g/36oOzXCiCu3GnPwbKVvNv8cuGKkVB4nqv+ubpfuVhoW3vXyOkU",
-	"JAgK8nAG8GD55lHd617mumyGG4SljWSybox6MN/BaUF5eGoBot3sZaRrIle1UlGgU/hofj5QxYEq1jNx",
-	"QwyLnbtXctiWAIrXmwqV1kP/4Dn6OSnyIRLYYpnLunrx+y3cSGvY+gkpQ/tGHqUSGvbnVip0c/At3aVv",
-	"aRuYrfDWm2RxLCBx91yOC5werkn9EOEJq1hZx8U2eLGKyFnny9oIEv+eGNJBD71/MbkNzvbo3co7qnVx",
-	"5SVu7edaJ3MPzq6H5eyqxueqw6uEn52ksPFJbBUNKDAbm7cddQ376lAJuWfsjD158pYrePLkGL1iJpIf",
-	"BLAItOmmhTX8kdILnABT6OWL0ybiLJmj8zGgs9T3u9Hf0VX+KYFzRGX2hGULfTB5/ykbm/cf3WDOKZOU",
-	"wHkWq3tJGeGXrTO25uWPXwWf3sIm2+mNEzPKjwoLtVuVF2z7PsZGFRPvxIs/tk+GAVIWKtz+7ZEDUd9C",
-	"ubEkWJe2Oie7gktEV6il9BqF6J8piHmJYl3TMRe2QU3Av/zyC3ppEYW40ASLE4QZQa9BysU30QSir1JX",
-	"OJ2ABPc3AhtZhXCswEbw4/FYwFjzKL2IqTIU2XSxW1PATCI1wQpxBijCbJF50sXe6zqQvfrhrg2EqTIP",
-	"wbpClM1SJdGYW+bgWE1lx2aKOb8BlMAxKnGfdx+WWJCe+nmSVfg7Gi/XKBUWgEKuJpu4Fk9VBdsyfa3n",
-	"bHodZhApepHMq7ic2ePFBv/KheZ4Pz6Pk/QT28ETdgcscXOFmYDIhEtuXSOH5PY+OjqFb5zBvXro5Ad+",
-	"eXCXP2gzpVJimBtMNxEX9V5Ao+mZuFvpvO/1vu4TYk4+T3mpzB4ZT4kpfL6hA1IaBlnjfFy/mQW/oVOY",
-	"ygv4ltv1o0yC0EKMpLBIruxCa0KNOyzmToIeiOlevZbryCnHv+Jl2K81r6S9LVl3emSVMG7fypdwAQIn",
-	"pvXMQ3VQxn4aZcw4FaXezKfzf7o7tEvscPkpFweC0qGi8Vga9isBC3vjoXhD6PdGezjq+8Ew8kISjbyg",
-	"GwUejoO2F+BR0A9HuBu0ofG5+jZvlsO9/g5RfU73wkPibX9FX/hprOO/tCpoSOYQPfGw1EEnZJYElpUt",
-	"mQCxDrqCaNnkGUylewO6UnC1WtWvUH4ytX62Ryj1rA7xCXuEsAXbEoBXQ2t0MfeyWQm/WfXNEQy6ZJWp",
-	"8sl+fxObIQPH3sIVbAf1gQrNRkLZV9Ot9RLqCk/n5mTz+PvSXK3T0a5kOEfp6rvA340S0DjWY7IzaoWc",
-	"zH8x1pjZzIzQn871/6v7iSkjt+vFnmWsm4s9FblNL9cHSt3ZXCrQ6jL9FUXH0RQ2Bs0ZM8Kl6zO7+GjO",
-	"08cr9PnbhOMpbTxYTv/XZtt6o5c4928TjvAUvWpsgMgOWY8/VTHuErs7BPI8/LOu0rbXnXC5rV4V7hsC",
-	"cDM5UBvSsw4o/t7F9cEgul+2VBXBU1AU9xa8U8mpSsrMreJ1atTNG0XqlGfwgqoJCHRun0Y816RElYQk",
-	"Rjz/9gsmxDjejgrfCZjyC+diyxxHLfROIMmngLhpFfTmtQ5vt+w1Pmgde13G5xaC+c4fd60hjqWXXXNb",
-	"7PAg5F+bdx+Vn/i7SyZehXaBrQZ656Lh2cQcG5iTD3v4kGcm0n26bJKVromPoNzKf8AKisRxI+FRaOsQ",
-	"7fnQoz1Xwbk219E6Rq74V2C7snEJkQCFbN1dePmpqXGfnNz0eGDkD5aRO/wtH6mbvXAAa9y5lr7pMpvu",
-	"NotElnOpYGqTETvcX9IkQSGgMTANcCDmDFgYUgFSmY7/LVwaJJ7yW/iTcyzv7/6b7uE3qiYfzUwPqfQf",
-	"gEN1PaW8dBh00MUFwmntJAKOvpt/v2zveLNkYlUUjeq6vL8GVLU8/+CHe7B+uEpk1PjmNuDurtMAG0xl",
-	"/rw8wqUR9gdk5A/aXtAPRl5AIPAwjrEX4gEZkXAQdkncqEyUu5ji2hiX5RcdPq9dVLtWZgvsrFORNI4b",
-	"32eCKx7x5Pr46Oi7/f260WxcYEFxmFjKyMpYAoxxmqjGcWOi1KyxzJLfZ0WbDWDpVK+7K6f/sctveyk3",
-	"1u4MWn7Lb7WPh/6ot9KsxQ769OG1lgMLM2s1GumTOaHBUcRTph4jKnNnjwkGddiYADp5/6oQUWSwsbq/",
-	"L43vyPiMihkdTACB/jAT/IKSHHOCjieqtWjWup4q2n2fOx/EonKagDTCfb7SoR1HoeXc6Fxt+8SluaPS",
-	"JEROEohU9rJUIbIC/TbBClGF5ISnCVk8aYAIzIARiThDc54WOnVZKyq7LIQD0sIVaBPVIZUAm9M2a6h4",
-	"nW+FqecpZYQ5NjULIBXXS2J1G0HhYtF0GqlUgERTXUKTcAJXSE0wK0/3GWcxHadWJKCYJmCiEeUUJwmI",
-	"RaCgbtbL+x9zTpAj6uL650lxKvbWpXM19U1CagnjqXkrwkU3EgTWi4klmmFhbRlmXZDFCujRlJM0gcdN",
-	"XTJ/q87GO4qUSQSaKiRHPFbA0CNX4LGemK7BEFxZ5jtHStDxGDQdRNpuylMSF0GVJaJdndRHxQUeA0p4",
-	"5BZQd5GAULKFTlCoOQ0K0+irscXQFLOxLq7ZCE+lLYkYVzR22mBxMW07jevP1/8/AAD//2Iuj5n8NQEA",
+	"H4sIAAAAAAAC/+y9+3LbONY4+Coo9W9rkywlS7J8kaf6D+fSmXxf0knHzuT3TScVQSQkcUwBagC0re7K",
+	"c+xr7DPsvtjWOQBIkCIlyrd2z2hqqmNJuB6cGw7O5Y9WKOYLwRnXqnXyR2vGaMQk/vlK0yn8GzEVynih",
+	"Y8FbJ63zGSMff3px1N/vk1fndEpMDzKJWRKRmBNKJFMLwRUjCyku44gpomeMhKmUjGvCuI71sv2Fazol",
+	"EyHxR8USFmoWQV+RypB1yCl3TaFhrAjlRCzobykjcQS/TGKYVsgvPIonE4aDXzKpYsEVERNCs8GIuGSS",
+	"6HjOAiLZlMooYUqRqxnTMybJPE10vEjYF551p5KRS5rEEaHaLJDOGY5QXlgouIqVNjO6FX7hv6UCtqO0",
+	"jPk0IAuhVDxOlmQh2SS+ZhEZLwklV4xecFhKzKM4pFrIzhfeClrsms4XCWudtI4iekSP+sftybDXbfd6",
+	"7LA9HPRp+/B4ctQ/DntjetRtBS0Vzticwmnp5QL6mYlb378Hrf/d/kg1exvPY93G/64e6kf2W8qUJgn8",
+	"TBZMkplIpb+QXrdbMUvMNZsy2foO8yyopHOmLfbQ6RRArdkH+Hp1ys8zxkmqYj4lo4VkYQyAH3XIGWIC",
+	"0TM4cTcGmaQ8hI4k5kozGgG04VgiNqFposmIXk5HcKCcAD6nGsaFBpKpNNEd8lIwRbjQM/gB23mzAnZx",
+	"oYliuvOFf+FtM15ARvOY4z/0Gv5R6XxEKI/IKBQp1yO3ikuapAwOET+N0/ACB2qT0SSWSts+CYU/sW1V",
+	"04glmuJS4BdobNvOY56aL3G0+hEk1SwbwI0XxUCCiG+G0ELBI0XGTF8xxr1hYY0JXTc+nrakSTYH0EP7",
+	"isXTGeA6lYySlAMzqANKAB/N+P/f/404pjrkJyGJxTPymWhBPs/MfHMWxRThvxgeWCAuhsMRUieyFMF1",
+	"zFORKnLQ1bOADA/0DNsNh3oGeBwCpSZMmQGVjiJ2mS1fmTmVpjyiMiIRu4wpYBkiwXNcMXIJyTxcgt6w",
+	"y0nMWRSQibd6hLs5BSHz6ZAXAaYnFioB7iBhE01EalDus0Fd4EAGdwWhgK5MklHKYz0KMqSzyGobs8gA",
+	"BnAzcKce2GUE/qGZZnZNsADBkyVRIU1gHzCkmEwMCbSCVgxE+lvK5LIVtDids9ZJTtMFjsN4Om+d/Nqi",
+	"l9NW0JrH0HtOr6FNOm8FLVx2K2ghmrWCFiBZK2jhSltBS5rx3DqhM557K2gthgf43yGMhQtvfQ0qOBzj",
+	"lz/FiWayhtecJkyC4LmMpeBzxnXN/ootankqLGaJ3Hki5Bw+s0vGdZMlXK6Z/HL7aa/DJI3YLylNYr2s",
+	"mfkto5cM8IxEVFOyEDE30krPmGLkN+wcM1VE5dGYRqOAjNlESEYoX3rMOFaWybKoQz4kNOZkzqhKJQPI",
+	"GeHJGYjcDMV9UfJra0yj1tcaKJgtfTPLWhbgEWs2V5WAsV9QKekSMWISJ8mWgucj06nkcERyadlVxiVH",
+	"SlPp+DjjkfkLJvGYmyJXsZ45QHfISyObFNDyiAvORk644AdDfhJnVYUhTH/TMk2SEcgllfNUODs2X+hl",
+	"1kkL29J0Wkh2GYtUjUhIpYyZJ1EuuLhyHHgi5BWVkemTxJxROSJAhnIhEqpZUUioVEqR8gjgZlg7djwl",
+	"PJ2PmSxhT3cUNFm1nlFtB0DY/BQnCUwQK2DegObA3ibaipTRlDlZykYknLHwQnWIY9QWV8uC8kkOjyDb",
+	"51Ng0PnAntx7kjcBPM7gWkO1gANFbpipbm7aVhXLmkpGNZPv5avfavD0H7gcNRNpEpExI7YHLJwBdQD8",
+	"nnxJu9199uNT1Jc6NWucsiq2YsCOi4knPwvO3lEdzmoWc46qgwSaBtKn0in1Scy4/j+VuQo8UYxrg8Jv",
+	"Jm0Ys42DPjXffeHQBVsCssRaZZcCq3g7hcop7gGedjwhY6FnFu2+8DmMSZ4g8sQqKPQgM2rF44zyKYue",
+	"BkTna1cMdR8aXnzhlOx3B+Rnock7EcFlArR1qlMVZHRMyVhEy4BczeJwRjRLEn/XuB97PQhpOGNRxTbM",
+	"RShWRGngFlMhIji4VDHyZCKZmj0ta/zHB/uTyXD/6LBPu4dRNJ4c9fvhgI3ZMIqiw8PoeHK4H0WU0eHR",
+	"5KDfC/dZGPa7ET0Kh0eH3X7XIYG5l+VYUDiRDVcGuB5tgZrQvAIvww14mWzCS7yOrMFI0xSFGcoGmNow",
+	"1NopYcTCrPYG0TrpdwMUrFSbi83hwOgx8Tyd2/vPPOb2U7B6AwpaRn3auN7CctVFvHCcC4WMVf5Ckdh7",
+	"UKZ3gmpWsy0zc/W+KrflNtKt3gh/IfgkicO6zfxdXMEiZ5RHCSvoFbm2rOM5U5rOF4QmktFoSdg1XpTt",
+	"jeAcfmcgnpxYZFIKOSITGls6k/ZaCkxAaSHzK1xAaKKEuchRbyoRhqlUZG4EAdzHeZjdZexwmWRGLvRf",
+	"Z+9/BjKP3SUhnnIh2QhPxizD3x8sBRVa/GlOqCKjKF0kcHtnatQhnxsviboFBZ7MyufCe4ZiUXG5P7/E",
+	"BcMyXpz9o7BwMHJcyRhuH5ItEhpaHo2Qi6x4K66/uDWVj6EZv81eUJ5WbAUO+rw0rdMRYX49Y7Hc8jhR",
+	"TOOWQFdQcBNaMKlYxKKRuVxWwsxaGBaLBD4Ax485YTSckTEwyHqJL/i30FJHjeDPDqJS8gsZT2Pe4LJg",
+	"Gtatwv24xXXB9NnWHqOp1Aa+8JtT3iZSzA0IjVGsoOz2u91uu9trd/fPu90T/P+IPKHkneARXT6FAxxB",
+	"t99RDwa0NjaxqzjSMxUQZTXDK8YulGGLRHDb3egEhWl63jSrY88F1zNDuUtGpVpztKtAzVhnRDVrw8CV",
+	"h5pBrAa6r6VIgREmOXIDI9QiAyiqDLEyMMDFWg3Yu3iJBZNooFBEGBqYwrgxn1oWesrJm7P37ePDbo9E",
+	"qWlb0sw/nB+9A0X4w3nv7/td8+f+S2Ow+NB7N7Ja/WtBEH3qhhl2UZ3uz0zP3my/C/YHdq0Zj/Ao9QxX",
+	"CMYL4JEj8gTOPiCjqxF5AicLf8/FiDzBA3pqFPvliDyBU3patAuN9iMz0eFcmCW+58ypWXB6EcmOQIHZ",
+	"LpTCmrqMGS9JYu+z+dP8wlPN8r8O8j97Xe9v7/u+9/0+/g2GLPg3okv4BzaHTWBf8AdsCH9nIY1wMrBL",
+	"pXJp9gSrY5zHdFQwPPl8DcltZPBz5BjgFeBTmIjwArEKwJGjfkAoiejS2rqkvccTsPaAtQt/y2VoRJcJ",
+	"mPGIopeogcB4RoUGenlXIiGzNjeUOWL4MaQcle8xrHw+jrnDBEPf2DAgKg1nyKk/9N71X665XGVHukFd",
+	"lbDOVzyqIb5XPPI0UjExu1swGYuoQ85n7m/yxLAaLQjj0VPczbNnXOhnzwi7DhmLSA/3v3K3vxp1ak1K",
+	"UStogaiKJYtaJ1qmrFpq9Lv9Xrt74HOz/6vbP+mCQt+QCyEckGHXQAJ/826NDwsLHLE5NLq3hYa9EjYQ",
+	"ta5pzcK9n7cQt3DnjDdNLyVdwjHYxghEc78VdVRhmzY0jc3p9VvGp3rWOjkoG8qq1nzJZKyXDWDmmtau",
+	"Mvs5X+b/kmzSOmn9sJc/Ou6ZX9Uejnrmeq1Z22u2xerI69zQYi6nG9b7bcrufslvt1ryW3v7brbe5C7X",
+	"G3/ia2/cZ2+QiXsGDnxdOyUhVWD5SRKjs5PYNBhTZVQAYt9oa40B0KhGn35RSd7GWNMErtiwnieZH7eB",
+	"oOlTAT9Np6oZvUPLBrQOze6F0J2eULfO0N7HrIJPoG2J1X86f1HL6t3wGwR3msbRGmzLjHqfPr15WTCS",
+	"9Y6Hh93BcdgeR+GwPdgPB206GfTaAzocHI6HdH/Qy5j5guqZh2dpvF4il1f53TRmSj/HiyO0+ZldISbA",
+	"3/AIyTj+ibfJENXlvX8p2MYf3sALCfq7tkMUdutj+wcpQqYUiWIWkShlAOtEXJE5mwsE8crJ+89WJQu0",
+	"iFJ8N6/sdrnS4aW4qmxqL0aFtleAuUx2puIELfHkba+/X9VZ0iu4768e8XOq2OGAMB4KuDJIemVePgon",
+	"TV//Q41fH6s3f48uw/n1xZtfxI++DjBeVt2zc+lfWjQbTyQeWFTVyYlWv8+v0Amfqxo+P+Vs/wb8GDnL",
+	"dkwIeURxxZP4GpUiLnSbtiMJjxRb7QDoV6S6YEjcP+yWbIn7/daq/TBooaWpCPf379/V6GiODH/1tazi",
+	"Q2z2MpqrFD4iBfm93cz8FYm2ShRoQWiExgM0jC2VZvMVZvA9QPrmXGjqyPOGRA5o1uA6MmOEmulYVFTI",
+	"CwzXPEIGhHLzkEyozh0LrKGNG3tMK6i5WQxP9rfWpQOruTe6TtTupXZJxzdQ7yuxfk4BCTnlIdsS29l1",
+	"iRG+Y5pJZ0atZBVohlZVcgu+N/4bBgzFx++tydDYyCsmAtiaH+tnayYot1hTiWbdlQ4hWEl3GRltQ3wv",
+	"qaaK3Ua8et2KC3phfii/NG4SOj9WCR14G6bjhJmlVyCJ65A7yYTqEvWSuBW0cA9Bax6rEEAo5kkraF3j",
+	"f5d0jhw7X5LpsjKD0Wp81J0IgYNypw3ur6Ge8mERmimo7FqThI5ZosgTaP7UOGtKGl6Ahcg6QGgGQ5JF",
+	"KhdCGe0+X8qvX+AcJvHU2hC/tALypQU2Qslp0rbS9kvra2srogAK+4Z63OoOiGToChqi3kQJkmNhUQeD",
+	"/vDgsL/fDg/YfnvQPT5oH3fDSftg0N/fPx73xuF+d/PZlugAjyE77yBDvyqSsMi9DT2g+fgW1OCwpLiQ",
+	"n+k8s6GiIbkAJ2NsFnITMlVBomrbuIdtNv1BJHG4vMWuaZhp14760BiA81Hg6ukiMp8jljDNihRn26zq",
+	"zZMJCwtETZNEXOEofFkcw/2yMgjCO0Ni7/W/1432j8fj9iE9Zu1BtH/YHh8f7LeP9g+648OjcNwd9KrG",
+	"W8hYOJXTc9qtUs+qNeNc1Oz9H8Uj7206cm8v3kIyQAXuILypqxDEnPdWGCLF1F4eb3wLo1ES8wrieIPP",
+	"wBEyvXciSsGJFN5X3HsIeRJz4r8rPLX+RMZZhBK7OPJEilTHnAXkio1nQlw8JWqGL0FMzmNONQtwz5ci",
+	"jkgi+JTIlHNkqmaEElM9QCVp9VgTyqcpnTIfMTXjU1HESPNVI0nybumWUNUeQApgaQQ6FBefzf4BjuTF",
+	"x/c/EzeEe83Sy0Uc0oT8ir8aZvr1yUzrhTrZ22O8cxVfxAsWxbQj5HQPPu29kII/DciSWccjlS4WQprH",
+	"cnsyRfh1yeCA9PfJM/KMHNYou7oARUDfS2NOyP4EJwUWtb7+mbJ1voTjMUKVXjEl5tvLUvy8Eg5gMNY8",
+	"87BrFqb4tq4J5cZB8JImnew43WNQAi88yjnef3x1dk5OP7zp5CggGXoBgCt6PoOHF/jYgA+I1hHAef2j",
+	"Kyhu357IHIdsBS1LW62gZYmrxMKznxuJb2zkEMDD8CDnEx6dVfIwS/RbMDGjodynbNcrOtC7ZaYYPRZF",
+	"cZzGCTiXGnQWk8lNNMNKbMadAm9hJGJhQg3/LszvJt8z896VymNn3gIXCve9mxodrheSKWVVn+KK3i8M",
+	"PZG8kXG1mNOLzCeGREzGlyzyXbTwadCGdIhJZRv7YD1fOG6BNmFjA8D3axPOkHcIcmXdxafEEm29JOZk",
+	"DAjEdClAZPRrkxvtV7JHfj1kB+PDyf5h+5gOaHtAw8P2kI6P2sfj8VF0PIjCAWNfyTOy3zkcZZ5JMV+k",
+	"WpF5qrRxnLKrArfpiGoaVG3ce/bmTKFb/Om6dmZg6+VdJIRmu8NFV8mui5hHm8yH5+q/oRWoDOKKyW9j",
+	"kZpO2TLaB76hLxLpOPF4qHPQDJqwntxIscKA4Kcz99MGNiQZUMJ6lMa5tCAXjC3sWVGMGsxcYp6MPgy7",
+	"L43zd+bg8mQ07KIryP7hbPQ00+86BO5pRCQmnolyQxyxIuauYCP4wJd4io7x5F9i3CGVnhJw5jQM2QJQ",
+	"owAHWE/VOUqRJOBGtma7SIzW35JaRxFJriRdwKS4Ii3I70wKz+Pi8OBg/9C4RVHSOyTjWBPJprHSTHbI",
+	"e4hIgN88nLUHCYhlI5CYHBU2gWM2whcVf8NnvrVPi1SpeMqZZZju/cmyoVZQehdce0/xxZc1Yv/6tSR3",
+	"Xp/v9760gi+t9y/P79ISkZ1TySCxKoxZv0fZwfCg3TugB+3BpNdrHw+H/fYw2geTexj2WCNjU7pYVFJz",
+	"I2KuVojcgVUKt5L9samIExeM34u6s4dKSBZSbCYqMR3jZKtYKNEAY1rciaw/jSJCCWdXZlhz2Klisg4O",
+	"6qV9FGsMiAwx13P3j+Kq0m7rD37d5tHqBLl9M+a06q3R2sr3wIq5Zc+1+GNF4Rf+lsopI+kiETRSZE6X",
+	"IFMxqgS4V9UORuSJ4IyMcN8jIsb/YqEJYQZ93cT5KDJyyx6RJ6FI0jl4CWoVXI5MtIcJ3bYUayPapbh6",
+	"inqPYs5nmKKXvmTocIjum86JGh0dQGvBV1HlxymPGWiBklHbxTzkomwiVzOR2CgXJygwRCEP2HVezvZF",
+	"rkNemQA14zcwIQfdbrfgT22i8+axhkEExzAbccUzb26zfutlv6BSkyu6DKy3hlm8H0lF4ySVDFd3wRba",
+	"rBXiaNxFz45HpzTm6BAyh+3jgaHDv3MntE6vCMKLeLEwW7SMGU0Cxjd7iSE8MLKNATTxEqq4SY4yDw6S",
+	"jH4buRAdS2gA2fwQjY5VT4PPYdf3QYg4MMxg3TPemG69bre7Qp4bycMaNC6ZpImvmNds7ZNicqs9bXup",
+	"tKzNN3Xe4c0Jlt9YqnxCK+7OIWPnkLFzyLidQ0YVJSJxgXykSGD19HeHDhP34rvwn+B98NEP/Mr9MIxT",
+	"wj05ItTNWXNtuwefhPVom+NlLe7exN9g9QY7p9cEH/xYRFT8eyYqgWMkTOe6HGo5sSK97uD44OiQAMtU",
+	"5EmPvHv+tEM+mLgftJ1mXYySSqz3QttIWJvXCC7JJnMPOuC6NCWcUDLodgMyp4kNpXejYaSl0Ysauk2U",
+	"RINt1yGflH3oUXN4AZBOdy/KjLdnXf0ifn4x7n86fPPiv2ZvXn9M/vm/36g3r19N/zn/h/6fz9eJ/S5+",
+	"ET+/oudi+m45uP755ave+4by5Q59LfCbps4WHdt653Fxzx4Xa1wp7K0PwJU96deQ+l25UuTbmy+d88Qd",
+	"+klssaM/208ia/yf4ilhZO8mH4l6Dwd7tqljnRsPeOfmsHNz2Lk57NwctndzuDsmZFNEfrRIc0NGJG13",
+	"P69KIbNKd/VlomIPZ0wXjKMwLHmSp1ex36ssleVT++QFVqtO/Sbvxxfj3Maou/sAztK5oUNGRrarc+BP",
+	"BacPH5mqyHtBlTJ/URnO4Lm6JJRdw39Hv5BTb4lAk0JOKYdrm3XhR2N9nloWBiktb9Vt5AY6LM62NTne",
+	"u6fIq+0cRAKiGCOjgg/LyOTKxaRvBgLFUfKWZgqb4sc+WDw+/4gVXM9ejLAhwYaEaqsbWW8ZKln2+J9n",
+	"Moo65B/m92cJU+qZ52KAl/gxI5L9C3Mil2BQ45xRg3nbOmu0s5RMBbi//538DwMNnTyXcXhBPgoaBeRM",
+	"pHpGXnEtwer1N3LO5ug0nsoaC3CtE8f5Y/DdKCMrLCZ/fAGrcBks27tv/GMbr40qivonk4JINheX1uLm",
+	"5jL41iEvIGuHU08uYjPgaErTKRtlHc373BVLktKW7sCZwzpylGH14k8VIzpHTSNJXp+ev9rvWa3yctqb",
+	"PYTzh5H8JcAcdoe94cHgqN2dDI7bg+Nhtz3sjsN272B81Jv0e8NJb3wD/496boUNb8qtbBrKLRjWjfjV",
+	"95onUeeVtKW4vOVzKNogKhDV6aGgVpoQGvSUjBXJkqED14k5iBmq43HCzPVtZBp/o5HNJeu+MATqcuI4",
+	"ZCzZtsFDUkzshJVkVcLVfLYKzIiifA/mrVWxm2zmnhZtIFL17ADf50u3GckeyeKdtG3ywgarb4rQxiqF",
+	"WUVxnc9pZG9mJfRGh5dFQmP+NxLOqFRM/5jqSfu4iOfrFKFXUgpZ6ZvgXb0im1ifTATqB2rBwnhiCasD",
+	"oHhpZG5digczTJbq4YpmUhp7/yTkOI4ixh9wf5AU1j3kaJElYsP3rTDb1xturOpnmFvWDPZwa3Szu9S2",
+	"zDQMYPE/ORnwgPhgz51FxaM0mJFyc5g/C+2S7W7I+OHS+I4Z42Tu+nwPWudCvKN8aZFePeQuhSBzypcZ",
+	"zto0bBmmFIPdvUoqlRU4qtZg++ytdsD1fOI01TMh499Z9KCoZkuhpHrGuLa0TULJsA4LTVSnlUnabejc",
+	"MDlAje8uAwvCK3PlKT1OSuYm8N/ye0ft7lG73zvvHZ3s90/6x1u+5Zccf1Z/d+ldC46O9d4WJe+fejef",
+	"lV8SqvQ3yUIWX7JvuNzbbXWjypi7EenVZxKTvfzbjX1nPDejrZyD1jkBPXaXnxs59DTAKXfNWBk2c+1Z",
+	"/9qYZT1qnmIlz4yV5Zszk9VmX7HJqhyZ5nvMccGnpiocq6KBr9+DVvGUvEcLxcLU9gxlDLwJ3+Lpv1zg",
+	"Ov57RSU31tWYG2jjTQi3Mk7he7hPGuNoxLIXq/I7Zzb+yjH46OCtTizQwTxMhEKYXy9iNI2oGUuM2TWE",
+	"igwJizDrXMrhEy9Oa8dYnbLgb1XLKVcezA13sLWSTDs0ttiKNvhs8dMLsr+/PwzgaQl6koPOYW0Cl173",
+	"pHcDtmvn/jZeVujbitlAsOwCXZx+sn94vD+YjNvH0fCwPQi7vfa4ywbt7jg6Phh2D8dh/6DaM7MmIc+6",
+	"1DVBntjeFQLB14THlYZn/Q5uc8D/dhl6Hsw17vxP8ojLBUYOsP3wcHJAD8btw2jA2gO6H7aPw17YPqbH",
+	"k+6kP+5FR5sDpa0c8fYc5P4QLhmQ9eWFI6sQCh7dA2d/ISL2kV3GqpqRYY2XdF5y/TgKx2w8YWwcdg8m",
+	"R+HBgIbD/f3DcDAejMcsPN7v9ftH9HDQGx706GAcsSMWRQdQHWMC7KFVyJZ4OCg8uh0OVoAQ3Jfq2YgH",
+	"Fhnf5OCYRlGv3R/SqD042B+0x0eT4/ZwcDSehOwwouNBtYKVg7hKOze/2goV/oyD9dUigpaJ1qvNlb5R",
+	"BzX9N4Jgu3w82XZrMC9btj9/kKMbYKbnFFqPlBUX4RntHxwS1yh3AjV3tTsu9bIOU+9N9D8I2t+16M/9",
+	"Q4sT/hQnzDrhuLPCJx8ot0JO7bO4H4RqfnZBSranVvYF3X9y38YBNWhlQ2yV+Kv+OS8vpYrFLzF5N5nR",
+	"S3yLGGNa3t/SEnDfvQVjC0vI8nx6+b+Pfm9VEuzvdQ4IBadnxHcSc6/sDTo6d1oVFWlW+coNrlRrXmSK",
+	"GOW9xtg4PIqJh/BVCc+3rbISZU4/aPAAE20iPTExtVFMav0/hfjsKh+W+GoOhcfFcr4lF0DW7Q/DaNIe",
+	"TBhrD/pRvz3sDQ/bdDKOJuNoPIyOJ001lpUEbY6HW3z25YQ7xwJGlcSHB0WLqiAyMiNw6YIBX5M5U4pO",
+	"WWGL5V9WAPda0gnldN1l7waEsqJNey/3hI7hXaXdOybVT7ZxFeN557mIwl0oNC8zbCHCmRE4xlTKMN5U",
+	"OfHTlBfEc6jvcPtpkfisdaH55DopWeKcKeCE/D2eznzgCU4mkrHfmWz3NuKmI1G7OzdVUXf+WoUFv2C6",
+	"7Vr/s5VD+821X5F92hZQy+CErjwLKqn1k1ALGjIVuDYAMKhrzJLoR7QHwcsZMH/8yjwgj5zoAdcMz9CE",
+	"Hy/tH7aYSYB3ap3auizGwmP+RrOTfR7VdDoKrN9ExU8uVFyyhcGr0hu7t4gf8yDMLOX+j/W2ne9V4Pee",
+	"3IoHQAt0us6AWHOkropHw+4fse2K9ovfeoiDY9cu2vm6vqu4wX7GckT27F2topJLeDEbiHHobEBXc3oN",
+	"KvYHjOiuIG2hiiVrF0wSTeWU6SB/J8U6OFVrNDW+861h3eR5rFTZ9/Kg4XJvcCxBy6xXNQ4at/3PsdtK",
+	"2PiGZLxmhfmkHgJ8dIsvnjy8mVeERvZ67X4X7Hpo9vlnc4uPqB/scLvBSlvDheIE3qbOGCjMtWhtwFCh",
+	"qbJrTL6qsDtikX3JQydd4CnAeDNv3UKsvR9hsMbZ7fvqKlWaVC2SXVcs0Vfj6+Z/n+pIAAWsd7rLHgiq",
+	"PRqKMwSuNimmzED4jUwgIQqQzk1qMGwQgUbSucTtQatIASvwmsVRxW7+jh4CljvkOZdg0bGR83YVYyES",
+	"Rjk+cNElRCpuRZEfbB/cxORNyR50Wm0FrcZCdwC09oDvBr5m+hXIfsh3X5JgWY33VU8h91MWsVDkw5b1",
+	"4q1mlI2D9e9He5f9Pa0Qi0altPb0clpMKGWrx69eIirdDT+t1KMxC4DWFXMXJvppLeXCwZxllt1SRBXV",
+	"dFEjuV4Wi2ouaGxUrLwC53yN2lo0Df/a63cOgt7h/tGg2x+AbO1+9Q3B2R8NPDar3eLzz7dF6TvjWtU4",
+	"HPhANwhtQzk3RWg2Sk1SZTM/YkfH/f0wbA8GE9oedPejNtjs2tFByAbHtNvts8FWN9CvtrgEKNoQN7+s",
+	"iSRHG4wpZcwio8tQTk6rrk/Fza/ugQ77vcHwuNvuh8fD9qDPBm3aPY7aR73D4yGdHB+OD4+a7QEWnweb",
+	"7nJtr0SQNnjIb5R8uwFmHoTsINoPo/ZkMgThMOi3aW/I2pNo3BsfHHcPekfHTTHzRvm7g5YXlrqLNt1F",
+	"mz5MtOku5nNTzGcVtxgcRZQesnF7HPXC9mAYsfbw6Ljf7rHhoN+n/e7h5GBLS+p2ubI9W1YWY1npslJp",
+	"lv5YtNt/KqceO4iOw/5+dNTep0fH7UHvYNimdNBts3022Y+G4wk7OGhMndvGYd5vfOX2+J6PbqIS91yU",
+	"YqMnjBXUifoH+8fDwbA97LJhe9DrH7WP+we99tHhgA7o0aB/GG5rhHc4Y1GoYFfP0aTgVrEOV1Y20TCs",
+	"sSaNdYeMAEwuSW6TIMXbhChuPJJbhSzeIk7wbsP38tA8C92K4Lqq0LqN4LmLULvSkbumeN4CciJLpiAT",
+	"eaNAuY0A9gLn7oLsC4+e2waJ3WD5Na6o1RRf/4BWyjBcxNziOjMnohwDC0RuacTDBWQgLtFwIydyqDa9",
+	"3+4OwTVvcHyy3+109w+2fFmtFCeVGYcb8N3e0aA76bFBO+qHh+3BcLDfHg6PDtvDyaTXZXQ87I77W/Jd",
+	"t/UMOp9jPTvDlTW5RTfejMqGzDub79rYp/M/4JM1+J0evv79JaXng/1okfzmgxnk5pWQ0Z8GKrsFhJSX",
+	"x3UFSrkJ6DbJmetMWxUVl70HkUZll1fMMTajQUl//3//nxcNYd3MKJmdpOMDTWDvmXYs0N9whYaSatu5",
+	"zL5fD/PCKA++K7tKsyuzlMxNoLgf5wZQiQcY35WpIJg+Oc++DDcPEZXs86fcdkLUkHnyWrqaEbAmJuMG",
+	"4C0BIXdtqIBD3cG6AOQKE2PmSuS/DNoYZX/3vcGg2bNeFo+gGs9ms1cbB7BY5tmtCU0ko9HSJL4ukliz",
+	"1ZhI9uY7dyHXLls4ClGC8aN5jPd6k2y/0cJArF7JWGvGm64NcdU5aOMKjAEc9G5ekRv8BuD6LaWSch3z",
+	"9RDLoOQvz+7GpZfOh9oAr2Yrc1NWE/PtjzAgVJO5UJpABiVTngf1VgRvkuTDlnLDjxyKmdrtDaWWHQzf",
+	"6iujrdIFk4pFzTE3YRNNRKrNRcGUBsp/dxtWdJ7HDjqXS/zy5mhT4lAZs/Gor8AWishf2GsRA71TN1zu",
+	"v+MqnyX41u3Pr6jkH67L14GWf+e1AAUS2u6nE2DjjKpUsnkWhFKIPsnLvlD/uaWDg7hKLjCMFpom3m0n",
+	"5qApKqbyEczjGsWk/5Z+OZPTJcHIeg/9mERXHLwwBXifsmeTe2NFDJx3oImm6G4kzK2sdCmDHcVAH8X8",
+	"Jq5Q0yhiiabgKCTxkRFdgVQ6H5Hs5dHC1a3LladiXKFvEyzOXOyws5vH1royY+EA5l0TiEfPWAYd790O",
+	"vco85PXqJ5i+AVHCABi+U+kclxYKDuFrYALK3FZoFJF00SHeFs3WzC7xfOCBvqQLopnWejGYoRBQp5bl",
+	"jhmE5ufcJWMqOXRi5eX9QJs7daeIJxcjALjQhANwYc2wLhYj5SZMKZcRhGWTIhRiTYSs/DpWNQsrpqax",
+	"9jVEeyytiwsuxebZLyvUmHP1i6kjsUqJv+QFJqh/fnhSdreUYFS1T2sd8s5QpLUXrDawlBoKKZEfjE5w",
+	"j7ZJ5Go3wUOeawLsf0Z5ZMiTKR3Pad4z75D9UqQuzH5ByUKKSQy2/zwfBuEsns7GIgWAWIZjJlHpWOlY",
+	"p1XTaApFbMyoljubp5/itNTEOVg2gKOOaXG0WBGMarRe51dSWFZ2ynNSdSBxLB4lG3rnFcqAlLE+NuVN",
+	"HJFZAhAyYtK6seIOEgYEqoU/bBG5smOA7xyEkddnIGoFrTEt2W/9ptWI54SDkZzNAzU/Z9wyR0s4F0/e",
+	"dBobJn7bLOEdjaBwpKr4lItU+q1ooEHwfvPNNJXPNqo2MGil7WW1roSIkZ1t9aW2qPr3u52D7UteXbZw",
+	"udn+S/aSkg5UcRndQU3wDFZwsVPr/GNroiVzf9gKBzxfyhWiW1FCWTWRRebNcuTN1VzXzeevUnNdPoPb",
+	"GXtYdZQAJJO6KpD9KgBCkSaRqzXp/ENq9KwcHnj8Vp6V8x+tQQ8yglWg4yzmxSvgC5qsyHzjsJ9XB66L",
+	"Ksife9aCNW+5jeHMatoxt7sqbubic/OoldJrZGFfvnPJce+wvx+2KRsftweU7bePKT1oH/W70XDQPe4N",
+	"9xuH21oLOWKfJTBxtUpc27H5Gs+128QI3phjnfKljRKzRSjte0zMbRFZYzyyXt3mJR4ussmP2A6vEEAD",
+	"TotGBc6rygpNSwai/U5vsDkLXSWzM0cA2R6q3iZBE214CS4s6PjosJlpYRP74AIzAcdKx6GpOTtmZBpf",
+	"gj7naiN6V82CFilk9ZtoZUBlLJWufgs01jBLeZCGs3A/yp7bgFOI1PzUuXESm4Q2WgZnV/e6jDm9Xl0F",
+	"1jNU2uXm3Dhh41e4OaP8m3NYqSCoSybplHmhl87bdMz0FQMJcSWKjwne2vzr3JWoxdhS/aktFh9XGBHP",
+	"sIDMfcCqkYwYzWNur9pzev1XFA+G8zi6tHRhgB1Yl+oi1gAfc4kx6/JdNoxaEeliXRbEO3DFPWDh+Dga",
+	"h+3h+GjSHjAKLkzjfvso7B8fsnB4FB0fbvnIZ3f59fv3IMsjdAZbcqkVVRyepnqWpVCDkcfwbT7RTOuF",
+	"cRqP+US4pGzU+Kya7bdex3qWjsnCvIOkMrH9wP9uir91QjHfUyyZtGdC6fyvlfRkrR9+IJ9ZEgrjb4Hs",
+	"Fbx8YpqQSITpnHGjvTqu9/P7l6fkjCUTGA6d1pwB7fTDG7RAxUqjqn1MQqrZVACqnhgDBiCHgj/wgPEv",
+	"9P+NGf5tUqfgXxmSwyebOMG0t+6W8De6Lyvy5Pz5y6cwgalkGhprtS3cuRSpNRR42eYwnu8L/+GHH8hp",
+	"IQcd7kUUmuIIVDIyFbb4PmcsItSGu5MRWLmUIhdsafw9GA1nZBSJOQUGAL2vYjWDjqalZ3G0beBYnTVw",
+	"lCom4YuRqaVqjKNCRlgHl/z9/PwDyRDJqeSm2mphJW449/I9ynZsskoRqLulvvDTJLGvelmNA1f0ayG4",
+	"vfoIztC0noVsgmc0QEN5Y9kzHnS75DnNHgI75rse8XMN2i8H5Ocsm6P5ZggFySZJHNp+/SEpZ0k0tqaD",
+	"bpdUZqzEbb7z26P9mCZK3HxP/W6XnKXu9OBzz30m7TwFoXOiN00GVU1smHeQJQ4XEvQreNVauufULPs0",
+	"DrRvweTyXPqjXVG1V5nY0hijgDVyxXzO8eFte7/TbYPZd4V1CLBk48DouGt7qz3byQtdbmVcoO3YQCto",
+	"ga3bMJVup2faw5B0EbdOWvudbqeLHox6htwQgmhMfDB8qoz/eBsr7eXjtuHEIEkFPj7EgkN8SeunmEeG",
+	"F+AENj2vap38Wi1m8iZQaEFB8JKk89b3YGNzrIrXuLU7JxMV3bgb45fb9oAY6C37mHDpLTsZ2ti2kw2K",
+	"fstu2PH1TTtu2U3T6fZ7w8jxQq+vpZTK/W53q1ThG9NEVuVUPXX57S1NfQ9ag26vbrhsfXs+Wzad9jd3",
+	"ynMoQ4/+cHOPcpbd7wGGSGzsV5UT2VevkMY9xepXjPw5sUD4Cmeh0vmcyiVwP6Y9HmJcI39tmW9QeV0I",
+	"dQs29ALZ/6lX1ZYp/VxEy/ptuiYQqOPCuFrfV/Cnd2f4U4wVq8CjF85EY4yBIBBdZKlJKf6fi1lGvNfg",
+	"loEboWAWMBhSiWPfA0/w7f0B94fvBuMwhm3VgIbfK0LNmOiIF7lIHDiYVTQ0XfCUny8/ZT5jPj4NNoPH",
+	"JVnHg2sATi9v/H8sgphDPCkebglPDFwJzfLar0GWoFot+oiUmaPEsgYRMrWoDg0eQizZMt8F5rFDp20l",
+	"WQ0yoUBrhknbqcUwW67NLNKqMPliHXeHhitYaNqV8XBL2egN0vpezc5KeIdrsretR451TdhxVoQBOxxU",
+	"BqfEEUKdsOuQLdyL4yPDaXMi67HaYVYTxHbytPgMXH+b1KtPwl4WXdTybLU+4752yWRCF4QWn4fRIcS8",
+	"XdkEzs4BJR+bmFIxpVQSvu9TEvMLUxAEzBW22Sh/uBwRIcnI5AuE0nfa+p2IzKUUX0udPTLA1Wfla+Yp",
+	"ejheYvYsClWBjDFCC38OewQu8xXOlX0nJoShSc2b0VgWKgSNdwIr3KYsE8pP8ordRS5jGNvkJXOG4mJe",
+	"4VyUNY2G+h40W3uW0rFyDVk64xvNv8nGQPmUYcLu5mYJ6PKKR/d5L76FXeW21+dbu2Wsu1x7WL5TZbZV",
+	"ZTzgVSky+c8FVl/oVXdHt+fJDKuSZC5kgafAd5axo+9vgaEb79gy1zSi6lZ8M0/VLjir4pynkcc4b2o0",
+	"KKDyvVkO/GlqzQb/bprW4zRA1NNR0QqRtaunp1X9aRujBPfmqDBNPAxZ1RlAspX921tB/uJo7cwm9Whd",
+	"YTRpgNsbzCdF5F2uQdkm+nOuqvv46in2BaSte8TaiLPdB+LnpwXo/CWMN39xKmikIm1LAPdp9WlIPHfN",
+	"7wMyFnrmon4od9FFePHFuTADmPnT3OITRl1xa2/VzhEMaFQXNUVcCJnQOFHW99t5NVhEGHXq7FxVFHwz",
+	"Y9c6tW5QqwHHwu19J7UexJJVT7EVdqzmGllkfZ4aO0e4Dh2I0rMfMAjPsW9MR8YjL7+q0gKdOk3o4CSe",
+	"uqx5MKxC90mJjvipi/vFKha2DjIMJyc0RNelZ1gN5NnaORIqp8wuRrlI0L9h1fx0oQIyp+Es5gzI1Tw8",
+	"YtZKFZB4TqdMBeQyjphoh0m8UITpsEPQGxYAAOVIQsqfkTGzwfuEKpOnz8X4QimRrLYwmEgjE/RIx0ok",
+	"qWZkTq/jeTo3LdEgQZ7E84Wwadg+CKWnkp398vYpbOZZ7/XzZx3yd3EFPArSBpJIEBphKCqd0pgr7aV4",
+	"A+c4U9ucLt2StKRcYSyuA3kZVmZnc7o0vBS4bnTJJIB8vqChBm3bFhOmPGTWAChFOl2k4EHlHOf8XPtU",
+	"k1FWGWYUkJnAZF6E1lWmCdD7DQNFk9gLh9msFe2ZcA8zeSx9/+8aK6Jb8OPy41mxfz2IicrCool9CnmB",
+	"DQVA8O1sU1sqXhnkKrSujLN6PNtrX2uSiiJ7EfcHWLH/eCh/E+uPhyX3ZvrJ5vir2n0epRmnDuUAb+xv",
+	"NRhXUhG2ciOxnSqsNb7eUCsvUFyotcKCR36klCu9T8wS1sUigshkNKqSDaazxcOdV8ufYZ4pY9tGE816",
+	"HN7k25Lh6fLOsdP6gtsAga30mFir5lrM/dtw1jBmD1o7z5tbqQTNfG82Yfu9WWLKlHI/bNyaFKow3ixk",
+	"FedvZPWoV2cG1XUxYJs7N59HahzZQEKrBpKb6D17VCk2H5sc9yUyQ3+MGaMRk7lDxgvDetvvXh60/HA+",
+	"E+2Zc16vpPN+vxhh2K8IDPyj0vtjQaX+2RVBXjOXK4ncq8ovVj10uoCKO2+itQNXLHM73mPvNqV7iwW5",
+	"pcAPVGr1fPnfbFmWdoMtpV1tAkuvxCXXsV6eC4EBvhtDNN0YXyuE5Hvj1/TEtnn6ty+ckDZ5Vpzi2Qn5",
+	"hKAmscrMYlluKntyBKuFsMiKW7QidcgriAUEFDAW8TEj1HlxHZB3z0nMsWFgiTmzmmECMOjXsSt6wy+B",
+	"8gHQz07Ie8/JwSW2MyTEIuxWSuzhQuzKQ72XEZPPTtBun1gbgul+ZaPKYk6oChk3idqgubHym1bYx+0s",
+	"X0HMTVMQSbh5m4rhC9/Zl++YhTpCtM8fgKUOBTrk/PnL7Tgp9ttgcU4Sh3LF6Vb0AmhexR+qWHSJs11Y",
+	"RnJfTK2KRe2eSP4UNRqRaiO+1urAyJZZxmW9lG7wVOGKhle91kFPi55WIViHoDsd4q7Irf+oNQLgVCj8",
+	"HHPbPaI+hntCQzLfXuJJelUr7+CGD9NIeuVmyJNgupvKX8Uu9Zrpj/TqTi1TWeacMeamqKQ7fwQRaqbb",
+	"JsH87UbSYp7cboTr2w6wpDcZQbNrvReqyxv2xMSwfyPhjErF9I+pnrSPtx1q9RL0363AyjdEg1eaTuto",
+	"3TbbwzY41n5DDuTSV+z46Z+tcb0UVxz5qW3neNudWy43qwnx5GfB2Tuqw5nTFmr4tBHHat0b1wvKQ5Zk",
+	"nNr06Kx/SjKSpUbx22qnd3GN+bpzUX7cLsobKKsaA9dfaypdB97wWMc0AUckuhGh88YlpLYy/jYvD3eo",
+	"uWc3jQrEr5BKHgjmaaJj1PvMGDaU8kYYfwfa6LrD2ayATk0p8FqlM0+qZBNt0igyQaK2iLipv/Drf529",
+	"/9lwcEwxlBdgtRNgBjj7994iSacxV3sK/NmiNhxVO++79zSwLl3eAkcwz6ePb7OgUpOszHyEolvwO+au",
+	"w2xpJJQsYhwAozrZUiEVmzJ+b4xHNhe+IJrZSNxQcG4yvlaqrGaUc6b0i6xhjdJaEgWmOYseksU9Qk3A",
+	"FqkvY/D5KvxNyQJ3bjlu+MjsULeMy+Xo62qeBg/jxhXSZO1Dz+wrJhmRLGR4LfKycWOMqi36mA1u+qgF",
+	"tYUEasuYA5K5QGv4Gs3pbhpbC8OU7y9FZwAkjD+t8taKsddZbRy3ASr9jNleSLhLmL0028uzwtVheDF2",
+	"uvRyeieOAisT5Qzxe1lD+f4Qzo0rC2oUhruLvr3rlFgOl1UuXqrDcGuJ33CYWrL/BX4ue3pZotNUTplW",
+	"+OqVFXnNJZHlXiNLsKY1Uixagsxnl+jSJakqlj2iKiNRVw5LMTDDmDFhUhjAKxsScy2ytN+Qxtdlt32n",
+	"TFpwMckZFIli8Ek3iSDm9BoUMCznoGylJb83TGQrLwW2Sk5k+Nio3+12291eu7t/3u2e4P//OcrzhC7o",
+	"ErQLWz3I7hu8wpW1UI2yDYzIk4hNKGSaGNHL6ehpJr9HKY/1qJiN4qbhbrnhyQTo+Dn+zzPgwArNiyfy",
+	"TsEZWTIq1zDCX+z96R5ZIE7xSLgfgO0sqzywifu9tF6TBuQWC/zKQjPkP6WqNUVS290S/yydy/BBjwc6",
+	"pmdYgMWIRhzXcLAGmpZf6ASrVsOXmGwY+FhemBBmxJsMjbnKGG1A4ikXWOQppIrZQgqVYyIHgUS0zXkH",
+	"ldYtoVB40FejYKFreMWZgcK9MgszxyPhFm4x7u67iV8glZBidZOdynQHpGwOAgnYowYtbGmRLejZJcVf",
+	"4woNl33nmmM6VIeOm4zqW5t7tguEKkRZfX0Y3K+sBFAfAmWBusP1LXE9q12w4uScY12OybZtnf2ykIbE",
+	"5snHTpURUOaMbxb+lOHHvQU/2Rl2oU93GPpUjWx5wFyGKysYV2CdDQKfoizwCe5diUXD1egnolLAEBat",
+	"IKh5X0Es2CWS+Uu80hSRoy5OyXGdCqa2LjLJ4A/WZqkVw/cf+FPLlE7NvnYZWx5Wbq6JEQJUgdqxqV6P",
+	"dPcXIDS1k1aF7ZTx9UZBO3VCuMHxftqlKnkwL7u1qJphSy2KVonevYUt37TFLQY8yF03QpUSYUyzrBIg",
+	"j6vxFbirKxb1k5C50njfVxCcdNnkDmLr/eyQ+c/lungVdKhiS1DeC+O1FHEDGsi6rMPyB06CUnJ+BQA9",
+	"UU/zglQEH9+r/JwQmN8AMjdMc/x1R8f/FkaEDK3XUaRHhV77zVlU7PlVGBCyX25iQcjR4t5MCG6KnQ3h",
+	"Dm0IdbhWgTAV6FZi3VulUKlBRNPA/LizFPwlLAXl40dUqmRO65OVmEOvLYGSCfXl/VsG6nnNTjt9aDG4",
+	"Ga3u79Jfw6TM7yvIeKNrf63k/M+99//1U3Y0xV0nQG055m3uPq5LJZvMf/yPTwBpYbG7sdwnq3b4VsTz",
+	"/NvN9xLbuPJikv10o5tJfv73dzVxc+zuJnd5N9mEVSXu2fj6QWgtutnrh/l1d//4a9w/Sudfz4QqZetL",
+	"pjFFvXtdqkMNT7A+wAWknqPsbiAPLdY2I9b93UDqsNFeHlbw8WZ3kFoZuXt8fFz3ioYYWS0Z90IRsY2J",
+	"OtCLOEylZFyTJyqechY9JZdMKhvhZmLeooqaUa8hC1DEfpJi7ittOx75H8MjDYrdE6OsvELYtDZwh4C5",
+	"yRNzn5DsMgaExac3SiyudNbcLwBzP9peax3i7y+JiM2Hda93lcI2dzUIH8UNpxHx1PD0KJ5MNvJ0aGTS",
+	"Yl4JQyaOPlQVE6+gCPUS5tnIze+PNnYs/c9i6RmqGFy7B+YerNo7zZTktMZZQrLLb3RtWpiaAW0SVQyw",
+	"vKRJysiTdu8pkWwhmYIlIr38/dXpy4DYNLKcXTGMfDdDgAzJkgS2a7IE1sz+fM12xo91O19rOE/OQtax",
+	"H3BUy1r66qP1KaqVzDV86EG81YpCcuez9hdgTveidG7C/L0/3J/fmloeC9K3s94AuQHxd3bIx2yHrMWS",
+	"hxCg547JupkJ2oeybK8DK4cWVM8KYsgts1mO3O5NxEURHHtgYagoOvAX3XyNPe8snvIy8a/QPjS6M8rf",
+	"meX+NLPc1pRfQzFXbDwT4uJWxFFrNznleTaxJ3amp+RqFpug7CsqI2WTnCAYN9hRXl2zMM0E12e78iZp",
+	"xna6059ncHAYVk5t9vxlawOizpmesRQWRaP6JBqnXF3ZqtJw4/GyFv0q2VxoRqB/nnYvH7gTi71IhMqf",
+	"K6GaKb1XqLJb+vSDGfYbDIsVZj9k3fPQmJrKeVcy1pphIW67OPiGmcvVWERLTHJEFKeLxbINByOZUiwi",
+	"Cym0GKcTMvrIMuQcBVnSIHdwjTqbpiObKAS6j85O3314++pslA8EcgdWA/G2Qpq8aCbNUULHLCFzSAbL",
+	"pEmvRk1MLhCwnmUZs21+m1EO35MR5DBZBcw95Cwx64s65NSme4AsR/iln8ekW8xCxcFXxHEjvxm0VCjb",
+	"K5N25yjwEY8V4Nw448l12x3QDSxYt0lzcquJq7KHmWRVO++mGyQwAcQt80iPsXisLJOpftx9TgmVLBS5",
+	"TD0P/WgyLjoMz5Pn/+pzqXvgoThuiYkGRAmTLmslxWqq8nrhbc3k3FXi3oaBfoY5HQdFpvYKE3V50Hb8",
+	"S7ka5TEv5KtzmfzFFQ+sPjNztcLptMTwJsIWnMry2hlmA7Ype18o9EgXf8RcacpD9uOXViJCmgAMTobd",
+	"YfdLK/iXGP/4pZW3/9L6PgIm560uzvNr4iS4PfsbAnaGRaM4CzB3U0ToRFsRalsBI0RZZvoajo/5bD32",
+	"DWyCjGCEH9EuCImoeJikWIJq9O0b/PLt26hDPmOmQD2L+RRS8zmhs5pn8ByaIPcOBVcxZpiyFQ7tfrw+",
+	"YwZo4EQOds0z8an4W5ZGj1b1NqfioTo0JyqdTOJrt5w50zIOEUaBK4NPRt8UCwWP1Ig8GanR04CMvo2X",
+	"muHn56OnREgy+hayRMUpfvdi9NTsIVZk1BvhkZiRjb5gXIIuuLjiuIgOObNkiCdAsQiF0nS+MIdHE+AC",
+	"S8KuY2Wzm2L+LwcqpWkC4k9eMKnIk5/pz0+xkbqIFwtPjJezCRog3TSfoM3aCudnuUiwYUibeZFWY26Q",
+	"46DFHFN2Y2TuQ8Ux4Xe3RJuF0huU5rldnUqi6NyW9acmq65JcitjzZRrYPaghKcRZMdl5jaDGlKyODNv",
+	"oh0gB1p1nC7y5Hd04VOkQ/MSzcTKYL5PJQZ3TQJLIrhRxGIUF1UPAwi9QjhoJv9T4/JTmf7+T9dsKuqM",
+	"fjbq9e6e9wD3vKYqCxKV5Q5MbtJZDClvCFLwYhRs+ypHynP304MlWnus8QkIiV10wn3q7wbXCsa37LvN",
+	"kQmOBa/4DZ3bH24SlZCd+r35+dgZdhEJd8lW12FSgUluFQptNOu6uFfTDtv82wckPMp3veKJ1rGR9SJR",
+	"rz3iTCLef2BBLVvYmeEfVh5twqf7Cykwt/GaiIIyGt4onqBOuu2eLR/Vs2UFIq6kMsuQpZG8yyrONb8k",
+	"vHQ9qpii+/EnIXNt69418kKy753r16Pkm3teUbDVLEkObwhVJoDFvF7XI/MdOIkVl+fdn7e7L/tlbasV",
+	"hazexY4qdlSxnokjMfjW1gckh6YE4Ge18DqtR/2d5ejfkyIfI4H5ltCvNRbSBmakNWz9NCqi9o0sSgVs",
+	"uD+zkjfNzrZ0l7alJmi2wltvkrzfw8Q1Kfzh2dpvmar8lZxd48t9VvEyYnKlIj94BXChwTPArKC2inSO",
+	"+ruEG38JR/dV9FvHGDcYxnxkXGce24gk3QficTvV9uElbxM8u0eDWe4KuerDgk4aUKDROKkYV5Hcmwie",
+	"VcnzJbH1JAOIiuVTvOc5v5ZIMFN6EH8yHgLWtQkuuR3ySYEHiOCXTOpvxslDC2K/KDcPyG8plZTrmNtv",
+	"CDqkGb+hMZy3qnB+zVxtXOYHXJp1bLAFiHRQ66JhHayMYalDnptpFlKYyp5+t9SCVeYuY7HzMYVJMtea",
+	"JOaMSrtPFDZPfLesz7C6i88Y1/gC/v7pacEVzbinFMBW5f9hLZYZJOoqvq9E6lcBvwKwHggzpKnz9vAX",
+	"2ypGfyL2tE4mNFEsU/bHQiSM8kqvj8a22nV6485g+7gMttUMcdVomzOs1paaJNrVGgUymvK0YuLV31xh",
+	"lc+e/Sw0e/bshLzhmISAScZD5ogCnIguacK4Jq9fnVvvw9GUkS9pt7sf/kius78ShqV/qXHX7BBTwRH4",
+	"aMyzxYxidEzM6vNexTwSV1VUb3YBZkJIVnMLu0IxDm1DY1zlmaZSb9flFW8+xxSvE/K9fPVb4z4JU8rr",
+	"8PXWCviOqG+hTe9V+W6tkp0nY1BNaG2ngZugAJ9i7dCupC4S8A8//EBeG4wiQgLB0gQVibdMqfybcMbC",
+	"C2WVI8XsZ8JMUJjnwpyV2CYAxNSUSHdu2nNGuXWCFpyhMM+KZti0AdCHRTaWwWY8GKca1SfbKOaLVCsy",
+	"FYY5aFE/MW4x4zeMJOyEFLjP+48lFgRbHyWuw49kWu5RaCwZGQs928S1RKor2JYLeFnD2QAOCxbq+DJZ",
+	"VnE5POP8gH8S8qXRLP7iPE7Fn3isH5Ilbu6wkCzESM/GPYSMp3Hz5hkGN+4BbOB3wZt3mMRJ0rgxu4Zw",
+	"AvZLSpNYLx/U8K0+iqvdK9SjvqpXCjHMB3MTCVZvXPcvuOZRq6iBnpL/Onv/M0EUIbEiMVdManPtzCyi",
+	"Y4g8DMjPL01bHpEXZ/+AiCUXoZD1irlpzFSHvPSmziMi84CQiliQGeVRApOHoZAYigMhEoJ/gwisJA4L",
+	"MU84ERpYuVuakG5luJlQzOex1saCa2OeOuTzjBlJiM0mmNx2QaUmVxTMElKk01lgGpitkDGbCLt8aJ5K",
+	"cz2/YAudBbEyQAWYVKLpzkFwdK7eIHAQVUZBHmUbihQgIyb+JTkD3nM7NcwDYALIYtl6G2GW9Q/FpdUd",
+	"EkYRZP6Ri0m2078RyRTLQ261/yPAWTKVYjH8L9w/Oit9XWuQ1KjrpIsFk8ZgUnGth3Vzoe2+EJxLu5kc",
+	"PCPJoIAoi8BgM2V6xmQOH8moEtwFYOVGmx+1TNkoHxAja1xsstVm8tarSwvMXSzDyKWziki2SOgSkSVk",
+	"FjJopjExXVLiUqv0iNMIPXHOxbn/PnuPSsSWAl7wF5Z+6lKCYFRPAXPc0ThbXeW5NwB3nS0n73P/lhx4",
+	"/lOo2n2/T7O4pXSjxFbJ25+FAbFjlwFm//KhfsUkK4Ae4Uzl0l4ZYAN3+ly5cc3ek2Vl2FJ5T54ycacr",
+	"zEVtwxyK6wZ1g63s9txL6JHZnU24JYGrRDyxu+j8G2tAf8Fzc2qZfVIgLJt1i4fldapZpktpQQpcfjvr",
+	"ocf16rx+0Cdpa05cIYF55IvFWCuPTwdGmbCDOpWsSrbB4+IvWT+Uc+8nDyXnHuKyfAvPpwe6zXng/wAo",
+	"sbva/fWudkjVKy+P6B1ym9eIfMQ9o77W58l4AQpESV0r3g/tK6S5f9IpjbnShTdPw3mAsaxlPStXCNDp",
+	"Y15WqWkU5Vl3SoxLsrkAj5ns2TVf89YXy5ivzCDt9cleQ6LUUClTIzc7jbnr6HNNl90ju31wAWBRukOs",
+	"Bbr84pnfvc0BrZyAC3LXMxZLskhoWNqi0nGSlK9hFo51K4VtXbHE2KLL2zVGYxZVvvd+xFWWOP7D3Wxu",
+	"z/G//qkq/o7v/hkuges4r0HoVaaHTx+3475KU602Jpdn15rxzNpSxff/hr+YXNXZi7GhfmOImsRSGVNT",
+	"QpXOWZ35Vc1pkrCsgZwy5d5+nHWKXjJJpww2zeQlTciY6SvGuD8Vsm3wpTGOLZPY5mNJCsln0J+EEsW4",
+	"iseQ/UcBsdqXH8ajkc1bjdd9k84EwBQrHYeeOStj7FIkSbpQ2UpjHrFrH1gmO0gkjDkJbDXuFxJrxZJJ",
+	"ndZ6BqdzV7rq/XIVXOqOnTx+Ne4sR+VtdTflPEcaG+sFZ0BsigHxJoVUQzw3zltzxX2lRnJKoZ2FnJYs",
+	"VmXzf7Yuz/0tICxG4y6Yu0p6mHHdiWCjXHBWygYGeQ/R60WEYSqNZXbB5Mqm7YN3ggofrFekOhRz81rP",
+	"aDgrcDC7J7wCO1aTbfBeni2CAsyamNQL4yrLVC1oC6p2vTUayOcW3G9nNbZWY3yLuXXUSMPLPk5W1DI3",
+	"3/Z3RYn+7NxRvn1wlWE3Ew42+eRGhdJWKCm989VKi8B1QC2yVk40TlDbREqs8GRYHeNaLpF9r2PGI5hL",
+	"jeBCbaJmvGnwYk24fXKwKmMs7cgzqgjlZITGX/NS7PIFGz6NxuHI+WKGNJwxc52PlXnMTRewc/QRLWZD",
+	"jnOtE0KCAvvqOHMadJynR7YvyESgxHPZMPOHXuPzXqOzvkUMOFdRpR9SSWMgXjK9HEYQIqL8dP6/tnrH",
+	"w8Pu4Dhsj6Nw2B7sh4M2nQx67QEdDg7HQ7o/6LHW12qOi6exNuF/xthKefCC1pxevzE/QhriFTa2Ik9+",
+	"rroBlRCm3jc85bpaKPRwJaYoQQ/WkZUo6FWXKHgQF5083fDOlvu4UxkbovT8uG/M4JsZDFThkrGGr6/V",
+	"C/9juPy6y/8mFfjfjKM+EO+y5ood53rsnKtorrgF25KMzmv51lk6ho/jLG6lqWZqw+KW9oXCOushX/vV",
+	"7L99xrgmry4BSHkK95meJx21YGHnakb11bQj5HRvDp7jCzple0bFaivGdZth1w70eGrv9mVFjfJlpqYV",
+	"tTQSW89A9y3C4eF4qx0qVsb7h0XmVQ7CARLmrQhaiAXjeUkN+z3jkTJaaoxKLheYfp5JMpWUgzdbM/7r",
+	"Basrd9hwjzfemGBeGZmdIbjJTCSRwpA+IYm4ZNKBvAIx1tpl4jkLMj9KqzGNiBiDkSFLaO0kAXkLpm+X",
+	"CBv1/UUSa1xAhnwWH8gp4hs+asbc1AqBD+ZYegfE5knHGErGFtbjknNm4zmT+JJliFCANgZnZvLJQuTK",
+	"+ZyGScy451sUCq7SuTlMszYyoUoTxo0bqpB5X4uUiVAa3Tm89WhRuJ9YTAfrGaLmmDGOWbWti67QMxJS",
+	"hTqEW5KaiTSJbL2RiS2G6TJx+5KY5zhg8LFKBp8hSP5DLjJfm9X9xfNt58w021gLfzlBKH/h8N8T8scX",
+	"XPGX1smXRtv+0gq+tFIea+zxAj/ieADwL63LL62Tfq9zEHxpaYVN+t1+r93rtfvd8173pAv//+cXyE+E",
+	"p5lDZVd5+LFfT+JLdsvLiSGVOvluQuEqpDjJ6lHsQuL+miFx5rggZoJdL4TU8I1xuD8NQ7bQJwQ5V6gu",
+	"Ry5uAKC4EuZwFUeMaDpObDUb82AdiiSdc2hdfEIZaTUKCrVl8PBMa+95h0UFNCuoB9P40tQiy26SpyRk",
+	"SQKzsflCL/0KHW4EUzvGIoGTcb77o4HHGUsARHzqd0ZUzT/iUdlnLbj8ZhkVzHICQysJC/NfgAYtPpiq",
+	"IDYWZSwqf8UZcJtBaRuoc5pyYNC3Q97GK8CyYu4mWqlXESSrim1PBKAbMY75j87c7spQQY/K3LVL8OqS",
+	"aKYoDa4rQCXcWExXRmMTTUDPExMPBeG4DXhjwavLo/kjlR8K8fE2729tCsbA4JcdWt2hM/1a5dN4KGTG",
+	"4gK6oncCYK1fOMbQB8zkNuh54oKqkmEhhHioTMEujOyRX5xFNuX5MwKS8gSO2yZdiZVHURZLoR154h5k",
+	"7cimABD8BtV/FsXTeOqFTuXDuQmMMjtfUI1uIRlE8PfsrTwDop45SxEeK9UrdOrp0EAU9joSeJefanzx",
+	"GVOqUpoAquQczvEAdLTDs0Y8xJmMF4qJCDNsbSYSfN7WjEbZwZxyLow0UDm/pPmXI/PibLaH2GIuRLBm",
+	"ry/cPC6KHpCFQldwb0lsIR1AILJgMhZRpzBGgUKMMdAfqER2CQUZ24AljDYBrbAGcDti9BIXzuawlDqz",
+	"nLkQPF/+YnXzG98LzDsblaYaa4e8yG+ooZiP0c3L57pCOrbaufsrRcMrxFvGp6DN9Ro8hRjmulKC01KV",
+	"wlptKnCoCRACIYTFy6w8LG6TcSany7qNwGAN99Fw4eVFjyhf4inYT0mSKSbmhGorPNGp+oa8vfpxp0V5",
+	"VQ2mhgAt1aFaD867KUD1b5MGZRfz3yzmf/twztWYtLM3mSD11PDyIws4dwPWnZgsWuScXjBF4BRYxNC6",
+	"ecmsfL8JGzy5+FzLCXms74qDvME6jGxFXIpJ2ZMrN5qiSlhDop5kvoHT0J/5Emxj5kJ1eesatzsjzcMZ",
+	"aQz+law0v2R21dxU419QNthoUoU6Uo2FptPpVKpbn7DXA+YCfxCSgV3tUnrfIwobZFuJoyhno4dm9jGm",
+	"gL+u++ak39Cyyn/1k/n+Ji6bDjnuLcO3maDeBzNowb0OpzUhYdDh+RIz9578UdqryXFmIDleknQ1teYf",
+	"Ro88af0vt6MOJOz4AYMT8DAdoT9fwn+r58FgjlvNYlInrtuLTVx6i1m+7yh1a3dUj1bL9OeLjr0521hn",
+	"opDeFk7xyVKkT1fo8/NM0HncerSc/j+bbcNBlzj355kgdE7etDagSNOajISST1WMu8DudonqH39qzcKx",
+	"1yXUtEe9Ktw31KxxcqA2Zf06ROneu7jeXYgeli1VZaj3FMV7S05fyakKysytSjnWqJs3Sgxe3MEr87Y4",
+	"mkqRLtQISMm+KIns2280ivB5ZM/7zuROGNnHEOPs0iHvJVFi7h5N8Lnjcecw6h6swuQfNIkjPEbCrkNm",
+	"vn606cjXsdcyfjYQzHsLkcThdgXD4H3PdSNUKRHGNHsErCEO4M0fbJ+fhMzuYvet7OGcy51b8WPl3Tn+",
+	"3TkTr8J2SY0Geuei4UVeRsR62WTeDTCnfUWtNE2cMW0h/5Fq5hPHjYSHN9auuMRjLy6xipwlln7+/GVD",
+	"Rq7FBePbsnHFQsk0MX234eXn2OMhOTnOuGPkj5aRW/wrhzA7fxD88c619E31H2Fa5wujlkqzuc0eY/D+",
+	"CpJgjRmZMg4IziKbqcs4+3SqrMgQwA+jnotb2JMzXL6/kpEwAzgRneFOd/H9j8Cgup5SXlsctKhLPcLp",
+	"bCUC9v7Af781N7xhe6uiAFbXFo2EdrU8f2eHe7R2uErMqLHNbcC72yXqW3VCQZxy9rw8eGV8eBQNu0e9",
+	"9uBwMGwPIjZoUzqh7TE9iobR+Gi8H02ca8aC6pnnPJVtcW1cTtm5wV0XMNKrQUalQspe8/L+6xs+SdLr",
+	"l89N+NdCCi1CkeQhhpEIVSfGRhjZEIr5nv043rvsdY7N7N9cT3g15/nHb5LZWl57T9EvRzGuwYTjV+g7",
+	"ZwmbSjrJ00pG7DIOnf+nWjB6UVwfBjjgxLCpM5ZM2jOhNIliyUKdLMFrE9P5wwFLpvI6fy+MpGq/4qGA",
+	"DEUnZPp7vDA1w9DTn0VeaYVJzJLIeO3OGVWpZBgqhwFgdEoUQ5df6vlnWh9kzxX6gi3JyOsdaDrt/Ujh",
+	"n/6PYzPFyM+wRKc2ak9Im0EIhoA5mQrpwpZC5EWIlPJFSRay2Dqiej6wGP84s0UYYQcjc5Yno0IUgl12",
+	"UDgl1zRcpAEA+0cThtftkVTRKfsWRwkbuTIFxsXUVmqr8jVkxtXQRrxihAbWRzCRG17rWBEjkKKCQ3ru",
+	"HmxccrkNuHBtyyUY6BzglgXT4O4wJNBCwsycKuZcwg2QYulVYwS8eGPyY9gimeghZbEEgynsyZm8p7Aw",
+	"zOShAjIy1RqoIj3sO0JnK/yi2yE/wQjWQ9SQd2G4i3ixANvl25hbP1KRakLziI3CrDr3lC5FLFisiErJ",
+	"vcy9KR8MwVqR3suLYDXgWpPLKy8gSstnEUmB27mnRG1mky5YM9gw3sgPj1lBObPNKw+ljR/7yNyFi2PC",
+	"757f+HlFrl2LnHXVURHwNm43T/uoBKHZKWaHbyY2IxoCcLEqWT4KFMeWPohV0ZEJs+tFlp0sEwAm/LUy",
+	"kxrmNwPk+2C5zSaP9Q/O4dWhQ4ZcKnBhNSMO0UGjFP87x/+aP42T+qzW1Tjzpq1xhDaXtk1+0O9sREGx",
+	"/Gq9SzSGfyO0CwHBgten4rmRT/SqupExIFjSKn+D0DijMaxF5bpFwshr41xXU8aV43mxlkExkHedkPhx",
+	"2O8cBOYz0P6P+50e6R3uHw26/UE3/9/m+NuiqlRjOVxN1aed0rxT5/+EDHFessSYk2r9s94LdN28ZiJc",
+	"pWFKqUxaJ60/3KjfT/b2/jC/f28FrUsqY4jURGRxbYqsBLTgVrDC3DImyDhkz/rVtYN/zIXDzFIcrNc/",
+	"6nQ73U7v5Lg7PFgZ1oCXfPr4FpA7f1hYjQb6hD5JkGQz5fqpi2pDDqBFJpLAa/zDG4/Q8Ta0ymJe42up",
+	"yUSvVDzlZhiYBNmiLc7txpXxdKY7+bDmsbVi3A/Zc5vMO6cJMKzzrGaVN6FZhzdy9syyOvap1R5R+Q5F",
+	"4uIXbYCU8yUmnzGpXZbTQbKFZHgDidgCs1QITpYi7ZR4ds2UxcjDLNsUorJNieIN5NfLXjFjUE0Vs2oV",
+	"ViHXwqYbsdY8GbPLfOg01KlkisyFSRCzSNg1aAu8uF3IUxpPUyO5IQCcYaC5yYst8xhwGLadzT8VInJa",
+	"vg//yC6y6mylmEo6dxUEIljCdM64zgLXoyzVbZ46hnLz6O53IE/mIkoT9tTmN1mYkY0qJFOuUMEjShAx",
+	"0YyTJ7YBRmjaaNVrw5+WRMt4OsUY0hBeCp5csfFMiIunPlLZlVds6kwLzA2eiNACEKZImDR5UsbAacg4",
+	"DS/w9YHMKZ9Cc2AjIlWmJeFCZ7WRfGCacarwinvBHWRO5YXZFKZLEbyAdUIavFcmgMXo7CbMPbBhxAp1",
+	"RYxPjAjqZwgoAEg8lrZkU2VIyOrSXvEoz1FDyWtJJ5RTUygRkUOkMmQBAMNkS/HXCnisZuKKnOLOgdfb",
+	"AQrMA7+Bl8//fwAM7zh6y/wBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
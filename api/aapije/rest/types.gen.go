@@ -59,6 +59,8 @@ const (
 
 	DatasetFormatToml DatasetFormat = "toml"
 
+	DatasetFormatTsarchive DatasetFormat = "tsarchive"
+
 	DatasetFormatXml DatasetFormat = "xml"
 
 	DatasetFormatYaml DatasetFormat = "yaml"
@@ -116,54 +118,67 @@ const (
 	ThingStatePassive ThingState = "passive"
 )
 
-// Defines values for AggregateParam.
+// Defines values for TsKind.
 const (
-	Avg AggregateParam = "avg"
+	TsKindCounter TsKind = "counter"
 
-	Count AggregateParam = "count"
+	TsKindGauge TsKind = "gauge"
+)
 
-	Max AggregateParam = "max"
+// Defines values for TsQuality.
+const (
+	TsQualityBad TsQuality = "bad"
 
-	Min AggregateParam = "min"
+	TsQualityCorrected TsQuality = "corrected"
 
-	Sum AggregateParam = "sum"
+	TsQualityEstimated TsQuality = "estimated"
+
+	TsQualitySubstituted TsQuality = "substituted"
 )
 
-// Defines values for PrecisionParam.
+// Defines values for TsQuarantinedPointReason.
 const (
-	Century PrecisionParam = "century"
+	TsQuarantinedPointReasonAboveUpperBound TsQuarantinedPointReason = "above_upper_bound"
 
-	Day PrecisionParam = "day"
+	TsQuarantinedPointReasonBelowLowerBound TsQuarantinedPointReason = "below_lower_bound"
+)
 
-	Decade PrecisionParam = "decade"
+// Defines values for TsRejectedPointReason.
+const (
+	TsRejectedPointReasonAboveUpperBound TsRejectedPointReason = "above_upper_bound"
+
+	TsRejectedPointReasonBelowLowerBound TsRejectedPointReason = "below_lower_bound"
+)
 
-	Hour PrecisionParam = "hour"
+// Defines values for AggregateParam.
+const (
+	Avg AggregateParam = "avg"
 
-	Microseconds PrecisionParam = "microseconds"
+	Count AggregateParam = "count"
 
-	Millennia PrecisionParam = "millennia"
+	Delta AggregateParam = "delta"
 
-	Milliseconds PrecisionParam = "milliseconds"
+	First AggregateParam = "first"
 
-	Minute PrecisionParam = "minute"
+	Integral AggregateParam = "integral"
 
-	Minute10 PrecisionParam = "minute10"
+	Last AggregateParam = "last"
 
-	Minute15 PrecisionParam = "minute15"
+	Max AggregateParam = "max"
 
-	Minute20 PrecisionParam = "minute20"
+	Median AggregateParam = "median"
 
-	Minute30 PrecisionParam = "minute30"
+	Min AggregateParam = "min"
 
-	Minute5 PrecisionParam = "minute5"
+	P95 AggregateParam = "p95"
 
-	Month PrecisionParam = "month"
+	P99 AggregateParam = "p99"
 
-	Second PrecisionParam = "second"
+	Rate AggregateParam = "rate"
 
-	Week PrecisionParam = "week"
+	Stddev AggregateParam = "stddev"
 
-	Year PrecisionParam = "year"
+	Sum AggregateParam = "sum"
 )
 
 // Alert defines model for Alert.
@@ -193,6 +208,30 @@ type AlertSeverity string
 // AlertStatus defines model for AlertStatus.
 type AlertStatus string
 
+// Annotation defines model for Annotation.
+type Annotation struct {
+	// Date-time when created, as defined by RFC 3339, section 5.6.
+	Created time.Time `json:"created"`
+
+	// User UUID reference.
+	CreatedBy string `json:"created_by"`
+
+	// End of the annotated time period, equal to `start` for an event at a single point in time.
+	End time.Time `json:"end"`
+
+	// Start of the annotated time period, as defined by RFC 3339, section 5.6.
+	Start time.Time `json:"start"`
+	Tags  []string  `json:"tags"`
+	Text  string    `json:"text"`
+
+	// The annotated Things.
+	Things []string `json:"things"`
+
+	// The annotated Time series.
+	Timeseries []string `json:"timeseries"`
+	Uuid       string   `json:"uuid"`
+}
+
 // CodeRevision defines model for CodeRevision.
 type CodeRevision struct {
 	Checksum string    `json:"checksum"`
@@ -220,7 +259,7 @@ type Dataset struct {
 	// User UUID reference.
 	CreatedBy string `json:"created_by"`
 
-	// File format of the data set.
+	// File format of the data set. Archived time series data has the format tsarchive.
 	Format DatasetFormat `json:"format"`
 
 	// Name of the resource. Does *not* have to be unique.
@@ -243,12 +282,102 @@ type Dataset struct {
 	Uuid string `json:"uuid"`
 }
 
-// File format of the data set.
+// File format of the data set. Archived time series data has the format tsarchive.
 type DatasetFormat string
 
 // Error message
 type Error string
 
+// GrafanaAnnotation defines model for GrafanaAnnotation.
+type GrafanaAnnotation struct {
+	Tags []string `json:"tags"`
+	Text string   `json:"text"`
+
+	// Milliseconds since the epoch when the alert was created.
+	Time int64 `json:"time"`
+
+	// Milliseconds since the epoch when the alert was last received.
+	TimeEnd int64  `json:"timeEnd"`
+	Title   string `json:"title"`
+}
+
+// GrafanaAnnotationQuery defines model for GrafanaAnnotationQuery.
+type GrafanaAnnotationQuery struct {
+	Name *string `json:"name,omitempty"`
+
+	// Filters of the alerts, separated by spaces, of the form `field=value`.
+	// The fields are `resource`, `environment`, `event`, `origin`, `status`, `severity`, `service` and `tag`, where `service` and `tag` may be repeated.
+	Query *string `json:"query,omitempty"`
+}
+
+// GrafanaAnnotationRequest defines model for GrafanaAnnotationRequest.
+type GrafanaAnnotationRequest struct {
+	Annotation *GrafanaAnnotationQuery `json:"annotation,omitempty"`
+	Range      GrafanaRange            `json:"range"`
+}
+
+// GrafanaQueryRequest defines model for GrafanaQueryRequest.
+type GrafanaQueryRequest struct {
+	// Width of the buckets in milliseconds.
+	IntervalMs *int64 `json:"intervalMs,omitempty"`
+
+	// Most data points per target, used for the width of the buckets when `intervalMs` is missing.
+	MaxDataPoints *int64          `json:"maxDataPoints,omitempty"`
+	Range         GrafanaRange    `json:"range"`
+	Targets       []GrafanaTarget `json:"targets"`
+}
+
+// GrafanaRange defines model for GrafanaRange.
+type GrafanaRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// GrafanaSearchRequest defines model for GrafanaSearchRequest.
+type GrafanaSearchRequest struct {
+	// Text to search for in the name and tags of the Timeseries.
+	Target *string `json:"target,omitempty"`
+}
+
+// GrafanaSearchResult defines model for GrafanaSearchResult.
+type GrafanaSearchResult struct {
+	// Name of the Timeseries.
+	Text string `json:"text"`
+
+	// UUID of the Timeseries, to use as `target` in a query.
+	Value string `json:"value"`
+}
+
+// GrafanaTarget defines model for GrafanaTarget.
+type GrafanaTarget struct {
+	// Hidden targets are not queried.
+	Hide    *bool                 `json:"hide,omitempty"`
+	Payload *GrafanaTargetPayload `json:"payload,omitempty"`
+	RefId   *string               `json:"refId,omitempty"`
+
+	// UUID of a Timeseries.
+	Target string `json:"target"`
+}
+
+// GrafanaTargetPayload defines model for GrafanaTargetPayload.
+type GrafanaTargetPayload struct {
+	// Aggregate function used for the buckets, as `aggregate` of `/v2/tsquery`. Defaults to `avg`.
+	Aggregate *string `json:"aggregate,omitempty"`
+
+	// Unit of the result, as `unit` of `/v2/tsquery`.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// GrafanaTimeSeries defines model for GrafanaTimeSeries.
+type GrafanaTimeSeries struct {
+	// Data points as pairs of value and milliseconds since the epoch.
+	Datapoints [][]float64 `json:"datapoints"`
+	RefId      *string     `json:"refId,omitempty"`
+
+	// Name of the Timeseries.
+	Target string `json:"target"`
+}
+
 // Group defines model for Group.
 type Group struct {
 	Name string `json:"name"`
@@ -318,9 +447,28 @@ type ThingState string
 
 // Timeseries defines model for Timeseries.
 type Timeseries struct {
-	CreatedBy  string   `json:"created_by"`
+	CreatedBy string `json:"created_by"`
+
+	// Expression of a derived Timeseries. `null` for a Timeseries that stores its own data.
+	Expression *string `json:"expression"`
+
+	// Kind of the values of a Timeseries, `gauge` when missing.
+	//
+	// - `gauge`: a measurement at a point in time, such as a temperature.
+	// - `counter`: a total that only increases, such as the reading of an energy meter. The counter may reset, for example when the device restarts, or wrap around to zero at its rollover value.
+	//
+	// The `delta`, `rate` and `sum` aggregates of a counter are compensated for resets and rollovers. The `sum` of a bucket is the increase since the last data point before the bucket, so that the sums of consecutive buckets add up. The `delta` and `rate` only use the data points within the bucket.
+	//
+	// A value below the lower bound of a counter is accepted after a reset, when it is not negative and either less than the value before it or the value before it is below the lower bound as well.
+	Kind       TsKind   `json:"kind"`
 	LowerBound *float64 `json:"lower_bound"`
 	Name       string   `json:"name"`
+
+	// Time to keep data, as an ISO-8601 duration. `null` keeps data forever.
+	Retention *string `json:"retention"`
+
+	// Value where a counter wraps around to zero. `null` for a counter that only resets.
+	Rollover   *float64 `json:"rollover"`
 	SiUnit     string   `json:"si_unit"`
 	Tags       []string `json:"tags"`
 	ThingUuid  *string  `json:"thing_uuid"`
@@ -342,9 +490,120 @@ type TokenWithSecret struct {
 	Uuid   string `json:"uuid"`
 }
 
+// TsBatchData defines model for TsBatchData.
+type TsBatchData struct {
+	Points []TsRow `json:"points"`
+
+	// The SI unit of the data points. A cast will occur if the base unit of the Timeseries differs.
+	Unit *string `json:"unit,omitempty"`
+	Uuid string  `json:"uuid"`
+}
+
+// TsBatchInsertResult defines model for TsBatchInsertResult.
+type TsBatchInsertResult struct {
+	Result TsInsertResult `json:"result"`
+	Uuid   string         `json:"uuid"`
+}
+
+// TsInsertError defines model for TsInsertError.
+type TsInsertError struct {
+	// The error that stopped the streamed body.
+	Message string         `json:"message"`
+	Result  TsInsertResult `json:"result"`
+}
+
+// TsInsertResult defines model for TsInsertResult.
+type TsInsertResult struct {
+	// Number of data points stored.
+	Accepted int64 `json:"accepted"`
+
+	// Number of data points skipped as their timestamp already exists.
+	Duplicates int64 `json:"duplicates"`
+
+	// Number of data points rejected by the lower or upper bound of the Timeseries.
+	Filtered int64 `json:"filtered"`
+
+	// Number of data points that replaced the value of an existing timestamp.
+	Overwritten int64 `json:"overwritten"`
+
+	// Number of rejected data points written to the quarantine of the Timeseries.
+	Quarantined int64 `json:"quarantined"`
+
+	// The data points rejected by the lower or upper bound of the Timeseries, at most 1000. The count of all rejected data points is `filtered`.
+	Rejected []TsRejectedPoint `json:"rejected"`
+
+	// Number of data points left out as another data point of the same request has the same timestamp.
+	Superseded int64 `json:"superseded"`
+}
+
+// Kind of the values of a Timeseries, `gauge` when missing.
+//
+// - `gauge`: a measurement at a point in time, such as a temperature.
+// - `counter`: a total that only increases, such as the reading of an energy meter. The counter may reset, for example when the device restarts, or wrap around to zero at its rollover value.
+//
+// The `delta`, `rate` and `sum` aggregates of a counter are compensated for resets and rollovers. The `sum` of a bucket is the increase since the last data point before the bucket, so that the sums of consecutive buckets add up. The `delta` and `rate` only use the data points within the bucket.
+//
+// A value below the lower bound of a counter is accepted after a reset, when it is not negative and either less than the value before it or the value before it is below the lower bound as well.
+type TsKind string
+
+// Quality of a data point that is not a plain measurement. Missing for a plain measurement.
+//
+// - `corrected`: the measured value was corrected by hand.
+// - `estimated`: the value was estimated, for example from a profile or from the neighbouring values.
+// - `substituted`: the value was taken from another source, for example a check meter.
+// - `bad`: the value is known to be wrong.
+//
+// An aggregated value has the most severe quality of the data points in its bucket, in the order above from least to most severe.
+type TsQuality string
+
+// TsQuarantinedPoint defines model for TsQuarantinedPoint.
+type TsQuarantinedPoint struct {
+	// When the data point was quarantined.
+	Created time.Time `json:"created"`
+
+	// Quality of a data point that is not a plain measurement. Missing for a plain measurement.
+	//
+	// - `corrected`: the measured value was corrected by hand.
+	// - `estimated`: the value was estimated, for example from a profile or from the neighbouring values.
+	// - `substituted`: the value was taken from another source, for example a check meter.
+	// - `bad`: the value is known to be wrong.
+	//
+	// An aggregated value has the most severe quality of the data points in its bucket, in the order above from least to most severe.
+	Q      *TsQuality               `json:"q,omitempty"`
+	Reason TsQuarantinedPointReason `json:"reason"`
+	Ts     time.Time                `json:"ts"`
+
+	// The value, in the unit of the Timeseries.
+	V float64 `json:"v"`
+}
+
+// TsQuarantinedPointReason defines model for TsQuarantinedPoint.Reason.
+type TsQuarantinedPointReason string
+
+// TsRejectedPoint defines model for TsRejectedPoint.
+type TsRejectedPoint struct {
+	Reason TsRejectedPointReason `json:"reason"`
+	Ts     time.Time             `json:"ts"`
+
+	// The value, in the unit of the Timeseries.
+	V float64 `json:"v"`
+}
+
+// TsRejectedPointReason defines model for TsRejectedPoint.Reason.
+type TsRejectedPointReason string
+
 // TsResults defines model for TsResults.
 type TsResults struct {
-	Data []TsRow `json:"data"`
+	// The Annotations of the Timeseries within the time period, when requested with `annotations`.
+	Annotations *[]Annotation `json:"annotations,omitempty"`
+	Data        []TsRow       `json:"data"`
+
+	// Set when the data of the Timeseries could not be returned, for example when the requested unit is not compatible with the unit of the Timeseries. `data` is empty.
+	Error      *string     `json:"error,omitempty"`
+	Timeseries *Timeseries `json:"timeseries,omitempty"`
+
+	// The SI unit of the values in `data`.
+	Unit string `json:"unit"`
 
 	// Reference to a Timeseries
 	Uuid string `json:"uuid"`
@@ -352,11 +611,51 @@ type TsResults struct {
 
 // TsRow defines model for TsRow.
 type TsRow struct {
+	// Quality of a data point that is not a plain measurement. Missing for a plain measurement.
+	//
+	// - `corrected`: the measured value was corrected by hand.
+	// - `estimated`: the value was estimated, for example from a profile or from the neighbouring values.
+	// - `substituted`: the value was taken from another source, for example a check meter.
+	// - `bad`: the value is known to be wrong.
+	//
+	// An aggregated value has the most severe quality of the data points in its bucket, in the order above from least to most severe.
+	Q *TsQuality `json:"q,omitempty"`
+
 	// Date-time when created, as defined by RFC 3339, section 5.6.
 	Ts time.Time `json:"ts"`
 
-	// Any number
-	V float32 `json:"v"`
+	// Any number. Only `null` in query results when using `fill=null`, or for buckets that can not be filled.
+	V *float32 `json:"v"`
+}
+
+// TsStats defines model for TsStats.
+type TsStats struct {
+	// Number of data points.
+	Count int64 `json:"count"`
+
+	// Set when no statistics can be given for the Timeseries, for example for a derived Timeseries.
+	Error *string `json:"error,omitempty"`
+
+	// Timestamp of the oldest data point. `null` without data.
+	First *time.Time `json:"first"`
+
+	// Timestamp of the newest data point. `null` without data.
+	Last *time.Time `json:"last"`
+
+	// Largest value. `null` without data.
+	Max *float64 `json:"max"`
+
+	// Average number of seconds between two data points. `null` with less than two data points.
+	MeanInterval *float64 `json:"mean_interval"`
+
+	// Smallest value. `null` without data.
+	Min *float64 `json:"min"`
+
+	// The SI unit of `min` and `max`.
+	Unit string `json:"unit"`
+
+	// Reference to a Timeseries
+	Uuid string `json:"uuid"`
 }
 
 // User defines model for User.
@@ -375,6 +674,12 @@ type EnvFilterParam string
 // EventFilterParam defines model for eventFilterParam.
 type EventFilterParam string
 
+// ExcludeQualityParam defines model for excludeQualityParam.
+type ExcludeQualityParam []string
+
+// FillParam defines model for fillParam.
+type FillParam string
+
 // GreaterOrEqParam defines model for greaterOrEqParam.
 type GreaterOrEqParam float32
 
@@ -390,9 +695,15 @@ type LimitParam int64
 // OffsetParam defines model for offsetParam.
 type OffsetParam int64
 
+// OnConflictParam defines model for onConflictParam.
+type OnConflictParam string
+
 // OriginFilterParam defines model for originFilterParam.
 type OriginFilterParam string
 
+// OriginParam defines model for originParam.
+type OriginParam time.Time
+
 // PrecisionParam defines model for precisionParam.
 type PrecisionParam string
 
@@ -450,6 +761,23 @@ type NewAlert struct {
 	Value    string        `json:"value"`
 }
 
+// NewAnnotation defines model for NewAnnotation.
+type NewAnnotation struct {
+	// End (<=) of the annotated time period. Defaults to `start`, an event at a single point in time.
+	End *time.Time `json:"end,omitempty"`
+
+	// Start (>=) of the annotated time period.
+	Start time.Time `json:"start"`
+	Tags  *[]string `json:"tags,omitempty"`
+	Text  string    `json:"text"`
+
+	// Things to annotate.
+	Things *[]string `json:"things,omitempty"`
+
+	// Time series to annotate.
+	Timeseries *[]string `json:"timeseries,omitempty"`
+}
+
 // NewDataset defines model for NewDataset.
 type NewDataset struct {
 	// Content of the resource.
@@ -511,11 +839,31 @@ type NewThing struct {
 
 // NewTimeseries defines model for NewTimeseries.
 type NewTimeseries struct {
+	// Optional expression that makes this a derived Timeseries. The values of a derived Timeseries are computed at query time from other Timeseries, referenced by their UUID in brackets. For example `[1896048c-bdc9-43c4-af41-4a946b9a341e] / [6e5b6f36-8a4a-4ac6-9ab7-8bb7d84dc4ee] * 3.6`.
+	//
+	// The inputs must store their own data, derived Timeseries can not be nested. A derived Timeseries can not store data.
+	Expression *string `json:"expression,omitempty"`
+
+	// Kind of the values of a Timeseries, `gauge` when missing.
+	//
+	// - `gauge`: a measurement at a point in time, such as a temperature.
+	// - `counter`: a total that only increases, such as the reading of an energy meter. The counter may reset, for example when the device restarts, or wrap around to zero at its rollover value.
+	//
+	// The `delta`, `rate` and `sum` aggregates of a counter are compensated for resets and rollovers. The `sum` of a bucket is the increase since the last data point before the bucket, so that the sums of consecutive buckets add up. The `delta` and `rate` only use the data points within the bucket.
+	//
+	// A value below the lower bound of a counter is accepted after a reset, when it is not negative and either less than the value before it or the value before it is below the lower bound as well.
+	Kind       *TsKind  `json:"kind,omitempty"`
 	LowerBound *float64 `json:"lower_bound,omitempty"`
 
 	// Name of the time series
 	Name string `json:"name"`
 
+	// Optional time to keep data, as an ISO-8601 (`P90D`) or Go style (`90d`, `36h`) duration. Data older than this is deleted by a background job. Months and years are not accepted.
+	Retention *string `json:"retention,omitempty"`
+
+	// Optional value where a counter wraps around to zero, such as `65536` for a 16 bit register. Only for Timeseries of the kind `counter`.
+	Rollover *float64 `json:"rollover,omitempty"`
+
 	// The SI unit assigned to this time series.
 	SiUnit string    `json:"si_unit"`
 	Tags   *[]string `json:"tags,omitempty"`
@@ -534,6 +882,9 @@ type NewToken struct {
 // NewTsData defines model for NewTsData.
 type NewTsData []TsRow
 
+// NewTsDataBatch defines model for NewTsDataBatch.
+type NewTsDataBatch []TsBatchData
+
 // NewUser defines model for NewUser.
 type NewUser struct {
 	// Name of the user
@@ -558,6 +909,20 @@ type UpdateAlert struct {
 	Value    *string        `json:"value,omitempty"`
 }
 
+// UpdateAnnotation defines model for UpdateAnnotation.
+type UpdateAnnotation struct {
+	End   *time.Time `json:"end,omitempty"`
+	Start *time.Time `json:"start,omitempty"`
+	Tags  *[]string  `json:"tags,omitempty"`
+	Text  *string    `json:"text,omitempty"`
+
+	// Replaces the annotated Things.
+	Things *[]string `json:"things,omitempty"`
+
+	// Replaces the annotated Time series.
+	Timeseries *[]string `json:"timeseries,omitempty"`
+}
+
 // The max allowed size of the complete request body is 1048576 bytes (1 MB). Performing a request with a Content-Length over this limit will result in a 400, malformed request error.
 type UpdateDataset struct {
 	// Base64 encoded content. Used for smaller uploads.
@@ -626,12 +991,31 @@ type UpdateThing struct {
 
 // UpdateTimeseries defines model for UpdateTimeseries.
 type UpdateTimeseries struct {
+	// Expression that makes this a derived Timeseries, see `NewTimeseries`. An empty string makes this a Timeseries that stores its own data.
+	Expression *string `json:"expression,omitempty"`
+
+	// Kind of the values of a Timeseries, `gauge` when missing.
+	//
+	// - `gauge`: a measurement at a point in time, such as a temperature.
+	// - `counter`: a total that only increases, such as the reading of an energy meter. The counter may reset, for example when the device restarts, or wrap around to zero at its rollover value.
+	//
+	// The `delta`, `rate` and `sum` aggregates of a counter are compensated for resets and rollovers. The `sum` of a bucket is the increase since the last data point before the bucket, so that the sums of consecutive buckets add up. The `delta` and `rate` only use the data points within the bucket.
+	//
+	// A value below the lower bound of a counter is accepted after a reset, when it is not negative and either less than the value before it or the value before it is below the lower bound as well.
+	Kind *TsKind `json:"kind,omitempty"`
+
 	// An optional lower bound at which values are accepted and stored. Values *less* than this will be rejected.
 	LowerBound *float64 `json:"lower_bound"`
 
 	// Name of the time-series.
 	Name *string `json:"name,omitempty"`
 
+	// Time to keep data, as an ISO-8601 (`P90D`) or Go style (`90d`, `36h`) duration. Data older than this is deleted by a background job. An empty string keeps data forever.
+	Retention *string `json:"retention,omitempty"`
+
+	// Value where a counter wraps around to zero, see `NewTimeseries`. Zero removes the rollover value. Changing the kind to `gauge` removes it as well.
+	Rollover *float64 `json:"rollover,omitempty"`
+
 	// SI unit.
 	SiUnit *string `json:"si_unit,omitempty"`
 
@@ -697,6 +1081,30 @@ type FindAlertsParams struct {
 	Service *ServiceFilterParam `json:"service,omitempty"`
 }
 
+// FindAnnotationsParams defines parameters for FindAnnotations.
+type FindAnnotationsParams struct {
+	// Annotations of these Time series.
+	Timeseries *[]string `json:"timeseries,omitempty"`
+
+	// Annotations of these Things.
+	Things *[]string `json:"things,omitempty"`
+
+	// Start (>=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	Start RangeStartParam `json:"start"`
+
+	// End (<=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	End RangeEndParam `json:"end"`
+
+	// Array of tags to match on
+	Tags *TagsFilterParam `json:"tags,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+}
+
 // FindDatasetsParams defines parameters for FindDatasets.
 type FindDatasetsParams struct {
 	// The number of items to skip before starting to collect the result set.
@@ -749,6 +1157,15 @@ type DeleteDatasetUploadByKeyParams struct {
 	Key string `json:"key"`
 }
 
+// GrafanaAnnotationsJSONBody defines parameters for GrafanaAnnotations.
+type GrafanaAnnotationsJSONBody GrafanaAnnotationRequest
+
+// GrafanaQueryJSONBody defines parameters for GrafanaQuery.
+type GrafanaQueryJSONBody GrafanaQueryRequest
+
+// GrafanaSearchJSONBody defines parameters for GrafanaSearch.
+type GrafanaSearchJSONBody GrafanaSearchRequest
+
 // FindGroupsParams defines parameters for FindGroups.
 type FindGroupsParams struct {
 	// The numbers of items to return.
@@ -821,6 +1238,12 @@ type GetProgramCodeRevisionsDiffParams struct {
 	RevB int `json:"rev_b"`
 }
 
+// PrometheusRemoteWriteParams defines parameters for PrometheusRemoteWrite.
+type PrometheusRemoteWriteParams struct {
+	// Map the series to the Timeseries of this Thing, and create the missing ones in it.
+	Thing *string `json:"thing,omitempty"`
+}
+
 // FindThingsParams defines parameters for FindThings.
 type FindThingsParams struct {
 	// The numbers of items to return.
@@ -848,6 +1271,12 @@ type FindTimeSeriesParams struct {
 	Tags *TagsFilterParam `json:"tags,omitempty"`
 }
 
+// UpdateTimeseriesByUuidParams defines parameters for UpdateTimeseriesByUuid.
+type UpdateTimeseriesByUuidParams struct {
+	// Convert the stored data of the Timeseries to the new `si_unit`.
+	ConvertData *bool `json:"convert_data,omitempty"`
+}
+
 // DeleteDataFromTimeSeriesParams defines parameters for DeleteDataFromTimeSeries.
 type DeleteDataFromTimeSeriesParams struct {
 	// Start (>=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
@@ -880,18 +1309,51 @@ type QueryTimeseriesForDataParams struct {
 	// Value should be less or equal to (<=) this.
 	Le *LessOrEqParam `json:"le,omitempty"`
 
-	// Truncate all timestamps and perform aggregate operations on the grouping.
-	Precision *QueryTimeseriesForDataParamsPrecision `json:"precision,omitempty"`
+	// Group all timestamps into buckets of this width and perform aggregate operations on the grouping.
+	//
+	// - An ISO-8601 duration, for example `PT7M`, `PT1H30M`, `P3D` or `P1M`.
+	// - A Go style duration, for example `90s`, `2h` or `1h30m`, extended with the units `d` (day), `w` (week), `mo` (month) and `y` (year). For example `3d` or `6mo`.
+	// - One of the named precisions `microseconds`, `milliseconds`, `second`, `minute`, `minute5`, `minute10`, `minute15`, `minute20`, `minute30`, `hour`, `day`, `week`, `month`, `year`, `decade`, `century` or `millennia`.
+	//
+	// Buckets are counted from `origin` in the wall clock time of `timezone`, a day is therefore a calendar day also when daylight saving time changes.
+	// Months and years are calendar units and can not be combined with fixed units, such as `P1M2D`.
+	Precision *PrecisionParam `json:"precision,omitempty"`
+
+	// When using `precision`. Start counting buckets from this time. Defaults to `2000-01-03T00:00:00` (a Monday) in `timezone` for fixed widths, so that weeks start on Monday, and to `2000-01-01T00:00:00` in `timezone` for months and years.
+	Origin *OriginParam `json:"origin,omitempty"`
 
 	// When using `precision`. Select this aggregate function instead of the default `avg` when computing the result. Does nothing when `precision` is not set.
+	//
+	// - `avg`, `min`, `max`, `sum` and `count` of the values in the bucket.
+	// - `first` and `last` value in the bucket.
+	// - `delta` is the last value minus the first value in the bucket.
+	// - `rate` is the `delta` divided by the seconds between the first and last value in the bucket.
+	// - `integral` is the time-weighted area under the values in the bucket, in value × hours. For example W to Wh.
+	// - `median`, `p95` and `p99` are the continuous 50th, 95th and 99th percentiles.
+	// - `stddev` is the sample standard deviation.
+	//
+	// Buckets where the result is undefined, for example the `rate` or `stddev` of a single value, are left out.
+	//
+	// When converting to another `unit`, `count` is not converted and `sum`, `delta`, `rate`, `integral` and `stddev` are only scaled, not offset.
 	Aggregate *QueryTimeseriesForDataParamsAggregate `json:"aggregate,omitempty"`
 
 	// Act as this time zone. Defaults to `UTC`.
 	Timezone *TimezoneParam `json:"timezone,omitempty"`
-}
 
-// QueryTimeseriesForDataParamsPrecision defines parameters for QueryTimeseriesForData.
-type QueryTimeseriesForDataParamsPrecision string
+	// When using `precision`. Return every bucket between `start` and `end` and fill the buckets without data. Defaults to `none`.
+	//
+	// - `none` only returns buckets with data.
+	// - `null` sets the value of empty buckets to `null`.
+	// - `previous` carries the last known value forward.
+	// - `linear` interpolates between the surrounding values.
+	// - A number, for example `0`, sets the value of empty buckets to that number.
+	//
+	// Filling is performed after the `ge` and `le` checks. Buckets before the first value (`previous`, `linear`) or after the last value (`linear`) are `null`.
+	Fill *FillParam `json:"fill,omitempty"`
+
+	// Leave out data points of these qualities, for example `bad`, before any aggregate is computed. Plain measurements are never left out.
+	ExcludeQuality *ExcludeQualityParam `json:"exclude_quality,omitempty"`
+}
 
 // QueryTimeseriesForDataParamsAggregate defines parameters for QueryTimeseriesForData.
 type QueryTimeseriesForDataParamsAggregate string
@@ -900,12 +1362,93 @@ type QueryTimeseriesForDataParamsAggregate string
 type AddDataToTimeseriesParams struct {
 	// The SI unit of the result. A cast will occur if the base unit differes.
 	Unit *SiUnitParam `json:"unit,omitempty"`
+
+	// How to handle data points where the timestamp already exists in the Timeseries.
+	//
+	// - `error` fails the request and stores nothing, also when a timestamp occurs more than once in the request. Default for JSON bodies.
+	// - `ignore` skips the data points and counts them as `duplicates`. When a timestamp occurs more than once in a request, the first data point is used. Default for NDJSON and CSV bodies.
+	// - `overwrite` replaces the stored value and counts the data points as `overwritten`. When a timestamp occurs more than once in a request, the last data point is used.
+	//
+	// The data points left out as their timestamp occurs more than once in the request are counted as `superseded`. For NDJSON and CSV bodies this applies within each batch.
+	OnConflict *OnConflictParam `json:"on_conflict,omitempty"`
+
+	// Write data points rejected by the lower or upper bound to the quarantine of the Timeseries.
+	Quarantine *bool `json:"quarantine,omitempty"`
+}
+
+// FindQuarantinedDataOfTimeseriesParams defines parameters for FindQuarantinedDataOfTimeseries.
+type FindQuarantinedDataOfTimeseriesParams struct {
+	// Start (>=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	Start RangeStartParam `json:"start"`
+
+	// End (<=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	End RangeEndParam `json:"end"`
+
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
+// ReplayQuarantinedDataToTimeseriesParams defines parameters for ReplayQuarantinedDataToTimeseries.
+type ReplayQuarantinedDataToTimeseriesParams struct {
+	// Start (>=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	Start RangeStartParam `json:"start"`
+
+	// End (<=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	End RangeEndParam `json:"end"`
+}
+
+// AddDataToManyTimeseriesParams defines parameters for AddDataToManyTimeseries.
+type AddDataToManyTimeseriesParams struct {
+	// How to handle data points where the timestamp already exists in the Timeseries.
+	//
+	// - `error` fails the request and stores nothing, also when a timestamp occurs more than once in the request. Default for JSON bodies.
+	// - `ignore` skips the data points and counts them as `duplicates`. When a timestamp occurs more than once in a request, the first data point is used. Default for NDJSON and CSV bodies.
+	// - `overwrite` replaces the stored value and counts the data points as `overwritten`. When a timestamp occurs more than once in a request, the last data point is used.
+	//
+	// The data points left out as their timestamp occurs more than once in the request are counted as `superseded`. For NDJSON and CSV bodies this applies within each batch.
+	OnConflict *OnConflictParam `json:"on_conflict,omitempty"`
+
+	// Write data points rejected by the lower or upper bound to the quarantine of the Timeseries.
+	Quarantine *bool `json:"quarantine,omitempty"`
+}
+
+// FindLatestTsdataParams defines parameters for FindLatestTsdata.
+type FindLatestTsdataParams struct {
+	// A series of timeseries UUIDs
+	Uuids []string `json:"uuids"`
+
+	// Number of data points per Timeseries.
+	Count *int `json:"count,omitempty"`
+}
+
+// FindStatsOfManyTimeseriesParams defines parameters for FindStatsOfManyTimeseries.
+type FindStatsOfManyTimeseriesParams struct {
+	// A series of timeseries UUIDs
+	Uuids []string `json:"uuids"`
+}
+
+// StreamTsdataParams defines parameters for StreamTsdata.
+type StreamTsdataParams struct {
+	// A series of timeseries UUIDs
+	Uuids []string `json:"uuids"`
 }
 
 // FindTsdataByQueryParams defines parameters for FindTsdataByQuery.
 type FindTsdataByQueryParams struct {
-	// A series of timeseries UUIDs to search for
-	Uuids []string `json:"uuids"`
+	// A series of timeseries UUIDs to search for. Can not be combined with `tags` or `thing`.
+	Uuids *[]string `json:"uuids,omitempty"`
+
+	// Select the Timeseries with these tags, instead of listing `uuids`.
+	Tags *[]string `json:"tags,omitempty"`
+
+	// Select Timeseries with `any` or with `all` of the `tags`.
+	TagsMatch *string `json:"tags_match,omitempty"`
+
+	// Select the Timeseries of this Thing, instead of listing `uuids`.
+	Thing *string `json:"thing,omitempty"`
 
 	// Start (>=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
 	Start RangeStartParam `json:"start"`
@@ -919,18 +1462,60 @@ type FindTsdataByQueryParams struct {
 	// Value should be less or equal to (<=) this.
 	Le *LessOrEqParam `json:"le,omitempty"`
 
-	// Truncate all timestamps and perform aggregate operations on the grouping.
-	Precision *FindTsdataByQueryParamsPrecision `json:"precision,omitempty"`
+	// Group all timestamps into buckets of this width and perform aggregate operations on the grouping.
+	//
+	// - An ISO-8601 duration, for example `PT7M`, `PT1H30M`, `P3D` or `P1M`.
+	// - A Go style duration, for example `90s`, `2h` or `1h30m`, extended with the units `d` (day), `w` (week), `mo` (month) and `y` (year). For example `3d` or `6mo`.
+	// - One of the named precisions `microseconds`, `milliseconds`, `second`, `minute`, `minute5`, `minute10`, `minute15`, `minute20`, `minute30`, `hour`, `day`, `week`, `month`, `year`, `decade`, `century` or `millennia`.
+	//
+	// Buckets are counted from `origin` in the wall clock time of `timezone`, a day is therefore a calendar day also when daylight saving time changes.
+	// Months and years are calendar units and can not be combined with fixed units, such as `P1M2D`.
+	Precision *PrecisionParam `json:"precision,omitempty"`
+
+	// When using `precision`. Start counting buckets from this time. Defaults to `2000-01-03T00:00:00` (a Monday) in `timezone` for fixed widths, so that weeks start on Monday, and to `2000-01-01T00:00:00` in `timezone` for months and years.
+	Origin *OriginParam `json:"origin,omitempty"`
 
 	// When using `precision`. Select this aggregate function instead of the default `avg` when computing the result. Does nothing when `precision` is not set.
+	//
+	// - `avg`, `min`, `max`, `sum` and `count` of the values in the bucket.
+	// - `first` and `last` value in the bucket.
+	// - `delta` is the last value minus the first value in the bucket.
+	// - `rate` is the `delta` divided by the seconds between the first and last value in the bucket.
+	// - `integral` is the time-weighted area under the values in the bucket, in value × hours. For example W to Wh.
+	// - `median`, `p95` and `p99` are the continuous 50th, 95th and 99th percentiles.
+	// - `stddev` is the sample standard deviation.
+	//
+	// Buckets where the result is undefined, for example the `rate` or `stddev` of a single value, are left out.
+	//
+	// When converting to another `unit`, `count` is not converted and `sum`, `delta`, `rate`, `integral` and `stddev` are only scaled, not offset.
 	Aggregate *FindTsdataByQueryParamsAggregate `json:"aggregate,omitempty"`
 
 	// Act as this time zone. Defaults to `UTC`.
 	Timezone *TimezoneParam `json:"timezone,omitempty"`
-}
 
-// FindTsdataByQueryParamsPrecision defines parameters for FindTsdataByQuery.
-type FindTsdataByQueryParamsPrecision string
+	// When using `precision`. Return every bucket between `start` and `end` and fill the buckets without data. Defaults to `none`.
+	//
+	// - `none` only returns buckets with data.
+	// - `null` sets the value of empty buckets to `null`.
+	// - `previous` carries the last known value forward.
+	// - `linear` interpolates between the surrounding values.
+	// - A number, for example `0`, sets the value of empty buckets to that number.
+	//
+	// Filling is performed after the `ge` and `le` checks. Buckets before the first value (`previous`, `linear`) or after the last value (`linear`) are `null`.
+	Fill *FillParam `json:"fill,omitempty"`
+
+	// Leave out data points of these qualities, for example `bad`, before any aggregate is computed. Plain measurements are never left out.
+	ExcludeQuality *ExcludeQualityParam `json:"exclude_quality,omitempty"`
+
+	// The SI unit of the result. A cast will occur if the base unit differes.
+	Unit *SiUnitParam `json:"unit,omitempty"`
+
+	// The SI unit of the result per Timeseries, as `uuid:unit`. Takes precedence over `unit`.
+	Units *[]string `json:"units,omitempty"`
+
+	// Include the Annotations of each Timeseries in the result.
+	Annotations *bool `json:"annotations,omitempty"`
+}
 
 // FindTsdataByQueryParamsAggregate defines parameters for FindTsdataByQuery.
 type FindTsdataByQueryParamsAggregate string
@@ -944,18 +1529,45 @@ type FindUsersParams struct {
 	Offset *OffsetParam `json:"offset,omitempty"`
 }
 
+// WriteLineProtocolParams defines parameters for WriteLineProtocol.
+type WriteLineProtocolParams struct {
+	// Precision of the timestamps, one of `ns`, `us`, `ms`, `s`, `m` or `h`.
+	Precision *string `json:"precision,omitempty"`
+
+	// Map the series to the Timeseries of this Thing, and create the missing ones.
+	Thing *string `json:"thing,omitempty"`
+
+	// The `si_unit` of created Timeseries. Required when a Timeseries is created.
+	Unit *string `json:"unit,omitempty"`
+}
+
 // CreateAlertJSONRequestBody defines body for CreateAlert for application/json ContentType.
 type CreateAlertJSONRequestBody NewAlert
 
 // UpdateAlertByUuidJSONRequestBody defines body for UpdateAlertByUuid for application/json ContentType.
 type UpdateAlertByUuidJSONRequestBody UpdateAlert
 
+// AddAnnotationJSONRequestBody defines body for AddAnnotation for application/json ContentType.
+type AddAnnotationJSONRequestBody NewAnnotation
+
+// UpdateAnnotationByUuidJSONRequestBody defines body for UpdateAnnotationByUuid for application/json ContentType.
+type UpdateAnnotationByUuidJSONRequestBody UpdateAnnotation
+
 // AddDatasetsJSONRequestBody defines body for AddDatasets for application/json ContentType.
 type AddDatasetsJSONRequestBody NewDataset
 
 // UpdateDatasetByUuidJSONRequestBody defines body for UpdateDatasetByUuid for application/json ContentType.
 type UpdateDatasetByUuidJSONRequestBody UpdateDataset
 
+// GrafanaAnnotationsJSONRequestBody defines body for GrafanaAnnotations for application/json ContentType.
+type GrafanaAnnotationsJSONRequestBody GrafanaAnnotationsJSONBody
+
+// GrafanaQueryJSONRequestBody defines body for GrafanaQuery for application/json ContentType.
+type GrafanaQueryJSONRequestBody GrafanaQueryJSONBody
+
+// GrafanaSearchJSONRequestBody defines body for GrafanaSearch for application/json ContentType.
+type GrafanaSearchJSONRequestBody GrafanaSearchJSONBody
+
 // AddGroupJSONRequestBody defines body for AddGroup for application/json ContentType.
 type AddGroupJSONRequestBody NewGroup
 
@@ -989,6 +1601,9 @@ type UpdateTimeseriesByUuidJSONRequestBody UpdateTimeseries
 // AddDataToTimeseriesJSONRequestBody defines body for AddDataToTimeseries for application/json ContentType.
 type AddDataToTimeseriesJSONRequestBody NewTsData
 
+// AddDataToManyTimeseriesJSONRequestBody defines body for AddDataToManyTimeseries for application/json ContentType.
+type AddDataToManyTimeseriesJSONRequestBody NewTsDataBatch
+
 // AddUserJSONRequestBody defines body for AddUser for application/json ContentType.
 type AddUserJSONRequestBody NewUser
 
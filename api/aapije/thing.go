@@ -187,6 +187,12 @@ func (ra *RestApi) FindDatasetsForThing(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
 	s := services.NewThingService(db)
 	if ok, err := s.Exists(r.Context(), thingUUID); err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
@@ -197,7 +203,7 @@ func (ra *RestApi) FindDatasetsForThing(w http.ResponseWriter, r *http.Request,
 	}
 
 	srv := services.NewDatasetService(db)
-	datasets, err := srv.FindByThing(r.Context(), thingUUID)
+	datasets, err := srv.FindByThing(r.Context(), []byte(domaintoken.Token), thingUUID)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
 		return
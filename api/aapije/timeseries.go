@@ -7,6 +7,7 @@ package aapije
 import (
 	"database/sql"
 	"encoding/json"
+	"mime"
 	"net/http"
 	"time"
 
@@ -73,6 +74,26 @@ func (ra *RestApi) AddTimeSeries(w http.ResponseWriter, r *http.Request) {
 		params.UpperBound.Scan(*n.UpperBound)
 	}
 
+	if n.Retention != nil {
+		params.Retention, err = services.ParseRetention(*n.Retention)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
+	if n.Expression != nil {
+		params.Expression = *n.Expression
+	}
+
+	if n.Kind != nil {
+		params.Kind = *n.Kind
+	}
+
+	if n.Rollover != nil {
+		params.Rollover = sql.NullFloat64{Float64: *n.Rollover, Valid: true}
+	}
+
 	s := services.NewTimeseriesService(db)
 
 	// Add the time series
@@ -113,47 +134,113 @@ func (ra *RestApi) AddDataToTimeseries(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
+	var onConflict services.ConflictMode
+	if p.OnConflict != nil {
+		onConflict, err = services.ParseConflictMode(string(*p.OnConflict))
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
 	svc := services.NewTimeseriesService(db)
 
-	// Allow max of 5 MB read from body
-	r.Body = http.MaxBytesReader(w, r.Body, 5242880)
+	var result *rest.TsInsertResult
 
-	// We expect a NewTsData object in the request body.
-	var obj rest.NewTsData
-	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
-		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
-		return
-	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
 
-	if len(obj) == 0 {
-		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
-		return
-	}
+	switch mediaType {
+	case "application/x-ndjson", "text/csv":
+		// Streamed bodies are inserted in batches and are not limited in size
+		var reader services.DataPointReader
+		if mediaType == "text/csv" {
+			reader = services.NewCSVPointReader(r.Body)
+		} else {
+			reader = services.NewNDJSONPointReader(r.Body)
+		}
+
+		result, err = svc.AddDataStreamToTimeseries(r.Context(), services.AddDataStreamToTimeseriesParams{
+			Uuid:       tsUUID,
+			Reader:     reader,
+			CreatedBy:  createdBy,
+			Unit:       (*string)(p.Unit),
+			Quarantine: p.Quarantine != nil && *p.Quarantine,
+			OnConflict: onConflict,
+		})
+		if err != nil && result != nil {
+			// The batches before the error are stored, report them with the error
+			e := ie.ParseDBError(err)
+			ie.SendHTTPErrorJSON(w, e, rest.TsInsertError{
+				Message: e.Error(),
+				Result:  *result,
+			})
+			return
+		}
+	default:
+		// Allow max of 5 MB read from body
+		r.Body = http.MaxBytesReader(w, r.Body, 5242880)
 
-	points := make([]services.DataPoint, len(obj))
-	for i, element := range obj {
-		points[i] = services.DataPoint{
-			Value:     float64(element.V),
-			Timestamp: element.Ts,
+		// We expect a NewTsData object in the request body.
+		var obj rest.NewTsData
+		if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
+
+		if len(obj) == 0 {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
 		}
+
+		points := make([]services.DataPoint, len(obj))
+		for i, element := range obj {
+			if element.V == nil {
+				ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+				return
+			}
+
+			var q services.Quality
+			if element.Q != nil {
+				q, err = services.ParseQuality(string(*element.Q))
+				if err != nil {
+					ie.SendHTTPError(w, ie.ParseDBError(err))
+					return
+				}
+			}
+
+			points[i] = services.DataPoint{
+				Value:     float64(*element.V),
+				Timestamp: element.Ts,
+				Quality:   q,
+			}
+		}
+
+		result, err = svc.AddDataToTimeseries(r.Context(), services.AddDataToTimeseriesParams{
+			Uuid:       tsUUID,
+			Points:     points,
+			CreatedBy:  createdBy,
+			Unit:       (*string)(p.Unit),
+			Quarantine: p.Quarantine != nil && *p.Quarantine,
+			OnConflict: onConflict,
+		})
 	}
 
-	count, err := svc.AddDataToTimeseries(r.Context(), services.AddDataToTimeseriesParams{
-		Uuid:      tsUUID,
-		Points:    points,
-		CreatedBy: createdBy,
-		Unit:      (*string)(p.Unit),
-	})
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
 		return
-	} else if count == 0 {
-		// No rows where inserted due to boundary checks
+	} else if result.Accepted == 0 && result.Overwritten == 0 && result.Filtered == 0 {
+		// No rows where inserted
 		w.WriteHeader(http.StatusNoContent)
 		return
+	} else if result.Accepted == 0 && result.Overwritten == 0 {
+		// No rows where inserted due to boundary checks, report the rejected points
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(result)
+		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
 }
 
 // QueryTimeseriesForData returns data from a specific time series
@@ -187,6 +274,11 @@ func (ra *RestApi) QueryTimeseriesForData(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// A derived time series requires read access to each of its inputs
+	if ok := ra.checkExpressionInputAccess(w, r, svc, []uuid.UUID{tsUUID}); ok == false {
+		return
+	}
+
 	params := services.QuerySingleSourceDataParams{
 		Uuid:        tsUUID,
 		Start:       time.Time(p.Start),
@@ -214,6 +306,27 @@ func (ra *RestApi) QueryTimeseriesForData(w http.ResponseWriter, r *http.Request
 		params.Precision = "microseconds"
 	}
 
+	if p.Origin != nil {
+		origin := time.Time(*p.Origin)
+		params.Origin = &origin
+	}
+
+	if p.Fill != nil {
+		params.Fill, err = services.ParseFillMode(string(*p.Fill))
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
+	if p.ExcludeQuality != nil {
+		params.ExcludeQuality, err = services.ParseQualities(*p.ExcludeQuality)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
 	data, err := svc.QuerySingleSourceData(r.Context(), params)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
@@ -306,7 +419,7 @@ func (ra *RestApi) FindTimeSeriesByUuid(w http.ResponseWriter, r *http.Request,
 }
 
 // UpdateTimeseriesByUuid updates a specific time series by its UUID
-func (ra *RestApi) UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+func (ra *RestApi) UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.UpdateTimeseriesByUuidParams) {
 	tsUUID, err := uuid.Parse(string(id))
 	if err != nil {
 		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
@@ -329,10 +442,11 @@ func (ra *RestApi) UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request
 	}
 
 	params := services.UpdateTimeseriesParams{
-		Uuid:   tsUUID,
-		Name:   obj.Name,
-		SiUnit: obj.SiUnit,
-		Tags:   obj.Tags,
+		Uuid:        tsUUID,
+		Name:        obj.Name,
+		SiUnit:      obj.SiUnit,
+		Tags:        obj.Tags,
+		ConvertData: p.ConvertData != nil && *p.ConvertData,
 	}
 
 	if obj.ThingUuid != nil {
@@ -364,6 +478,30 @@ func (ra *RestApi) UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request
 		params.UpperBound = &v
 	}
 
+	if obj.Retention != nil {
+		var v time.Duration
+		if *obj.Retention != "" {
+			v, err = services.ParseRetention(*obj.Retention)
+			if err != nil {
+				ie.SendHTTPError(w, ie.ParseDBError(err))
+				return
+			}
+		}
+		params.Retention = &v
+	}
+
+	if obj.Expression != nil {
+		params.Expression = obj.Expression
+	}
+
+	if obj.Kind != nil {
+		params.Kind = obj.Kind
+	}
+
+	if obj.Rollover != nil {
+		params.Rollover = obj.Rollover
+	}
+
 	count, err := svc.UpdateTimeseries(r.Context(), params)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
@@ -394,7 +532,7 @@ func (ra *RestApi) DeleteTimeSeriesByUuid(w http.ResponseWriter, r *http.Request
 
 	count, err := svc.DeleteTimeseries(r.Context(), tsUUID)
 	if err != nil {
-		ie.SendHTTPError(w, ie.ErrorUndefined)
+		ie.SendHTTPError(w, ie.ParseDBError(err))
 		return
 	} else if count == 0 {
 		ie.SendHTTPError(w, ie.ErrorNotFound)
@@ -451,3 +589,145 @@ func (ra *RestApi) DeleteDataFromTimeSeries(w http.ResponseWriter, r *http.Reque
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// FindQuarantinedDataOfTimeseries returns the data points rejected by the bounds of a time series
+func (ra *RestApi) FindQuarantinedDataOfTimeseries(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindQuarantinedDataOfTimeseriesParams) {
+	tsUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	// Ensure the timeseries exists
+	ok, err := svc.Exists(r.Context(), tsUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if ok == false {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	if time.Time(p.End).Sub(time.Time(p.Start)) > 31622401*time.Second {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	params := services.FindQuarantineParams{
+		Uuid:  tsUUID,
+		Start: time.Time(p.Start),
+		End:   time.Time(p.End),
+	}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	points, err := svc.FindQuarantine(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(points)
+}
+
+// ReplayQuarantinedDataToTimeseries adds the quarantined data points within the current bounds to a time series
+func (ra *RestApi) ReplayQuarantinedDataToTimeseries(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.ReplayQuarantinedDataToTimeseriesParams) {
+	tsUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	createdBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	if time.Time(p.End).Sub(time.Time(p.Start)) > 31622401*time.Second {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	result, err := svc.ReplayQuarantine(r.Context(), services.ReplayQuarantineParams{
+		Uuid:      tsUUID,
+		Start:     time.Time(p.Start),
+		End:       time.Time(p.End),
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+// FindStatsOfTimeseries returns the statistics of the data of a time series
+func (ra *RestApi) FindStatsOfTimeseries(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	tsUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	stats, err := svc.FindTsDataStats(r.Context(), []uuid.UUID{tsUUID})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(stats[0])
+}
+
+// checkExpressionInputAccess ensures that the user has read access to the inputs of every derived time series in uuids.
+// Sends an error and returns false otherwise.
+func (ra *RestApi) checkExpressionInputAccess(w http.ResponseWriter, r *http.Request, svc *services.TimeseriesService, uuids []uuid.UUID) bool {
+	inputs, err := svc.FindExpressionInputs(r.Context(), uuids)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return false
+	} else if len(inputs) == 0 {
+		return true
+	}
+
+	return ra.checkTsDataAccess(w, r, "read", inputs)
+}
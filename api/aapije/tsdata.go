@@ -0,0 +1,444 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util"
+)
+
+// AddDataToManyTimeseries adds data to several time series in one transaction
+func (ra *RestApi) AddDataToManyTimeseries(w http.ResponseWriter, r *http.Request, p rest.AddDataToManyTimeseriesParams) {
+	// Allow max of 5 MB read from body
+	r.Body = http.MaxBytesReader(w, r.Body, 5242880)
+
+	// We expect a NewTsDataBatch object in the request body.
+	var obj []rest.TsBatchData
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	if len(obj) == 0 {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	var onConflict services.ConflictMode
+	if p.OnConflict != nil {
+		var err error
+		onConflict, err = services.ParseConflictMode(string(*p.OnConflict))
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	createdBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := make([]services.AddDataToTimeseriesParams, len(obj))
+	resources := make([]string, len(obj))
+	for i, item := range obj {
+		tsUUID, err := uuid.Parse(item.Uuid)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+
+		points := make([]services.DataPoint, len(item.Points))
+		for j, element := range item.Points {
+			if element.V == nil {
+				ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+				return
+			}
+
+			var q services.Quality
+			if element.Q != nil {
+				q, err = services.ParseQuality(string(*element.Q))
+				if err != nil {
+					ie.SendHTTPError(w, ie.ParseDBError(err))
+					return
+				}
+			}
+
+			points[j] = services.DataPoint{
+				Value:     float64(*element.V),
+				Timestamp: element.Ts,
+				Quality:   q,
+			}
+		}
+
+		params[i] = services.AddDataToTimeseriesParams{
+			Uuid:       tsUUID,
+			Points:     points,
+			CreatedBy:  createdBy,
+			Unit:       item.Unit,
+			Quarantine: p.Quarantine != nil && *p.Quarantine,
+			OnConflict: onConflict,
+		}
+
+		// Generate check rules for access control
+		resources[i] = fmt.Sprintf("timeseries/%v/data", tsUUID.String())
+	}
+
+	// Ensure that the User has access to all time series
+	policySvc := services.NewPolicyCheckService(db)
+	ok, err = policySvc.UserHasManyAccessViaToken(r.Context(), []byte(domaintoken.Token), "create", resources)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if ok == false {
+		// Access denied to one or more requested resources
+		ie.SendHTTPError(w, ie.ErrorForbidden)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	results, err := svc.AddDataToManyTimeseries(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(results)
+}
+
+// FindLatestTsdata returns the newest data points of several time series
+func (ra *RestApi) FindLatestTsdata(w http.ResponseWriter, r *http.Request, p rest.FindLatestTsdataParams) {
+	count := 1
+	if p.Count != nil {
+		count = *p.Count
+	}
+
+	uuids, err := util.StringSliceToUuidSlice(p.Uuids)
+	if err != nil {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("uuids has invalid format")))
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Ensure that the User has access to all requested items
+	if ok := ra.checkTsDataAccess(w, r, "read", uuids); ok == false {
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	results, err := svc.FindLatestTsData(r.Context(), services.FindLatestTsDataParams{
+		Uuids: uuids,
+		Count: count,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(results)
+}
+
+// Interval of the keep alive comments of a data stream, the access of the user is checked again at the same time
+const streamKeepAliveInterval = 15 * time.Second
+
+// StreamTsdata sends the data points committed to several time series as Server-Sent Events, until the client disconnects
+func (ra *RestApi) StreamTsdata(w http.ResponseWriter, r *http.Request, p rest.StreamTsdataParams) {
+	uuids, err := util.StringSliceToUuidSlice(p.Uuids)
+	if err != nil {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("uuids has invalid format")))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Ensure that the User has access to all requested items
+	if ok := ra.checkTsDataAccess(w, r, "read", uuids); ok == false {
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+	policySvc := services.NewPolicyCheckService(db)
+
+	sub, err := svc.SubscribeTsData(r.Context(), uuids)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+	defer sub.Close()
+
+	resources := make([]string, len(uuids))
+	for i, id := range uuids {
+		resources[i] = fmt.Sprintf("timeseries/%v/data", id.String())
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	sendError := func(msg string) {
+		data, _ := json.Marshal(map[string]string{"error": msg})
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+		flusher.Flush()
+	}
+
+	ticker := time.NewTicker(streamKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			// The client disconnected
+			return
+
+		case <-ticker.C:
+			ok, err := policySvc.UserHasManyAccessViaToken(r.Context(), []byte(domaintoken.Token), "read", resources)
+			if err != nil {
+				return
+			} else if ok == false {
+				sendError("access denied")
+				return
+			}
+
+			if _, err := fmt.Fprint(w, ": keep alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+
+		case e, ok := <-sub.C:
+			if ok == false {
+				sendError(sub.Err().Error())
+				return
+			}
+
+			result := rest.TsResults{
+				Uuid: e.Uuid.String(),
+				Unit: e.Unit,
+				Data: make([]rest.TsRow, len(e.Data)),
+			}
+			for i, point := range e.Data {
+				v := float32(point.Value)
+				result.Data[i] = rest.TsRow{
+					V:  &v,
+					Ts: point.Timestamp,
+					Q:  point.Quality.Rest(),
+				}
+			}
+
+			data, err := json.Marshal(result)
+			if err != nil {
+				return
+			}
+
+			if _, err := fmt.Fprintf(w, "event: data\ndata: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// FindStatsOfManyTimeseries returns the statistics of the data of several time series
+func (ra *RestApi) FindStatsOfManyTimeseries(w http.ResponseWriter, r *http.Request, p rest.FindStatsOfManyTimeseriesParams) {
+	uuids, err := util.StringSliceToUuidSlice(p.Uuids)
+	if err != nil {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("uuids has invalid format")))
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Ensure that the User has access to all requested items
+	if ok := ra.checkTsDataAccess(w, r, "read", uuids); ok == false {
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	results, err := svc.FindTsDataStats(r.Context(), uuids)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(results)
+}
+
+// checkTsDataAccess sends an error and returns false unless the user may perform action on the data of every time series
+func (ra *RestApi) checkTsDataAccess(w http.ResponseWriter, r *http.Request, action string, uuids []uuid.UUID) bool {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return false
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return false
+	}
+
+	// Generate check rules for access control
+	resources := make([]string, len(uuids))
+	for i, id := range uuids {
+		resources[i] = fmt.Sprintf("timeseries/%v/data", id.String())
+	}
+
+	policySvc := services.NewPolicyCheckService(db)
+	ok, err = policySvc.UserHasManyAccessViaToken(r.Context(), []byte(domaintoken.Token), action, resources)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return false
+	} else if ok == false {
+		// Access denied to one or more requested resources
+		ie.SendHTTPError(w, ie.ErrorForbidden)
+		return false
+	}
+
+	return true
+}
+
+// WriteLineProtocol adds data in InfluxDB line protocol to the time series mapped to each series
+func (ra *RestApi) WriteLineProtocol(w http.ResponseWriter, r *http.Request, p rest.WriteLineProtocolParams) {
+	// Allow max of 5 MB read from body
+	body := http.MaxBytesReader(w, r.Body, 5242880)
+
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
+		defer gz.Close()
+
+		// Limit the size after decompression as well
+		body = http.MaxBytesReader(w, gz, 52428800)
+	}
+
+	precision := ""
+	if p.Precision != nil {
+		precision = *p.Precision
+	}
+
+	var thing *uuid.UUID
+	if p.Thing != nil {
+		id, err := uuid.Parse(*p.Thing)
+		if err != nil {
+			ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("thing has invalid format")))
+			return
+		}
+		thing = &id
+	}
+
+	points, err := services.ParseLineProtocol(body, precision, time.Now())
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	createdBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Missing time series are created, under the thing when given
+	policySvc := services.NewPolicyCheckService(db)
+	if thing != nil {
+		ok, err := policySvc.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "update", fmt.Sprintf("things/%v", thing.String()))
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		} else if ok == false {
+			ie.SendHTTPError(w, ie.ErrorForbidden)
+			return
+		}
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	// The access to create time series, and to the data of every time series,
+	// is checked in the same transaction that creates the missing ones
+	err = svc.WriteLineProtocol(r.Context(), services.WriteLineProtocolParams{
+		Points:    points,
+		CreatedBy: createdBy,
+		Token:     []byte(domaintoken.Token),
+		Thing:     thing,
+		Unit:      p.Unit,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
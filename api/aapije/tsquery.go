@@ -5,11 +5,17 @@
 package aapije
 
 import (
+	"database/sql"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/self-host/self-host/api/aapije/rest"
 	ie "github.com/self-host/self-host/internal/errors"
 	"github.com/self-host/self-host/internal/services"
@@ -40,6 +46,32 @@ func (ra *RestApi) FindTsdataByQuery(w http.ResponseWriter, r *http.Request, p r
 		precision = string(*p.Precision)
 	}
 
+	var origin *time.Time
+	if p.Origin != nil {
+		o := time.Time(*p.Origin)
+		origin = &o
+	}
+
+	var fill services.FillMode
+	if p.Fill != nil {
+		var err error
+		fill, err = services.ParseFillMode(string(*p.Fill))
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
+	var excludeQuality []services.Quality
+	if p.ExcludeQuality != nil {
+		var err error
+		excludeQuality, err = services.ParseQualities(*p.ExcludeQuality)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
 	db, err := ra.GetDB(r)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ErrorUndefined)
@@ -53,12 +85,94 @@ func (ra *RestApi) FindTsdataByQuery(w http.ResponseWriter, r *http.Request, p r
 	}
 
 	svc := services.NewTimeseriesService(db)
-	policySvc := services.NewPolicyCheckService(db)
 
-	uuids, err := util.StringSliceToUuidSlice([]string(p.Uuids))
+	selecting := p.Tags != nil || p.Thing != nil
+	if selecting && p.Uuids != nil {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("uuids can not be combined with tags or thing")))
+		return
+	} else if selecting == false && p.Uuids == nil {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("uuids, tags or thing is required")))
+		return
+	}
+
+	var uuids []uuid.UUID
+	var metadata map[uuid.UUID]*rest.Timeseries
+	if selecting {
+		uuids, metadata, ok = ra.selectTimeseries(w, r, svc, []byte(domaintoken.Token), p)
+	} else {
+		uuids, ok = ra.checkTsQueryUuids(w, r, svc, services.NewPolicyCheckService(db), []byte(domaintoken.Token), *p.Uuids)
+	}
+	if ok == false {
+		return
+	}
+
+	unitMap := make(map[uuid.UUID]string)
+	if p.Units != nil {
+		for _, item := range *p.Units {
+			parts := strings.SplitN(item, ":", 2)
+			if len(parts) != 2 || parts[1] == "" {
+				ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("units must be formatted as uuid:unit")))
+				return
+			}
+
+			id, err := uuid.Parse(parts[0])
+			if err != nil {
+				ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("units has invalid format")))
+				return
+			}
+
+			unitMap[id] = parts[1]
+		}
+	}
+
+	params := services.QueryMultiSourceDataParams{
+		Uuids:          uuids,
+		Start:          time.Time(p.Start),
+		End:            time.Time(p.End),
+		GreaterOrEq:    (*float32)(p.Ge),
+		LessOrEq:       (*float32)(p.Le),
+		Aggregate:      aggregate,
+		Precision:      precision,
+		Origin:         origin,
+		Timezone:       timezone,
+		Fill:           fill,
+		Unit:           (*string)(p.Unit),
+		Units:          unitMap,
+		Metadata:       metadata,
+		ExcludeQuality: excludeQuality,
+	}
+
+	if ra.Accepts(r, "text/csv") {
+		ra.writeTsdataCSV(w, r, svc, params)
+		return
+	}
+
+	data := make([]*rest.TsResults, 0)
+	if len(uuids) > 0 {
+		data, err = svc.QueryMultiSourceData(r.Context(), params)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
+	if p.Annotations != nil && *p.Annotations {
+		if ok := ra.includeAnnotations(w, r, db, []byte(domaintoken.Token), data, params.Start, params.End); ok == false {
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(data)
+	return
+}
+
+// checkTsQueryUuids parses the listed time series of a query and ensures that they exist and that the user may read their data
+func (ra *RestApi) checkTsQueryUuids(w http.ResponseWriter, r *http.Request, svc *services.TimeseriesService, policySvc *services.PolicyCheckService, token []byte, list []string) ([]uuid.UUID, bool) {
+	uuids, err := util.StringSliceToUuidSlice(list)
 	if err != nil {
 		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("uuids has invalid format")))
-		return
+		return nil, false
 	}
 
 	// Ensure all timeseries exists
@@ -66,10 +180,10 @@ func (ra *RestApi) FindTsdataByQuery(w http.ResponseWriter, r *http.Request, p r
 		ok, err := svc.Exists(r.Context(), id)
 		if err != nil {
 			ie.SendHTTPError(w, ie.ParseDBError(err))
-			return
+			return nil, false
 		} else if ok == false {
 			ie.SendHTTPError(w, ie.ErrorNotFound)
-			return
+			return nil, false
 		}
 	}
 
@@ -80,34 +194,167 @@ func (ra *RestApi) FindTsdataByQuery(w http.ResponseWriter, r *http.Request, p r
 	}
 
 	// Ensure that the User has access to all requested items
-	ok, err = policySvc.UserHasManyAccessViaToken(r.Context(), []byte(domaintoken.Token), "read", resources)
+	ok, err := policySvc.UserHasManyAccessViaToken(r.Context(), token, "read", resources)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
-		return
+		return nil, false
 	} else if ok == false {
 		// Access denied to one or more requested resources
 		ie.SendHTTPError(w, ie.ErrorForbidden)
-		return
+		return nil, false
 	}
 
-	params := services.QueryMultiSourceDataParams{
-		Uuids:       uuids,
-		Start:       time.Time(p.Start),
-		End:         time.Time(p.End),
-		GreaterOrEq: (*float32)(p.Ge),
-		LessOrEq:    (*float32)(p.Le),
-		Aggregate:   aggregate,
-		Precision:   precision,
-		Timezone:    timezone,
+	// Derived time series require read access to each of their inputs
+	if ok := ra.checkExpressionInputAccess(w, r, svc, uuids); ok == false {
+		return nil, false
+	}
+
+	return uuids, true
+}
+
+// selectTimeseries resolves the time series selected by the tags and thing of a query.
+// The selection only holds time series where the user may read the data.
+func (ra *RestApi) selectTimeseries(w http.ResponseWriter, r *http.Request, svc *services.TimeseriesService, token []byte, p rest.FindTsdataByQueryParams) ([]uuid.UUID, map[uuid.UUID]*rest.Timeseries, bool) {
+	sel := services.TimeseriesSelector{
+		Token: token,
+	}
+
+	if p.Tags != nil {
+		sel.Tags = *p.Tags
+	}
+
+	matchAll, err := services.ParseTagsMatch(p.TagsMatch)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return nil, nil, false
+	}
+	sel.MatchAll = matchAll
+
+	if p.Thing != nil {
+		id, err := uuid.Parse(*p.Thing)
+		if err != nil {
+			ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("thing has invalid format")))
+			return nil, nil, false
+		}
+		sel.Thing = &id
+	}
+
+	selected, err := svc.SelectTimeseries(r.Context(), sel)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return nil, nil, false
 	}
 
-	data, err := svc.QueryMultiSourceData(r.Context(), params)
+	uuids := make([]uuid.UUID, 0, len(selected))
+	metadata := make(map[uuid.UUID]*rest.Timeseries, len(selected))
+	for _, t := range selected {
+		id := uuid.MustParse(t.Uuid)
+		uuids = append(uuids, id)
+		metadata[id] = t
+	}
+
+	return uuids, metadata, true
+}
+
+// includeAnnotations adds the annotations of each time series to the query result.
+// A time series where the user may not read the time series itself is left without annotations.
+func (ra *RestApi) includeAnnotations(w http.ResponseWriter, r *http.Request, db *sql.DB, token []byte, data []*rest.TsResults, start, end time.Time) bool {
+	policySvc := services.NewPolicyCheckService(db)
+
+	uuids := make([]uuid.UUID, 0, len(data))
+	for _, item := range data {
+		id, err := uuid.Parse(item.Uuid)
+		if err != nil {
+			continue
+		}
+
+		ok, err := policySvc.UserHasAccessViaToken(r.Context(), token, "read", fmt.Sprintf("timeseries/%v", id.String()))
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return false
+		} else if ok {
+			uuids = append(uuids, id)
+		}
+	}
+
+	annotations, err := services.NewAnnotationService(db).FindByTimeseries(r.Context(), uuids, start, end)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return false
+	}
+
+	for _, item := range data {
+		id, err := uuid.Parse(item.Uuid)
+		if err != nil {
+			continue
+		}
+
+		if list, ok := annotations[id]; ok {
+			item.Annotations = &list
+		}
+	}
+
+	return true
+}
+
+// writeTsdataCSV streams the query result as a wide table with one column per time series
+func (ra *RestApi) writeTsdataCSV(w http.ResponseWriter, r *http.Request, svc *services.TimeseriesService, params services.QueryMultiSourceDataParams) {
+	cw := csv.NewWriter(w)
+	started := false
+
+	// The response is deferred until there is data, so that query errors can still be reported
+	start := func() error {
+		started = true
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+
+		header := make([]string, 0, len(params.Uuids)+1)
+		header = append(header, "ts")
+		for _, id := range params.Uuids {
+			header = append(header, id.String())
+		}
+		return cw.Write(header)
+	}
+
+	if len(params.Uuids) == 0 {
+		// Nothing was selected, respond with only the header row
+		start()
+		cw.Flush()
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(data)
-	return
+	err := svc.QueryMultiSourceDataWide(r.Context(), params, func(row services.TsWideRow) error {
+		if started == false {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		record := make([]string, 0, len(row.Values)+1)
+		record = append(record, row.Ts.Format(time.RFC3339Nano))
+		for _, v := range row.Values {
+			if v == nil {
+				record = append(record, "")
+			} else {
+				record = append(record, strconv.FormatFloat(float64(*v), 'f', -1, 32))
+			}
+		}
+
+		return cw.Write(record)
+	})
+	if err != nil {
+		if started == false {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+		}
+		// Otherwise the response is already underway, all we can do is to stop writing
+		return
+	}
+
+	if started == false {
+		// No data, respond with only the header row
+		start()
+	}
+
+	cw.Flush()
 }
@@ -0,0 +1,68 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Archiver moves data older than age into datasets, in every domain, once per interval.
+// Several instances may run at the same time, as a range of data is only archived once.
+func Archiver(ctx context.Context, interval, age, window time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, domaindb := range postgres.GetAllDB() {
+			archiveTsData(ctx, domaindb, age, window)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func archiveTsData(ctx context.Context, domaindb postgres.DomainDB, age, window time.Duration) {
+	svc := services.NewTimeseriesService(domaindb.DB)
+	if svc == nil {
+		return
+	}
+
+	t1 := time.Now()
+	result, err := svc.ArchiveTsData(ctx, t1.Add(-age), window)
+
+	var total int64
+	for _, item := range result {
+		total += item.Archived
+		logger.Info("Data archived",
+			zap.String("domain", domaindb.Domain),
+			zap.String("timeseries", item.Uuid.String()),
+			zap.Int64("archived", item.Archived),
+			zap.Int("datasets", item.Datasets),
+		)
+	}
+
+	if err != nil && ctx.Err() == nil {
+		logger.Error("Error while archiving data", zap.String("domain", domaindb.Domain), zap.Error(err))
+	}
+
+	if total > 0 {
+		logger.Info("Data archived for domain",
+			zap.String("domain", domaindb.Domain),
+			zap.Int("timeseries", len(result)),
+			zap.Int64("archived", total),
+			zap.Duration("dur-ms", time.Since(t1)*1000),
+		)
+	}
+}
@@ -39,6 +39,19 @@ func initConfig() {
 	viper.SetDefault("cors.allow_credentials", true)
 	viper.SetDefault("cors.max_age", 300) // Maximum value not ignored by any of major browsers
 
+	// Retention default settings, an interval of zero disables the retention enforcer
+	viper.SetDefault("retention.interval", time.Hour)
+	viper.SetDefault("retention.window", 24*time.Hour)
+
+	// Partition default settings, an interval of zero disables the partition maintainer
+	viper.SetDefault("partition.interval", time.Hour)
+	viper.SetDefault("partition.ahead", 14*24*time.Hour)
+
+	// Archive default settings, an interval of zero disables the archiver
+	viper.SetDefault("archive.interval", 0)
+	viper.SetDefault("archive.age", 365*24*time.Hour)
+	viper.SetDefault("archive.window", 7*24*time.Hour)
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		logger.Fatal("Fatal error unable to load config file", zap.Error(err))
@@ -0,0 +1,50 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/postgres"
+)
+
+// PartitionMaintainer creates the partitions of tsdata until ahead of now, in every domain partitioned by time, once per interval.
+// Several instances may run at the same time, as a partition is only created once.
+func PartitionMaintainer(ctx context.Context, interval, ahead time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, domaindb := range postgres.GetAllDB() {
+			maintainPartitions(ctx, domaindb, ahead)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func maintainPartitions(ctx context.Context, domaindb postgres.DomainDB, ahead time.Duration) {
+	svc := services.NewTimeseriesService(domaindb.DB)
+	if svc == nil {
+		return
+	}
+
+	created, err := svc.MaintainTsDataPartitions(ctx, time.Now(), ahead)
+	for _, name := range created {
+		logger.Info("Partition created", zap.String("domain", domaindb.Domain), zap.String("partition", name))
+	}
+
+	if err != nil && ctx.Err() == nil {
+		logger.Error("Error while creating partitions", zap.String("domain", domaindb.Domain), zap.Error(err))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/postgres"
+)
+
+// RetentionEnforcer deletes data older than the retention of each time series, in every domain, once per interval.
+// Partitions of tsdata holding only such data are dropped as a whole.
+// Several instances may run at the same time, as a range of data is only deleted once.
+func RetentionEnforcer(ctx context.Context, interval, window time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, domaindb := range postgres.GetAllDB() {
+			enforceRetention(ctx, domaindb, window)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func enforceRetention(ctx context.Context, domaindb postgres.DomainDB, window time.Duration) {
+	svc := services.NewTimeseriesService(domaindb.DB)
+	if svc == nil {
+		return
+	}
+
+	t1 := time.Now()
+
+	// Drop whole partitions first, leaving less data to delete
+	dropped, err := svc.DropExpiredTsDataPartitions(ctx, t1)
+	for _, name := range dropped {
+		logger.Info("Retention dropped partition", zap.String("domain", domaindb.Domain), zap.String("partition", name))
+	}
+	if err != nil && ctx.Err() == nil {
+		logger.Error("Error while dropping expired partitions", zap.String("domain", domaindb.Domain), zap.Error(err))
+	}
+
+	result, err := svc.EnforceRetention(ctx, t1, window)
+
+	var total int64
+	for _, item := range result {
+		total += item.Deleted
+		logger.Info("Retention enforced",
+			zap.String("domain", domaindb.Domain),
+			zap.String("timeseries", item.Uuid.String()),
+			zap.Int64("deleted", item.Deleted),
+		)
+	}
+
+	if err != nil && ctx.Err() == nil {
+		logger.Error("Error while enforcing retention", zap.String("domain", domaindb.Domain), zap.Error(err))
+	}
+
+	if total > 0 {
+		logger.Info("Retention enforced for domain",
+			zap.String("domain", domaindb.Domain),
+			zap.Int("timeseries", len(result)),
+			zap.Int64("deleted", total),
+			zap.Duration("dur-ms", time.Since(t1)*1000),
+		)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"embed"
 	"io/fs"
+	"mime"
 	"net/http"
 	"os"
 	"os/signal"
@@ -33,6 +34,8 @@ import (
 
 var URLParamRegex = regexp.MustCompile(`(?m)\{([^\}]+)\}`)
 
+var tsDataPathRegex = regexp.MustCompile(`^/v2/timeseries/[^/]+/data$`)
+
 //go:embed static
 var content embed.FS
 
@@ -131,7 +134,17 @@ func Server(address string) (<-chan error, error) {
 	})
 	r.Use(chiware.CleanPath)
 	r.Use(chiware.Heartbeat("/status"))
-	r.Use(chiware.Timeout(60 * time.Second))
+	r.Use(func(h http.Handler) http.Handler {
+		timeout := chiware.Timeout(60 * time.Second)(h)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Live data streams stay open until the client disconnects, streamed data bodies are not limited in size
+			if r.URL.Path == "/v2/tsdata/stream" || isStreamedTsData(r) {
+				h.ServeHTTP(w, r)
+				return
+			}
+			timeout.ServeHTTP(w, r)
+		})
+	})
 
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   viper.GetStringSlice("cors.allowed_origins"),
@@ -182,6 +195,18 @@ func Server(address string) (<-chan error, error) {
 		syscall.SIGTERM,
 		syscall.SIGQUIT)
 
+	if interval := viper.GetDuration("retention.interval"); interval > 0 {
+		go RetentionEnforcer(ctx, interval, viper.GetDuration("retention.window"))
+	}
+
+	if interval := viper.GetDuration("partition.interval"); interval > 0 {
+		go PartitionMaintainer(ctx, interval, viper.GetDuration("partition.ahead"))
+	}
+
+	if interval := viper.GetDuration("archive.interval"); interval > 0 && viper.GetDuration("archive.age") > 0 {
+		go Archiver(ctx, interval, viper.GetDuration("archive.age"), viper.GetDuration("archive.window"))
+	}
+
 	go func() {
 		<-ctx.Done()
 
@@ -218,3 +243,14 @@ func Server(address string) (<-chan error, error) {
 
 	return errC, nil
 }
+
+// isStreamedTsData reports if a request writes time series data from an NDJSON or CSV body, which is streamed into the database
+func isStreamedTsData(r *http.Request) bool {
+	if r.Method != http.MethodPost || tsDataPathRegex.MatchString(r.URL.Path) == false {
+		return false
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	return mediaType == "application/x-ndjson" || mediaType == "text/csv"
+}
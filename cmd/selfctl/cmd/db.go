@@ -30,6 +30,9 @@ var (
 	dbCmdExample = templates.Examples(`
 		# Initialize an empty database to the latest schema
 		selfctl db migrate up
+
+		# Migrate time series data to time-range partitioning
+		selfctl db partition --database URI
 	`)
 )
 
@@ -0,0 +1,120 @@
+/*
+Copyright © 2021 Self-host Authors
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+var (
+	partitionCmdLong = templates.LongDesc(`
+		Migrate the time series data of a domain to time-range partitioning.
+
+		The domain stays online during the migration. Changes to the data are
+		repeated in the new table, while the existing data is copied in batches.
+		An interrupted migration is resumed by running the command again.
+		Once all data is copied, the new table replaces the old one, which is
+		kept as tsdata_hash until dropped by hand.
+	`)
+
+	partitionCmdExample = templates.Examples(`
+		# Partition the data of a domain by month
+		selfctl db partition --database URI
+
+		# Partition the data of a domain by week, with 4 hash sub-partitions per week
+		selfctl db partition --width week --hash-partitions 4 --database URI
+	`)
+)
+
+var (
+	partitionCmd = &cobra.Command{
+		Use:     "partition",
+		Short:   "Migrate time series data to time-range partitioning",
+		Long:    partitionCmdLong,
+		Example: partitionCmdExample,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := services.ValidatePartitioning(partitionWidth, partitionHashPartitions); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err.Error())
+				os.Exit(1)
+			}
+
+			if partitionBatch < 1 {
+				fmt.Fprintln(os.Stderr, "Error: batch must be a positive integer")
+				os.Exit(1)
+			}
+
+			db, err := sql.Open("pgx", partitionDbUri)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			ctx := context.Background()
+			svc := services.NewTimeseriesService(db)
+
+			resumed, err := svc.BeginTsDataPartitioning(ctx, partitionWidth, partitionHashPartitions)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+			if resumed {
+				fmt.Println("Resuming the migration in progress, with the layout it was started with.")
+			}
+
+			err = svc.CopyTsDataToPartitions(ctx, time.Now(), partitionAhead, partitionBatch, func(id uuid.UUID, done, total int) {
+				fmt.Printf("Copied time series %v (%d/%d)\n", id, done, total)
+			})
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+
+			if err := svc.FinishTsDataPartitioning(ctx); err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+
+			fmt.Println("tsdata is partitioned by time. The old table is kept as tsdata_hash.")
+		},
+	}
+	partitionWidth          string
+	partitionHashPartitions int
+	partitionBatch          int
+	partitionAhead          time.Duration
+	partitionDbUri          string
+)
+
+func init() {
+	dbCmd.AddCommand(partitionCmd)
+	partitionCmd.Flags().StringVarP(&partitionDbUri, "database", "d", "", "Database URI")
+	partitionCmd.Flags().StringVarP(&partitionWidth, "width", "w", "month", "Partition width; day, week or month")
+	partitionCmd.Flags().IntVarP(&partitionHashPartitions, "hash-partitions", "", 0, "Hash sub-partitions on time series per partition")
+	partitionCmd.Flags().IntVarP(&partitionBatch, "batch", "b", 10000, "Most data points copied per batch")
+	partitionCmd.Flags().DurationVarP(&partitionAhead, "ahead", "", 14*24*time.Hour, "Create partitions this far ahead of now")
+	partitionCmd.MarkFlagRequired("database")
+}
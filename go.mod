@@ -3,7 +3,7 @@ module github.com/self-host/self-host
 go 1.16
 
 require (
-	github.com/Knetic/govaluate v3.0.1-0.20171022003610-9aa49832a739+incompatible // indirect
+	github.com/Knetic/govaluate v3.0.1-0.20171022003610-9aa49832a739+incompatible
 	github.com/MakeNowJust/heredoc/v2 v2.0.1
 	github.com/d5/tengo/v2 v2.10.0
 	github.com/deepmap/oapi-codegen v1.9.0
@@ -13,6 +13,7 @@ require (
 	github.com/go-chi/chi/v5 v5.0.7
 	github.com/go-chi/cors v1.2.0
 	github.com/golang-migrate/migrate/v4 v4.15.1
+	github.com/golang/snappy v0.0.4
 	github.com/google/uuid v1.3.0
 	github.com/hexops/gotextdiff v1.0.3
 	github.com/jackc/pgx/v4 v4.14.1
@@ -5,6 +5,7 @@
 package errors
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 
@@ -170,6 +171,29 @@ func SendHTTPError(w http.ResponseWriter, e ClientError) {
 		return
 	}
 
+	logHTTPError(e)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(httpErrorCode(e))
+
+	w.Write([]byte(e.Error()))
+}
+
+// SendHTTPErrorJSON sends an error with a JSON body, for endpoints that report more than the message on error
+func SendHTTPErrorJSON(w http.ResponseWriter, e ClientError, body interface{}) {
+	if e == nil {
+		return
+	}
+
+	logHTTPError(e)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpErrorCode(e))
+
+	json.NewEncoder(w).Encode(body)
+}
+
+func logHTTPError(e ClientError) {
 	if err, ok := e.(*HTTPError); ok == true {
 		if err.Code >= 500 {
 			m := err.Message
@@ -180,14 +204,11 @@ func SendHTTPError(w http.ResponseWriter, e ClientError) {
 			logger.Error("internal", zap.Int("status", err.Code), zap.String("error", m))
 		}
 	}
+}
 
-	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
-
+func httpErrorCode(e ClientError) int {
 	if ce, ok := e.(*HTTPError); ok {
-		w.WriteHeader(ce.Code)
-	} else {
-		w.WriteHeader(http.StatusInternalServerError)
+		return ce.Code
 	}
-
-	w.Write([]byte(e.Error()))
+	return http.StatusInternalServerError
 }
@@ -0,0 +1,557 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// Package prompb encodes and decodes the protocol buffer messages of the Prometheus remote write and remote read protocols.
+//
+// Only the fields used by Self-host are supported, any other field is skipped when decoding.
+// See https://github.com/prometheus/prometheus/tree/main/prompb for the message definitions.
+package prompb
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Wire types of the protocol buffer encoding
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var ErrMalformed = errors.New("malformed protocol buffer message")
+
+// Types of a LabelMatcher
+const (
+	MatchEqual     = 0
+	MatchNotEqual  = 1
+	MatchRegexp    = 2
+	MatchNotRegexp = 3
+)
+
+// Response types of a ReadRequest
+const (
+	ResponseSamples           = 0
+	ResponseStreamedXORChunks = 1
+)
+
+type Label struct {
+	Name  string
+	Value string
+}
+
+type Sample struct {
+	Value float64
+	// Milliseconds since the epoch
+	Timestamp int64
+}
+
+type TimeSeries struct {
+	Labels  []Label
+	Samples []Sample
+}
+
+type WriteRequest struct {
+	Timeseries []TimeSeries
+}
+
+type LabelMatcher struct {
+	Type  int
+	Name  string
+	Value string
+}
+
+type Query struct {
+	// Milliseconds since the epoch, inclusive
+	StartTimestampMs int64
+	EndTimestampMs   int64
+	Matchers         []LabelMatcher
+}
+
+type ReadRequest struct {
+	Queries               []Query
+	AcceptedResponseTypes []int
+}
+
+type QueryResult struct {
+	Timeseries []TimeSeries
+}
+
+type ReadResponse struct {
+	Results []QueryResult
+}
+
+// decoder reads the fields of one message
+type decoder struct {
+	buf []byte
+}
+
+// next returns the number and wire type of the next field, false at the end of the message
+func (d *decoder) next() (int, int, bool, error) {
+	if len(d.buf) == 0 {
+		return 0, 0, false, nil
+	}
+	key, err := d.varint()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return int(key >> 3), int(key & 7), true, nil
+}
+
+func (d *decoder) varint() (uint64, error) {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		return 0, ErrMalformed
+	}
+	d.buf = d.buf[n:]
+	return v, nil
+}
+
+func (d *decoder) fixed64() (uint64, error) {
+	if len(d.buf) < 8 {
+		return 0, ErrMalformed
+	}
+	v := binary.LittleEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+	return v, nil
+}
+
+func (d *decoder) bytes() ([]byte, error) {
+	n, err := d.varint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(d.buf)) {
+		return nil, ErrMalformed
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b, nil
+}
+
+// skip skips the value of a field of an unsupported number
+func (d *decoder) skip(wire int) error {
+	var err error
+	switch wire {
+	case wireVarint:
+		_, err = d.varint()
+	case wireFixed64:
+		_, err = d.fixed64()
+	case wireBytes:
+		_, err = d.bytes()
+	case wireFixed32:
+		if len(d.buf) < 4 {
+			return ErrMalformed
+		}
+		d.buf = d.buf[4:]
+	default:
+		err = ErrMalformed
+	}
+	return err
+}
+
+// expect checks the wire type of a supported field
+func expect(wire, expected int) error {
+	if wire != expected {
+		return ErrMalformed
+	}
+	return nil
+}
+
+// decodeMessage calls fn for every field of a message. Unsupported fields must be skipped by fn.
+func decodeMessage(buf []byte, fn func(d *decoder, field, wire int) error) error {
+	d := &decoder{buf: buf}
+	for {
+		field, wire, ok, err := d.next()
+		if err != nil {
+			return err
+		} else if ok == false {
+			return nil
+		}
+		if err := fn(d, field, wire); err != nil {
+			return err
+		}
+	}
+}
+
+// UnmarshalWriteRequest decodes a WriteRequest
+func UnmarshalWriteRequest(buf []byte) (*WriteRequest, error) {
+	req := &WriteRequest{}
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		if field != 1 {
+			return d.skip(wire)
+		}
+		if err := expect(wire, wireBytes); err != nil {
+			return err
+		}
+		b, err := d.bytes()
+		if err != nil {
+			return err
+		}
+		ts, err := unmarshalTimeSeries(b)
+		if err != nil {
+			return err
+		}
+		req.Timeseries = append(req.Timeseries, ts)
+		return nil
+	})
+	return req, err
+}
+
+func unmarshalTimeSeries(buf []byte) (TimeSeries, error) {
+	var ts TimeSeries
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		switch field {
+		case 1:
+			if err := expect(wire, wireBytes); err != nil {
+				return err
+			}
+			b, err := d.bytes()
+			if err != nil {
+				return err
+			}
+			l, err := unmarshalLabel(b)
+			if err != nil {
+				return err
+			}
+			ts.Labels = append(ts.Labels, l)
+		case 2:
+			if err := expect(wire, wireBytes); err != nil {
+				return err
+			}
+			b, err := d.bytes()
+			if err != nil {
+				return err
+			}
+			s, err := unmarshalSample(b)
+			if err != nil {
+				return err
+			}
+			ts.Samples = append(ts.Samples, s)
+		default:
+			return d.skip(wire)
+		}
+		return nil
+	})
+	return ts, err
+}
+
+func unmarshalLabel(buf []byte) (Label, error) {
+	var l Label
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		switch field {
+		case 1, 2:
+			if err := expect(wire, wireBytes); err != nil {
+				return err
+			}
+			b, err := d.bytes()
+			if err != nil {
+				return err
+			}
+			if field == 1 {
+				l.Name = string(b)
+			} else {
+				l.Value = string(b)
+			}
+		default:
+			return d.skip(wire)
+		}
+		return nil
+	})
+	return l, err
+}
+
+func unmarshalSample(buf []byte) (Sample, error) {
+	var s Sample
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		switch field {
+		case 1:
+			if err := expect(wire, wireFixed64); err != nil {
+				return err
+			}
+			v, err := d.fixed64()
+			if err != nil {
+				return err
+			}
+			s.Value = math.Float64frombits(v)
+		case 2:
+			if err := expect(wire, wireVarint); err != nil {
+				return err
+			}
+			v, err := d.varint()
+			if err != nil {
+				return err
+			}
+			s.Timestamp = int64(v)
+		default:
+			return d.skip(wire)
+		}
+		return nil
+	})
+	return s, err
+}
+
+// UnmarshalReadRequest decodes a ReadRequest
+func UnmarshalReadRequest(buf []byte) (*ReadRequest, error) {
+	req := &ReadRequest{}
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		switch field {
+		case 1:
+			if err := expect(wire, wireBytes); err != nil {
+				return err
+			}
+			b, err := d.bytes()
+			if err != nil {
+				return err
+			}
+			q, err := unmarshalQuery(b)
+			if err != nil {
+				return err
+			}
+			req.Queries = append(req.Queries, q)
+		case 2:
+			// Repeated enums may be packed or not
+			if wire == wireBytes {
+				b, err := d.bytes()
+				if err != nil {
+					return err
+				}
+				packed := &decoder{buf: b}
+				for len(packed.buf) > 0 {
+					v, err := packed.varint()
+					if err != nil {
+						return err
+					}
+					req.AcceptedResponseTypes = append(req.AcceptedResponseTypes, int(v))
+				}
+				return nil
+			}
+			if err := expect(wire, wireVarint); err != nil {
+				return err
+			}
+			v, err := d.varint()
+			if err != nil {
+				return err
+			}
+			req.AcceptedResponseTypes = append(req.AcceptedResponseTypes, int(v))
+		default:
+			return d.skip(wire)
+		}
+		return nil
+	})
+	return req, err
+}
+
+func unmarshalQuery(buf []byte) (Query, error) {
+	var q Query
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		switch field {
+		case 1, 2:
+			if err := expect(wire, wireVarint); err != nil {
+				return err
+			}
+			v, err := d.varint()
+			if err != nil {
+				return err
+			}
+			if field == 1 {
+				q.StartTimestampMs = int64(v)
+			} else {
+				q.EndTimestampMs = int64(v)
+			}
+		case 3:
+			if err := expect(wire, wireBytes); err != nil {
+				return err
+			}
+			b, err := d.bytes()
+			if err != nil {
+				return err
+			}
+			m, err := unmarshalLabelMatcher(b)
+			if err != nil {
+				return err
+			}
+			q.Matchers = append(q.Matchers, m)
+		default:
+			return d.skip(wire)
+		}
+		return nil
+	})
+	return q, err
+}
+
+func unmarshalLabelMatcher(buf []byte) (LabelMatcher, error) {
+	var m LabelMatcher
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		switch field {
+		case 1:
+			if err := expect(wire, wireVarint); err != nil {
+				return err
+			}
+			v, err := d.varint()
+			if err != nil {
+				return err
+			}
+			m.Type = int(v)
+		case 2, 3:
+			if err := expect(wire, wireBytes); err != nil {
+				return err
+			}
+			b, err := d.bytes()
+			if err != nil {
+				return err
+			}
+			if field == 2 {
+				m.Name = string(b)
+			} else {
+				m.Value = string(b)
+			}
+		default:
+			return d.skip(wire)
+		}
+		return nil
+	})
+	return m, err
+}
+
+// encoder appends fields to a message
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) key(field, wire int) {
+	e.varint(uint64(field<<3 | wire))
+}
+
+func (e *encoder) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *encoder) bytesField(field int, b []byte) {
+	e.key(field, wireBytes)
+	e.varint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *encoder) stringField(field int, s string) {
+	e.bytesField(field, []byte(s))
+}
+
+func (e *encoder) varintField(field int, v uint64) {
+	e.key(field, wireVarint)
+	e.varint(v)
+}
+
+func (e *encoder) doubleField(field int, v float64) {
+	e.key(field, wireFixed64)
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+	e.buf = append(e.buf, b[:]...)
+}
+
+func marshalTimeSeries(ts TimeSeries) []byte {
+	e := &encoder{}
+	for _, l := range ts.Labels {
+		le := &encoder{}
+		le.stringField(1, l.Name)
+		le.stringField(2, l.Value)
+		e.bytesField(1, le.buf)
+	}
+	for _, s := range ts.Samples {
+		se := &encoder{}
+		se.doubleField(1, s.Value)
+		se.varintField(2, uint64(s.Timestamp))
+		e.bytesField(2, se.buf)
+	}
+	return e.buf
+}
+
+// Marshal encodes a WriteRequest
+func (req *WriteRequest) Marshal() []byte {
+	e := &encoder{}
+	for _, ts := range req.Timeseries {
+		e.bytesField(1, marshalTimeSeries(ts))
+	}
+	return e.buf
+}
+
+// Marshal encodes a ReadRequest
+func (req *ReadRequest) Marshal() []byte {
+	e := &encoder{}
+	for _, q := range req.Queries {
+		qe := &encoder{}
+		qe.varintField(1, uint64(q.StartTimestampMs))
+		qe.varintField(2, uint64(q.EndTimestampMs))
+		for _, m := range q.Matchers {
+			me := &encoder{}
+			me.varintField(1, uint64(m.Type))
+			me.stringField(2, m.Name)
+			me.stringField(3, m.Value)
+			qe.bytesField(3, me.buf)
+		}
+		e.bytesField(1, qe.buf)
+	}
+	for _, t := range req.AcceptedResponseTypes {
+		e.varintField(2, uint64(t))
+	}
+	return e.buf
+}
+
+// Marshal encodes a ReadResponse
+func (resp *ReadResponse) Marshal() []byte {
+	e := &encoder{}
+	for _, r := range resp.Results {
+		re := &encoder{}
+		for _, ts := range r.Timeseries {
+			re.bytesField(1, marshalTimeSeries(ts))
+		}
+		e.bytesField(1, re.buf)
+	}
+	return e.buf
+}
+
+// UnmarshalReadResponse decodes a ReadResponse with samples
+func UnmarshalReadResponse(buf []byte) (*ReadResponse, error) {
+	resp := &ReadResponse{}
+	err := decodeMessage(buf, func(d *decoder, field, wire int) error {
+		if field != 1 {
+			return d.skip(wire)
+		}
+		if err := expect(wire, wireBytes); err != nil {
+			return err
+		}
+		b, err := d.bytes()
+		if err != nil {
+			return err
+		}
+		var r QueryResult
+		if err := decodeMessage(b, func(d *decoder, field, wire int) error {
+			if field != 1 {
+				return d.skip(wire)
+			}
+			if err := expect(wire, wireBytes); err != nil {
+				return err
+			}
+			b, err := d.bytes()
+			if err != nil {
+				return err
+			}
+			ts, err := unmarshalTimeSeries(b)
+			if err != nil {
+				return err
+			}
+			r.Timeseries = append(r.Timeseries, ts)
+			return nil
+		}); err != nil {
+			return err
+		}
+		resp.Results = append(resp.Results, r)
+		return nil
+	})
+	return resp, err
+}
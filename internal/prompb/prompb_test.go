@@ -0,0 +1,98 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package prompb
+
+import (
+	"bytes"
+	"log"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestWriteRequest(t *testing.T) {
+	req := &WriteRequest{
+		Timeseries: []TimeSeries{{
+			Labels:  []Label{{"a", "b"}},
+			Samples: []Sample{{1.0, 5}},
+		}},
+	}
+
+	expected := []byte{
+		0x0a, 0x15,
+		0x0a, 0x06, 0x0a, 0x01, 'a', 0x12, 0x01, 'b',
+		0x12, 0x0b, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x10, 0x05,
+	}
+
+	if bytes.Equal(req.Marshal(), expected) == false {
+		log.Fatalf("Encoded message does not match expected: %x", req.Marshal())
+	}
+
+	decoded, err := UnmarshalWriteRequest(expected)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if reflect.DeepEqual(decoded, req) == false {
+		log.Fatal("Decoded message does not match expected")
+	}
+
+	// Unknown fields are skipped
+	withUnknown := append([]byte{0x1a, 0x02, 0x08, 0x01}, expected...)
+	decoded, err = UnmarshalWriteRequest(withUnknown)
+	if err != nil || reflect.DeepEqual(decoded, req) == false {
+		log.Fatal("Unknown fields should be skipped")
+	}
+
+	if _, err := UnmarshalWriteRequest(expected[:10]); err == nil {
+		log.Fatal("Truncated message should fail")
+	}
+}
+
+func TestReadRequest(t *testing.T) {
+	req := &ReadRequest{
+		Queries: []Query{{
+			StartTimestampMs: 1637402400000,
+			EndTimestampMs:   1637406000000,
+			Matchers: []LabelMatcher{
+				{MatchEqual, "__name__", "up"},
+				{MatchRegexp, "job", "node|db"},
+			},
+		}},
+		AcceptedResponseTypes: []int{ResponseSamples},
+	}
+
+	decoded, err := UnmarshalReadRequest(req.Marshal())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if reflect.DeepEqual(decoded, req) == false {
+		log.Fatal("Decoded message does not match expected")
+	}
+
+	// Packed response types
+	decoded, err = UnmarshalReadRequest([]byte{0x12, 0x02, 0x00, 0x01})
+	if err != nil || reflect.DeepEqual(decoded.AcceptedResponseTypes, []int{ResponseSamples, ResponseStreamedXORChunks}) == false {
+		log.Fatal("Packed response types do not match expected")
+	}
+}
+
+func TestReadResponse(t *testing.T) {
+	resp := &ReadResponse{
+		Results: []QueryResult{{
+			Timeseries: []TimeSeries{{
+				Labels:  []Label{{"__name__", "up"}},
+				Samples: []Sample{{math.Inf(1), -1}, {0.5, 1637402400000}},
+			}},
+		}, {}},
+	}
+
+	decoded, err := UnmarshalReadResponse(resp.Marshal())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if reflect.DeepEqual(decoded, resp) == false {
+		log.Fatal("Decoded message does not match expected")
+	}
+}
@@ -7,6 +7,7 @@ package services
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/self-host/self-host/api/aapije/rest"
@@ -177,6 +178,59 @@ func (svc *AlertService) FindAlertByUuid(ctx context.Context, id uuid.UUID) (*re
 		return nil, err
 	}
 
+	return newRestAlert(alert), nil
+}
+
+type FindAlertsByTimeRangeParams struct {
+	Start       time.Time
+	End         time.Time
+	Resource    string
+	Environment string
+	Event       string
+	Origin      string
+	Status      *rest.AlertStatus
+	Severity    *rest.AlertSeverity
+	Service     []string
+	Tags        []string
+	ArgLimit    int64
+}
+
+// FindByTimeRange finds the alerts that were received within a time range, ordered by when they were created
+func (svc *AlertService) FindByTimeRange(ctx context.Context, p FindAlertsByTimeRangeParams) ([]*rest.Alert, error) {
+	params := postgres.FindAlertsByTimeRangeParams{
+		Start:       p.Start,
+		Stop:        p.End,
+		Resource:    p.Resource,
+		Environment: p.Environment,
+		Event:       p.Event,
+		Origin:      p.Origin,
+		Service:     p.Service,
+		Tags:        p.Tags,
+		ArgLimit:    p.ArgLimit,
+	}
+
+	// Compared to "" in SQL, as FindAlerts
+	if p.Status != nil {
+		params.Status = string(*p.Status)
+	}
+	if p.Severity != nil {
+		params.Severity = string(*p.Severity)
+	}
+
+	alertList, err := svc.q.FindAlertsByTimeRange(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*rest.Alert, 0, len(alertList))
+	for _, t := range alertList {
+		alerts = append(alerts, newRestAlert(t))
+	}
+
+	return alerts, nil
+}
+
+func newRestAlert(alert postgres.VAlert) *rest.Alert {
 	v := &rest.Alert{
 		Uuid:             alert.Uuid.String(),
 		Resource:         alert.Resource,
@@ -200,7 +254,7 @@ func (svc *AlertService) FindAlertByUuid(ctx context.Context, id uuid.UUID) (*re
 		v.LastReceiveTime = &alert.LastReceiveTime.Time
 	}
 
-	return v, nil
+	return v
 }
 
 type UpdateAlertByUuidParams struct {
@@ -0,0 +1,379 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// AnnotationService represents the repository used for interacting with Annotation records.
+type AnnotationService struct {
+	q  *postgres.Queries
+	db *sql.DB
+}
+
+// NewAnnotationService instantiates the AnnotationService repository.
+func NewAnnotationService(db *sql.DB) *AnnotationService {
+	if db == nil {
+		return nil
+	}
+
+	return &AnnotationService{
+		q:  postgres.New(db),
+		db: db,
+	}
+}
+
+func (svc *AnnotationService) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	found, err := svc.q.ExistsAnnotation(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return found > 0, nil
+}
+
+// AnnotationResources returns the resources that decide the access to an annotation of the time series and things.
+// Every resource appears once, in a stable order.
+func AnnotationResources(timeseries, things []uuid.UUID) []string {
+	seen := make(map[string]bool)
+	resources := make([]string, 0, len(timeseries)+len(things))
+
+	add := func(kind string, ids []uuid.UUID) {
+		for _, id := range ids {
+			res := fmt.Sprintf("%v/%v", kind, id.String())
+			if seen[res] {
+				continue
+			}
+			seen[res] = true
+			resources = append(resources, res)
+		}
+	}
+	add("timeseries", timeseries)
+	add("things", things)
+
+	sort.Strings(resources)
+
+	return resources
+}
+
+// validateAnnotation checks an annotation before it is stored. It must be linked to at least one time series or thing.
+func validateAnnotation(timeseries, things []uuid.UUID, start, end time.Time, text string) error {
+	if len(timeseries)+len(things) == 0 {
+		return ie.NewBadRequestError(fmt.Errorf("an annotation requires at least one timeseries or thing"))
+	} else if end.Before(start) {
+		return ie.NewBadRequestError(fmt.Errorf("end can not be before start"))
+	} else if strings.TrimSpace(text) == "" {
+		return ie.NewBadRequestError(fmt.Errorf("text can not be empty"))
+	}
+
+	return nil
+}
+
+type AddAnnotationParams struct {
+	Timeseries []uuid.UUID
+	Things     []uuid.UUID
+	Start      time.Time
+	End        time.Time
+	Text       string
+	Tags       []string
+	CreatedBy  uuid.UUID
+}
+
+// AddAnnotation adds an annotation and links it to its time series and things
+func (svc *AnnotationService) AddAnnotation(ctx context.Context, p AddAnnotationParams) (*rest.Annotation, error) {
+	if err := validateAnnotation(p.Timeseries, p.Things, p.Start, p.End, p.Text); err != nil {
+		return nil, err
+	}
+
+	tags := p.Tags
+	if tags == nil {
+		tags = make([]string, 0)
+	}
+
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	id, err := q.CreateAnnotation(ctx, postgres.CreateAnnotationParams{
+		Start:     p.Start,
+		Stop:      p.End,
+		Text:      p.Text,
+		Tags:      tags,
+		CreatedBy: p.CreatedBy,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := setAnnotationLinks(ctx, q, id, &p.Timeseries, &p.Things); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	annotation, err := q.FindAnnotationByUUID(ctx, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return newRestAnnotation(annotation), nil
+}
+
+// setAnnotationLinks replaces the time series and things linked to an annotation, a nil list keeps the current links
+func setAnnotationLinks(ctx context.Context, q *postgres.Queries, id uuid.UUID, timeseries, things *[]uuid.UUID) error {
+	if timeseries != nil {
+		if _, err := q.DeleteAnnotationTimeseries(ctx, id); err != nil {
+			return err
+		}
+		if len(*timeseries) > 0 {
+			_, err := q.AddAnnotationTimeseries(ctx, postgres.AddAnnotationTimeseriesParams{
+				AnnotationUuid: id,
+				TsUuids:        *timeseries,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if things != nil {
+		if _, err := q.DeleteAnnotationThings(ctx, id); err != nil {
+			return err
+		}
+		if len(*things) > 0 {
+			_, err := q.AddAnnotationThings(ctx, postgres.AddAnnotationThingsParams{
+				AnnotationUuid: id,
+				ThingUuids:     *things,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (svc *AnnotationService) FindAnnotationByUuid(ctx context.Context, id uuid.UUID) (*rest.Annotation, error) {
+	annotation, err := svc.q.FindAnnotationByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestAnnotation(annotation), nil
+}
+
+type FindAllAnnotationsParams struct {
+	Timeseries []uuid.UUID
+	Things     []uuid.UUID
+	Start      time.Time
+	End        time.Time
+	Tags       []string
+	ArgOffset  int64
+	ArgLimit   int64
+}
+
+// FindAll finds the annotations of any of the time series or things that overlap a time range, ordered by start
+func (svc *AnnotationService) FindAll(ctx context.Context, p FindAllAnnotationsParams) ([]*rest.Annotation, error) {
+	params := postgres.FindAnnotationsParams{
+		Start:      p.Start,
+		Stop:       p.End,
+		TsUuids:    p.Timeseries,
+		ThingUuids: p.Things,
+		Tags:       p.Tags,
+		ArgOffset:  p.ArgOffset,
+		ArgLimit:   p.ArgLimit,
+	}
+
+	list, err := svc.q.FindAnnotations(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := make([]*rest.Annotation, 0, len(list))
+	for _, t := range list {
+		annotations = append(annotations, newRestAnnotation(t))
+	}
+
+	return annotations, nil
+}
+
+// FindByTimeseries finds the annotations linked to each of the time series that overlap a time range.
+// Every time series is part of the result, also without annotations.
+func (svc *AnnotationService) FindByTimeseries(ctx context.Context, uuids []uuid.UUID, start, end time.Time) (map[uuid.UUID][]rest.Annotation, error) {
+	result := make(map[uuid.UUID][]rest.Annotation, len(uuids))
+	if len(uuids) == 0 {
+		return result, nil
+	}
+
+	rows, err := svc.q.FindAnnotationsByTimeseries(ctx, postgres.FindAnnotationsByTimeseriesParams{
+		TsUuids: uuids,
+		Start:   start,
+		Stop:    end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groupAnnotations(uuids, rows), nil
+}
+
+// groupAnnotations groups the annotations found by time series
+func groupAnnotations(uuids []uuid.UUID, rows []postgres.FindAnnotationsByTimeseriesRow) map[uuid.UUID][]rest.Annotation {
+	result := make(map[uuid.UUID][]rest.Annotation, len(uuids))
+	for _, id := range uuids {
+		result[id] = make([]rest.Annotation, 0)
+	}
+
+	for _, row := range rows {
+		result[row.TsUuid] = append(result[row.TsUuid], *newRestAnnotation(postgres.VAnnotation{
+			Uuid:       row.Uuid,
+			Timeseries: row.Timeseries,
+			Things:     row.Things,
+			Start:      row.Start,
+			Stop:       row.Stop,
+			Text:       row.Text,
+			Tags:       row.Tags,
+			Created:    row.Created,
+			CreatedBy:  row.CreatedBy,
+		}))
+	}
+
+	return result
+}
+
+func newRestAnnotation(annotation postgres.VAnnotation) *rest.Annotation {
+	v := &rest.Annotation{
+		Uuid:       annotation.Uuid.String(),
+		Timeseries: make([]string, len(annotation.Timeseries)),
+		Things:     make([]string, len(annotation.Things)),
+		Start:      annotation.Start,
+		End:        annotation.Stop,
+		Text:       annotation.Text,
+		Tags:       annotation.Tags,
+		Created:    annotation.Created,
+		CreatedBy:  annotation.CreatedBy.String(),
+	}
+
+	for i, id := range annotation.Timeseries {
+		v.Timeseries[i] = id.String()
+	}
+	for i, id := range annotation.Things {
+		v.Things[i] = id.String()
+	}
+
+	return v
+}
+
+type UpdateAnnotationByUuidParams struct {
+	Timeseries *[]uuid.UUID
+	Things     *[]uuid.UUID
+	Start      *time.Time
+	End        *time.Time
+	Text       *string
+	Tags       *[]string
+}
+
+// UpdateAnnotationByUuid updates an annotation, a nil value keeps the current one.
+// The time series and things are replaced as a whole.
+func (svc *AnnotationService) UpdateAnnotationByUuid(ctx context.Context, id uuid.UUID, p UpdateAnnotationByUuidParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	current, err := q.FindAnnotationByUUID(ctx, id)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return 0, nil
+	} else if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateAnnotationParams{
+		Uuid:  id,
+		Start: current.Start,
+		Stop:  current.Stop,
+		Text:  current.Text,
+		Tags:  current.Tags,
+	}
+	timeseries := current.Timeseries
+	things := current.Things
+
+	if p.Start != nil {
+		params.Start = *p.Start
+	}
+	if p.End != nil {
+		params.Stop = *p.End
+	}
+	if p.Text != nil {
+		params.Text = *p.Text
+	}
+	if p.Tags != nil {
+		params.Tags = *p.Tags
+	}
+	if p.Timeseries != nil {
+		timeseries = *p.Timeseries
+	}
+	if p.Things != nil {
+		things = *p.Things
+	}
+
+	if err := validateAnnotation(timeseries, things, params.Start, params.Stop, params.Text); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.UpdateAnnotation(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := setAnnotationLinks(ctx, q, id, p.Timeseries, p.Things); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (svc *AnnotationService) DeleteAnnotation(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteAnnotation(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,330 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestAnnotationResources(t *testing.T) {
+	a := uuid.MustParse("1896048c-bdc9-43c4-af41-4a946b9a341e")
+	b := uuid.MustParse("6e5b6f36-8a4a-4ac6-9ab7-8bb7d84dc4ee")
+
+	resources := AnnotationResources([]uuid.UUID{b, a, b}, []uuid.UUID{a})
+	expected := []string{
+		"things/" + a.String(),
+		"timeseries/" + a.String(),
+		"timeseries/" + b.String(),
+	}
+
+	if len(resources) != len(expected) {
+		log.Fatalf("Resources do not match expected: %v", resources)
+	}
+	for i := range expected {
+		if resources[i] != expected[i] {
+			log.Fatalf("Resources do not match expected: %v", resources)
+		}
+	}
+
+	if len(AnnotationResources(nil, nil)) != 0 {
+		log.Fatal("Expected no resources without time series or things")
+	}
+}
+
+func TestAnnotationValidate(t *testing.T) {
+	id := []uuid.UUID{uuid.MustParse("1896048c-bdc9-43c4-af41-4a946b9a341e")}
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := validateAnnotation(id, nil, t0, t0, "Meter replaced"); err != nil {
+		log.Fatal(err)
+	}
+	if err := validateAnnotation(nil, id, t0, t0.Add(time.Hour), "Calibration"); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := validateAnnotation(nil, nil, t0, t0, "Meter replaced"); err == nil {
+		log.Fatal("Expected error for an annotation without time series or things")
+	}
+	if err := validateAnnotation(id, nil, t0, t0.Add(-time.Second), "Meter replaced"); err == nil {
+		log.Fatal("Expected error for an end before the start")
+	}
+	if err := validateAnnotation(id, nil, t0, t0, " "); err == nil {
+		log.Fatal("Expected error for an empty text")
+	}
+}
+
+func TestAnnotationGroupByTimeseries(t *testing.T) {
+	a := uuid.MustParse("1896048c-bdc9-43c4-af41-4a946b9a341e")
+	b := uuid.MustParse("6e5b6f36-8a4a-4ac6-9ab7-8bb7d84dc4ee")
+	c := uuid.MustParse("a3f2e6a1-0d6b-4c3e-9a57-0c1d3e7c3b11")
+
+	replaced := uuid.MustParse("3c6f5a5b-6d4e-4a3c-8c1c-8a8f0f2b1d7e")
+	calibration := uuid.MustParse("0b1e4c2d-7f3a-4e5b-9c6d-1a2b3c4d5e6f")
+
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// The annotation of both a and b is found once for each of them
+	rows := []postgres.FindAnnotationsByTimeseriesRow{
+		{TsUuid: a, Uuid: replaced, Timeseries: []uuid.UUID{a, b}, Start: t0, Stop: t0, Text: "Meter replaced"},
+		{TsUuid: a, Uuid: calibration, Timeseries: []uuid.UUID{a}, Start: t0.Add(time.Hour), Stop: t0.Add(2 * time.Hour), Text: "Calibration"},
+		{TsUuid: b, Uuid: replaced, Timeseries: []uuid.UUID{a, b}, Start: t0, Stop: t0, Text: "Meter replaced"},
+	}
+
+	result := groupAnnotations([]uuid.UUID{a, b, c}, rows)
+
+	if len(result[a]) != 2 || result[a][0].Uuid != replaced.String() || result[a][1].Uuid != calibration.String() {
+		log.Fatalf("Annotations of a do not match expected: %v", result[a])
+	}
+	if len(result[b]) != 1 || result[b][0].Text != "Meter replaced" || len(result[b][0].Timeseries) != 2 {
+		log.Fatalf("Annotations of b do not match expected: %v", result[b])
+	}
+	if list, ok := result[c]; ok == false || list == nil || len(list) != 0 {
+		log.Fatal("Expected an empty list of annotations for c")
+	}
+	if result[a][1].End != t0.Add(2*time.Hour) {
+		log.Fatalf("End does not match expected: %v", result[a][1].End)
+	}
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestUpdateAnnotationLinks(t *testing.T) {
+	ctx := context.Background()
+	svc := NewAnnotationService(db)
+	tsSvc := NewTimeseriesService(db)
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries, err := tsSvc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyAnnotatedLinksTimeseries",
+		SiUnit:    "C",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	tsUUID := uuid.MustParse(timeseries.Uuid)
+
+	thing, err := NewThingService(db).AddThing(ctx, &AddThingParams{
+		Name:      "MyAnnotatedLinksThing",
+		CreatedBy: &rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	thingUUID := uuid.MustParse(thing.Uuid)
+
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	annotation, err := svc.AddAnnotation(ctx, AddAnnotationParams{
+		Timeseries: []uuid.UUID{tsUUID},
+		Things:     []uuid.UUID{thingUUID},
+		Start:      t0,
+		End:        t0,
+		Text:       "Meter replaced",
+		CreatedBy:  rootUUID,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	id := uuid.MustParse(annotation.Uuid)
+
+	// Removing the time series keeps the thing
+	none := []uuid.UUID{}
+	if count, err := svc.UpdateAnnotationByUuid(ctx, id, UpdateAnnotationByUuidParams{Timeseries: &none}); err != nil {
+		log.Fatal(err)
+	} else if count != 1 {
+		log.Fatal("Annotation was not updated")
+	}
+
+	// Removing the last thing, alone or together with the time series, is refused
+	if _, err := svc.UpdateAnnotationByUuid(ctx, id, UpdateAnnotationByUuidParams{Things: &none}); err == nil {
+		log.Fatal("Expected error for an update leaving no time series or things")
+	}
+	if _, err := svc.UpdateAnnotationByUuid(ctx, id, UpdateAnnotationByUuidParams{Timeseries: &none, Things: &none}); err == nil {
+		log.Fatal("Expected error for an update leaving no time series or things")
+	}
+
+	found, err := svc.FindAnnotationByUuid(ctx, id)
+	if err != nil {
+		log.Fatal(err)
+	} else if len(found.Timeseries) != 0 || len(found.Things) != 1 || found.Things[0] != thing.Uuid {
+		log.Fatalf("Links do not match expected: %v %v", found.Timeseries, found.Things)
+	}
+
+	if _, err := svc.DeleteAnnotation(ctx, id); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := NewThingService(db).DeleteThing(ctx, thingUUID); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := tsSvc.DeleteTimeseries(ctx, tsUUID); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// annotationUuids returns the uuids of the annotations, in order
+func annotationUuids(list []*rest.Annotation) []string {
+	uuids := make([]string, len(list))
+	for i, item := range list {
+		uuids[i] = item.Uuid
+	}
+	return uuids
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestAnnotationAll(t *testing.T) {
+	ctx := context.Background()
+	svc := NewAnnotationService(db)
+	tsSvc := NewTimeseriesService(db)
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries := make([]uuid.UUID, 0)
+	for _, name := range []string{"MyAnnotatedTimeseriesA", "MyAnnotatedTimeseriesB"} {
+		ts, err := tsSvc.AddTimeseries(ctx, &NewTimeseriesParams{
+			Name:      name,
+			SiUnit:    "C",
+			CreatedBy: rootUUID,
+			Tags:      []string{},
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		timeseries = append(timeseries, uuid.MustParse(ts.Uuid))
+	}
+	a, b := timeseries[0], timeseries[1]
+
+	thing, err := NewThingService(db).AddThing(ctx, &AddThingParams{
+		Name:      "MyAnnotatedThing",
+		CreatedBy: &rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	thingUUID := uuid.MustParse(thing.Uuid)
+
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	annotations := make([]uuid.UUID, 0)
+	for _, p := range []AddAnnotationParams{
+		{Timeseries: []uuid.UUID{a, b}, Start: t0, End: t0, Text: "Meter replaced", Tags: []string{"maintenance"}},
+		{Timeseries: []uuid.UUID{a}, Start: t0.Add(time.Hour), End: t0.Add(2 * time.Hour), Text: "Calibration", Tags: []string{}},
+		{Things: []uuid.UUID{thingUUID}, Start: t0.Add(3 * time.Hour), End: t0.Add(3 * time.Hour), Text: "Inspection", Tags: []string{}},
+	} {
+		p.CreatedBy = rootUUID
+		annotation, err := svc.AddAnnotation(ctx, p)
+		if err != nil {
+			log.Fatal(err)
+		}
+		annotations = append(annotations, uuid.MustParse(annotation.Uuid))
+	}
+	replaced, calibration, inspection := annotations[0].String(), annotations[1].String(), annotations[2].String()
+
+	if _, err := svc.AddAnnotation(ctx, AddAnnotationParams{Start: t0, End: t0, Text: "Nothing", CreatedBy: rootUUID}); err == nil {
+		log.Fatal("Expected error for an annotation without time series or things")
+	}
+
+	for _, c := range []struct {
+		p        FindAllAnnotationsParams
+		expected []string
+	}{
+		{FindAllAnnotationsParams{Timeseries: []uuid.UUID{a}, Start: t0, End: t0.Add(4 * time.Hour)}, []string{replaced, calibration}},
+		{FindAllAnnotationsParams{Timeseries: []uuid.UUID{b}, Things: []uuid.UUID{thingUUID}, Start: t0, End: t0.Add(4 * time.Hour)}, []string{replaced, inspection}},
+		{FindAllAnnotationsParams{Timeseries: []uuid.UUID{a}, Start: t0, End: t0.Add(4 * time.Hour), Tags: []string{"maintenance"}}, []string{replaced}},
+		{FindAllAnnotationsParams{Timeseries: []uuid.UUID{a}, Start: t0.Add(90 * time.Minute), End: t0.Add(4 * time.Hour)}, []string{calibration}},
+		{FindAllAnnotationsParams{Timeseries: []uuid.UUID{a}, Start: t0.Add(3 * time.Hour), End: t0.Add(4 * time.Hour)}, []string{}},
+	} {
+		c.p.ArgLimit = 100
+		list, err := svc.FindAll(ctx, c.p)
+		if err != nil {
+			log.Fatal(err)
+		}
+		found := annotationUuids(list)
+		if len(found) != len(c.expected) {
+			log.Fatalf("Annotations do not match expected: %v", found)
+		}
+		for i := range c.expected {
+			if found[i] != c.expected[i] {
+				log.Fatalf("Annotations do not match expected: %v", found)
+			}
+		}
+	}
+
+	byTimeseries, err := svc.FindByTimeseries(ctx, []uuid.UUID{a, b}, t0, t0.Add(4*time.Hour))
+	if err != nil {
+		log.Fatal(err)
+	} else if len(byTimeseries[a]) != 2 || len(byTimeseries[b]) != 1 || byTimeseries[b][0].Uuid != replaced {
+		log.Fatalf("Annotations by time series do not match expected: %v", byTimeseries)
+	}
+
+	// Update keeps what is not given
+	text := "Calibrated"
+	end := t0.Add(150 * time.Minute)
+	if count, err := svc.UpdateAnnotationByUuid(ctx, annotations[1], UpdateAnnotationByUuidParams{Text: &text, End: &end}); err != nil {
+		log.Fatal(err)
+	} else if count != 1 {
+		log.Fatal("Annotation was not updated")
+	}
+
+	found, err := svc.FindAnnotationByUuid(ctx, annotations[1])
+	if err != nil {
+		log.Fatal(err)
+	} else if found.Text != text || found.End.Equal(end) == false || found.Start.Equal(t0.Add(time.Hour)) == false || len(found.Timeseries) != 1 || found.Timeseries[0] != a.String() {
+		log.Fatalf("Updated annotation does not match expected: %v", found)
+	}
+
+	before := t0.Add(3 * time.Hour)
+	if _, err := svc.UpdateAnnotationByUuid(ctx, annotations[1], UpdateAnnotationByUuidParams{End: &before, Start: &end}); err == nil {
+		log.Fatal("Expected error for an end before the start")
+	}
+
+	// The access to an annotation is the access to every one of its time series and things
+	reader := addUserWithPolicy(ctx, "annotationreader", "read", fmt.Sprintf("timeseries/%v", a.String()))
+	policySvc := NewPolicyCheckService(db)
+	for _, c := range []struct {
+		timeseries []uuid.UUID
+		things     []uuid.UUID
+		access     bool
+	}{
+		{[]uuid.UUID{a}, nil, true},
+		{[]uuid.UUID{a, b}, nil, false},
+		{nil, []uuid.UUID{thingUUID}, false},
+	} {
+		ok, err := policySvc.UserHasManyAccessViaToken(ctx, reader, "read", AnnotationResources(c.timeseries, c.things))
+		if err != nil {
+			log.Fatal(err)
+		} else if ok != c.access {
+			log.Fatalf("Access to %v %v should be %v", c.timeseries, c.things, c.access)
+		}
+	}
+
+	for _, id := range annotations {
+		if count, err := svc.DeleteAnnotation(ctx, id); err != nil {
+			log.Fatal(err)
+		} else if count != 1 {
+			log.Fatal("Annotation was not deleted")
+		}
+	}
+	if _, err := svc.FindAnnotationByUuid(ctx, annotations[0]); err == nil {
+		log.Fatal("Deleted annotation should not be found")
+	}
+
+	if _, err := NewThingService(db).DeleteThing(ctx, thingUUID); err != nil {
+		log.Fatal(err)
+	}
+	for _, id := range timeseries {
+		if _, err := tsSvc.DeleteTimeseries(ctx, id); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -7,9 +7,11 @@ package services
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
 	"github.com/self-host/self-host/postgres"
 )
 
@@ -98,7 +100,11 @@ func (svc *DatasetService) AddDataset(ctx context.Context, p *AddDatasetParams)
 	return v, nil
 }
 
-func (svc *DatasetService) FindDatasetByUuid(ctx context.Context, id uuid.UUID) (*rest.Dataset, error) {
+func (svc *DatasetService) FindDatasetByUuid(ctx context.Context, token []byte, id uuid.UUID) (*rest.Dataset, error) {
+	if err := checkArchiveAccess(ctx, svc.q, token, id); err != nil {
+		return nil, err
+	}
+
 	dataset, err := svc.q.FindDatasetByUUID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -125,10 +131,13 @@ func (svc *DatasetService) FindDatasetByUuid(ctx context.Context, id uuid.UUID)
 	return v, nil
 }
 
-func (svc *DatasetService) FindByThing(ctx context.Context, id uuid.UUID) ([]*rest.Dataset, error) {
+func (svc *DatasetService) FindByThing(ctx context.Context, token []byte, id uuid.UUID) ([]*rest.Dataset, error) {
 	datasets := make([]*rest.Dataset, 0)
 
-	datasetsList, err := svc.q.FindDatasetByThing(ctx, id)
+	datasetsList, err := svc.q.FindDatasetByThing(ctx, postgres.FindDatasetByThingParams{
+		Token:     token,
+		ThingUuid: id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +255,11 @@ func (svc *DatasetService) FindByTags(ctx context.Context, p FindByTagsParams) (
 	return datasets, nil
 }
 
-func (svc *DatasetService) GetDatasetContentByUuid(ctx context.Context, id uuid.UUID) (*DatasetFile, error) {
+func (svc *DatasetService) GetDatasetContentByUuid(ctx context.Context, token []byte, id uuid.UUID) (*DatasetFile, error) {
+	if err := checkArchiveAccess(ctx, svc.q, token, id); err != nil {
+		return nil, err
+	}
+
 	content, err := svc.q.GetDatasetContentByUUID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -278,6 +291,11 @@ func (svc *DatasetService) UpdateDatasetByUuid(ctx context.Context, id uuid.UUID
 
 	q := svc.q.WithTx(tx)
 
+	if err := refuseArchive(ctx, q, id); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
 	if p.Name != nil {
 		c, err := q.SetDatasetNameByUUID(ctx, postgres.SetDatasetNameByUUIDParams{
 			Uuid: id,
@@ -349,6 +367,10 @@ func (svc *DatasetService) UpdateDatasetByUuid(ctx context.Context, id uuid.UUID
 }
 
 func (svc *DatasetService) DeleteDataset(ctx context.Context, id uuid.UUID) (int64, error) {
+	if err := refuseArchive(ctx, svc.q, id); err != nil {
+		return 0, err
+	}
+
 	count, err := svc.q.DeleteDataset(ctx, id)
 	if err != nil {
 		return 0, err
@@ -356,3 +378,39 @@ func (svc *DatasetService) DeleteDataset(ctx context.Context, id uuid.UUID) (int
 
 	return count, nil
 }
+
+// checkArchiveAccess returns ErrorForbidden for a dataset holding archived time series data,
+// unless the user has read access to the data of the time series
+func checkArchiveAccess(ctx context.Context, q *postgres.Queries, token []byte, id uuid.UUID) error {
+	archive, err := q.GetTsDataArchiveByDataset(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	ok, err := q.CheckUserTokenHasAccess(ctx, postgres.CheckUserTokenHasAccessParams{
+		Token:    token,
+		Action:   postgres.PolicyActionRead,
+		Resource: fmt.Sprintf("timeseries/%v/data", archive.TsUuid.String()),
+	})
+	if err != nil {
+		return err
+	} else if ok == false {
+		return ie.ErrorForbidden
+	}
+
+	return nil
+}
+
+// refuseArchive returns an error for a dataset holding archived time series data, which only the archiving may change
+func refuseArchive(ctx context.Context, q *postgres.Queries, id uuid.UUID) error {
+	_, err := q.GetTsDataArchiveByDataset(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return ie.NewBadRequestError(fmt.Errorf("the dataset holds archived timeseries data and can not be changed"))
+}
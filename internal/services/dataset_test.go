@@ -0,0 +1,199 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// hasDataset returns true if the list holds the dataset
+func hasDataset(list []*rest.Dataset, id uuid.UUID) bool {
+	for _, item := range list {
+		if item.Uuid == id.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestDatasetArchive(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+	ds := NewDatasetService(db)
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	thing, err := NewThingService(db).AddThing(ctx, &AddThingParams{
+		Name:      "MyArchivedThing",
+		CreatedBy: &rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	thingUUID := uuid.MustParse(thing.Uuid)
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyArchivedDatasetTimeseries",
+		SiUnit:    "C",
+		CreatedBy: rootUUID,
+		ThingUuid: thingUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID := uuid.MustParse(timeseries.Uuid)
+	start := time.Date(2020, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid: tsUUID,
+		Points: []DataPoint{
+			{Value: 10, Timestamp: start},
+			{Value: 20, Timestamp: start.Add(30 * time.Minute)},
+		},
+		CreatedBy: rootUUID,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := svc.ArchiveTsData(ctx, start.Add(24*time.Hour), 24*time.Hour); err != nil {
+		log.Fatal(err)
+	}
+
+	archives, err := postgres.New(db).FindTsDataArchivesByTimeseries(ctx, tsUUID)
+	if err != nil {
+		log.Fatal(err)
+	} else if len(archives) != 1 {
+		log.Fatal("Data was not archived")
+	}
+	datasetUUID := archives[0].DatasetUuid
+
+	// Only the archiving changes an archive
+	name := "renamed"
+	if _, err := ds.UpdateDatasetByUuid(ctx, datasetUUID, UpdateDatasetByUuidParams{Name: &name}); err == nil {
+		log.Fatal("Updating an archive should fail")
+	}
+	if _, err := ds.DeleteDataset(ctx, datasetUUID); err == nil {
+		log.Fatal("Deleting an archive should fail")
+	}
+
+	// Reads require access to the data of the time series
+	dataset, err := ds.FindDatasetByUuid(ctx, []byte(rootToken), datasetUUID)
+	if err != nil {
+		log.Fatal(err)
+	} else if dataset.Name == name || dataset.ThingUuid == nil || *dataset.ThingUuid != thing.Uuid {
+		log.Fatalf("Archive does not match expected: %v", dataset)
+	}
+	if _, err := ds.GetDatasetContentByUuid(ctx, []byte(rootToken), datasetUUID); err != nil {
+		log.Fatal(err)
+	}
+
+	u := NewUserService(db)
+	reader, err := u.AddUser(ctx, "archivereader")
+	if err != nil {
+		log.Fatal(err)
+	}
+	readerUUID := uuid.MustParse(reader.Uuid)
+
+	token, err := u.AddTokenToUser(ctx, readerUUID, "test")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, g := range reader.Groups {
+		if g.Name != reader.Name {
+			continue
+		}
+		_, err := NewPolicyService(db).Add(ctx, NewPolicyParams{
+			GroupUuid: uuid.MustParse(g.Uuid),
+			Priority:  0,
+			Effect:    "allow",
+			Action:    "read",
+			Resource:  "datasets/%",
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if _, err := ds.FindDatasetByUuid(ctx, []byte(token.Secret), datasetUUID); err != ie.ErrorForbidden {
+		log.Fatal("Reading an archive without access to the data should be forbidden")
+	}
+	if _, err := ds.GetDatasetContentByUuid(ctx, []byte(token.Secret), datasetUUID); err != ie.ErrorForbidden {
+		log.Fatal("Reading the content of an archive without access to the data should be forbidden")
+	}
+
+	limit := int64(1000)
+	for _, c := range []struct {
+		token []byte
+		found bool
+	}{
+		{[]byte(rootToken), true},
+		{[]byte(token.Secret), false},
+	} {
+		list, err := ds.FindAll(ctx, NewFindAllParams(c.token, &limit, nil))
+		if err != nil {
+			log.Fatal(err)
+		} else if hasDataset(list, datasetUUID) != c.found {
+			log.Fatalf("Archive should be listed %v", c.found)
+		}
+
+		list, err = ds.FindByTags(ctx, NewFindByTagsParams(c.token, []string{"tsarchive"}, &limit, nil))
+		if err != nil {
+			log.Fatal(err)
+		} else if hasDataset(list, datasetUUID) != c.found {
+			log.Fatalf("Archive should be listed by tag %v", c.found)
+		}
+
+		list, err = ds.FindByThing(ctx, c.token, thingUUID)
+		if err != nil {
+			log.Fatal(err)
+		} else if hasDataset(list, datasetUUID) != c.found {
+			log.Fatalf("Archive should be listed by thing %v", c.found)
+		}
+	}
+
+	// Deleting the thing detaches the archive, which still belongs to the time series
+	if _, err := NewThingService(db).DeleteThing(ctx, thingUUID); err != nil {
+		log.Fatal(err)
+	}
+
+	dataset, err = ds.FindDatasetByUuid(ctx, []byte(rootToken), datasetUUID)
+	if err != nil {
+		log.Fatal(err)
+	} else if dataset.ThingUuid != nil {
+		log.Fatal("Archive should no longer belong to the deleted thing")
+	}
+
+	rows, err := svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+		Uuid:      tsUUID,
+		Start:     start,
+		End:       start.Add(time.Hour),
+		Aggregate: "count",
+		Precision: "1h",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if len(rows) != 1 || rows[0].V == nil || *rows[0].V != 2 {
+		log.Fatal("Archive of a deleted thing should still be read")
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, tsUUID); err != nil {
+		log.Fatal(err)
+	}
+}
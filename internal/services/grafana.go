@@ -0,0 +1,277 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+const (
+	// Most time series found by a Grafana search
+	maxGrafanaSearch = 100
+
+	// Most alerts returned as Grafana annotations
+	maxGrafanaAnnotations = 1000
+)
+
+// GrafanaTarget is a time series in a Grafana query
+type GrafanaTarget struct {
+	RefId     *string
+	Uuid      uuid.UUID
+	Aggregate string
+	// Unit of the result, nil keeps the unit of the time series
+	Unit *string
+}
+
+type QueryGrafanaParams struct {
+	Targets   []GrafanaTarget
+	Start     time.Time
+	End       time.Time
+	Precision string
+}
+
+// GrafanaPrecision returns the bucket width of a Grafana query, from the interval chosen by Grafana
+// or else from the most data points a panel can show.
+func GrafanaPrecision(intervalMs, maxDataPoints *int64, start, end time.Time) string {
+	if intervalMs != nil && *intervalMs > 0 {
+		return fmt.Sprintf("%dms", *intervalMs)
+	}
+
+	if maxDataPoints != nil && *maxDataPoints > 0 && end.After(start) {
+		width := end.Sub(start) / time.Duration(*maxDataPoints)
+		// Round up to whole milliseconds, at least one
+		ms := int64((width + time.Millisecond - 1) / time.Millisecond)
+		if ms < 1 {
+			ms = 1
+		}
+		return fmt.Sprintf("%dms", ms)
+	}
+
+	return "microseconds"
+}
+
+// SearchGrafana finds the time series to offer in a Grafana query editor
+func (svc *TimeseriesService) SearchGrafana(ctx context.Context, token []byte, text string) ([]*rest.GrafanaSearchResult, error) {
+	found, err := svc.SearchTimeseries(ctx, token, text, maxGrafanaSearch)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rest.GrafanaSearchResult, 0, len(found))
+	for _, t := range found {
+		result = append(result, &rest.GrafanaSearchResult{
+			Text:  t.Name,
+			Value: t.Uuid,
+		})
+	}
+
+	return result, nil
+}
+
+// QueryGrafana queries the time series of the targets of a Grafana query, in the order of the targets.
+// Targets with the same aggregate and unit are queried together.
+func (svc *TimeseriesService) QueryGrafana(ctx context.Context, p QueryGrafanaParams) ([]*rest.GrafanaTimeSeries, error) {
+	type group struct {
+		aggregate string
+		unit      *string
+		uuids     []uuid.UUID
+		results   map[uuid.UUID]*rest.TsResults
+	}
+
+	groups := make([]*group, 0)
+	byKey := make(map[string]*group)
+	targetGroup := make([]*group, len(p.Targets))
+	uuids := make([]uuid.UUID, 0, len(p.Targets))
+	seen := make(map[uuid.UUID]bool)
+	for i, t := range p.Targets {
+		key := t.Aggregate
+		if t.Unit != nil {
+			key += ":" + *t.Unit
+		}
+
+		g, ok := byKey[key]
+		if ok == false {
+			g = &group{
+				aggregate: t.Aggregate,
+				unit:      t.Unit,
+				uuids:     make([]uuid.UUID, 0),
+				results:   make(map[uuid.UUID]*rest.TsResults),
+			}
+			byKey[key] = g
+			groups = append(groups, g)
+		}
+		if _, ok := g.results[t.Uuid]; ok == false {
+			g.results[t.Uuid] = nil
+			g.uuids = append(g.uuids, t.Uuid)
+		}
+		targetGroup[i] = g
+
+		if seen[t.Uuid] == false {
+			seen[t.Uuid] = true
+			uuids = append(uuids, t.Uuid)
+		}
+	}
+
+	for _, g := range groups {
+		data, err := svc.QueryMultiSourceData(ctx, QueryMultiSourceDataParams{
+			Uuids:     g.uuids,
+			Start:     p.Start,
+			End:       p.End,
+			Aggregate: g.aggregate,
+			Precision: p.Precision,
+			Timezone:  "UTC",
+			Unit:      g.unit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range data {
+			if item.Error != nil {
+				return nil, ie.NewBadRequestError(fmt.Errorf("timeseries %v: %v", item.Uuid, *item.Error))
+			}
+			g.results[uuid.MustParse(item.Uuid)] = item
+		}
+	}
+
+	names := make(map[uuid.UUID]string, len(uuids))
+	if len(uuids) > 0 {
+		found, err := svc.q.GetTimeseriesByUUIDs(ctx, uuids)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range found {
+			names[item.Uuid] = item.Name
+		}
+	}
+
+	result := make([]*rest.GrafanaTimeSeries, 0, len(p.Targets))
+	for i, t := range p.Targets {
+		ts := &rest.GrafanaTimeSeries{
+			Target:     names[t.Uuid],
+			RefId:      t.RefId,
+			Datapoints: make([][]float64, 0),
+		}
+
+		if data := targetGroup[i].results[t.Uuid]; data != nil {
+			for _, row := range data.Data {
+				if row.V == nil {
+					continue
+				}
+				ts.Datapoints = append(ts.Datapoints, []float64{
+					float64(*row.V),
+					float64(row.Ts.UnixNano() / int64(time.Millisecond)),
+				})
+			}
+		}
+
+		result = append(result, ts)
+	}
+
+	return result, nil
+}
+
+// Alert statuses and severities, to validate the filters of an annotation query
+var (
+	alertStatuses = map[rest.AlertStatus]bool{
+		rest.AlertStatusAcknowledge: true,
+		rest.AlertStatusClose:       true,
+		rest.AlertStatusExpire:      true,
+		rest.AlertStatusOpen:        true,
+		rest.AlertStatusShelve:      true,
+		rest.AlertStatusUnknown:     true,
+	}
+	alertSeverities = map[rest.AlertSeverity]bool{
+		rest.AlertSeverityCritical:      true,
+		rest.AlertSeverityDebug:         true,
+		rest.AlertSeverityIndeterminate: true,
+		rest.AlertSeverityInformational: true,
+		rest.AlertSeverityMajor:         true,
+		rest.AlertSeverityMinor:         true,
+		rest.AlertSeveritySecurity:      true,
+		rest.AlertSeverityTrace:         true,
+		rest.AlertSeverityWarning:       true,
+	}
+)
+
+// ParseGrafanaAnnotationQuery parses the filters of a Grafana annotation query, for example "environment=production severity=critical".
+// The fields service and tag may be repeated, an alert then matches any of the values.
+func ParseGrafanaAnnotationQuery(query string, start, end time.Time) (FindAlertsByTimeRangeParams, error) {
+	p := FindAlertsByTimeRangeParams{
+		Start:    start,
+		End:      end,
+		Service:  []string{},
+		Tags:     []string{},
+		ArgLimit: maxGrafanaAnnotations,
+	}
+
+	for _, filter := range strings.Fields(query) {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return p, ie.NewBadRequestError(fmt.Errorf("filter %v must be formatted as field=value", filter))
+		}
+
+		value := parts[1]
+		switch parts[0] {
+		case "resource":
+			p.Resource = value
+		case "environment":
+			p.Environment = value
+		case "event":
+			p.Event = value
+		case "origin":
+			p.Origin = value
+		case "status":
+			status := rest.AlertStatus(value)
+			if alertStatuses[status] == false {
+				return p, ie.NewBadRequestError(fmt.Errorf("status %v is not a known alert status", value))
+			}
+			p.Status = &status
+		case "severity":
+			severity := rest.AlertSeverity(value)
+			if alertSeverities[severity] == false {
+				return p, ie.NewBadRequestError(fmt.Errorf("severity %v is not a known alert severity", value))
+			}
+			p.Severity = &severity
+		case "service":
+			p.Service = append(p.Service, value)
+		case "tag":
+			p.Tags = append(p.Tags, value)
+		default:
+			return p, ie.NewBadRequestError(fmt.Errorf("unknown filter field %v", parts[0]))
+		}
+	}
+
+	return p, nil
+}
+
+// NewGrafanaAnnotation represents an alert as a Grafana annotation, from when it was created to when it was last received
+func NewGrafanaAnnotation(alert *rest.Alert) *rest.GrafanaAnnotation {
+	end := alert.Created
+	if alert.LastReceiveTime != nil {
+		end = *alert.LastReceiveTime
+	}
+
+	tags := alert.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return &rest.GrafanaAnnotation{
+		Time:    alert.Created.UnixNano() / int64(time.Millisecond),
+		TimeEnd: end.UnixNano() / int64(time.Millisecond),
+		Title:   fmt.Sprintf("%v: %v on %v", alert.Severity, alert.Event, alert.Resource),
+		Text:    alert.Description,
+		Tags:    tags,
+	}
+}
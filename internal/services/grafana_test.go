@@ -0,0 +1,85 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+)
+
+func TestGrafanaPrecision(t *testing.T) {
+	start := time.Date(2021, 11, 20, 0, 0, 0, 0, time.UTC)
+	end := start.Add(6 * time.Hour)
+
+	interval := int64(30000)
+	if p := GrafanaPrecision(&interval, nil, start, end); p != "30000ms" {
+		log.Fatalf("Precision %v does not match expected", p)
+	}
+
+	points := int64(1000)
+	if p := GrafanaPrecision(nil, &points, start, end); p != "21600ms" {
+		log.Fatalf("Precision %v does not match expected", p)
+	}
+
+	points = 7
+	if p := GrafanaPrecision(nil, &points, start, start.Add(time.Second)); p != "143ms" {
+		log.Fatalf("Precision %v should be rounded up", p)
+	}
+
+	if p := GrafanaPrecision(nil, nil, start, end); p != "microseconds" {
+		log.Fatalf("Precision %v does not match expected", p)
+	}
+
+	if _, err := ParseBucketWidth(GrafanaPrecision(&interval, nil, start, end)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func TestParseGrafanaAnnotationQuery(t *testing.T) {
+	start := time.Date(2021, 11, 20, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	p, err := ParseGrafanaAnnotationQuery(" environment=production severity=critical tag=a tag=b ", start, end)
+	if err != nil {
+		log.Fatal(err)
+	} else if p.Environment != "production" || p.Severity == nil || *p.Severity != rest.AlertSeverityCritical {
+		log.Fatalf("Filters do not match expected: %v", p)
+	} else if len(p.Tags) != 2 || p.Tags[1] != "b" || len(p.Service) != 0 {
+		log.Fatalf("Tags do not match expected: %v", p.Tags)
+	} else if p.Start.Equal(start) == false || p.End.Equal(end) == false || p.ArgLimit != maxGrafanaAnnotations {
+		log.Fatalf("Range does not match expected: %v", p)
+	}
+
+	for _, query := range []string{"production", "severity=bad", "status=gone", "color=red", "resource="} {
+		if _, err := ParseGrafanaAnnotationQuery(query, start, end); err == nil {
+			log.Fatalf("Expected error for %v", query)
+		}
+	}
+}
+
+func TestNewGrafanaAnnotation(t *testing.T) {
+	created := time.Date(2021, 11, 20, 10, 0, 0, 0, time.UTC)
+	received := created.Add(5 * time.Minute)
+
+	a := NewGrafanaAnnotation(&rest.Alert{
+		Resource:        "freezer-1",
+		Event:           "HighTemperature",
+		Severity:        rest.AlertSeverityCritical,
+		Description:     "Temperature above -18 C",
+		Created:         created,
+		LastReceiveTime: &received,
+	})
+
+	if a.Time != 1637402400000 || a.TimeEnd != 1637402700000 {
+		log.Fatalf("Times do not match expected: %v %v", a.Time, a.TimeEnd)
+	} else if a.Title != "critical: HighTemperature on freezer-1" || a.Text != "Temperature above -18 C" {
+		log.Fatalf("Title does not match expected: %v", a.Title)
+	} else if a.Tags == nil {
+		log.Fatal("Tags should not be nil")
+	}
+}
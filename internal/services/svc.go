@@ -35,6 +35,7 @@ type DomainInfo struct {
 type DataPoint struct {
 	Value     float64   `json:"v"`
 	Timestamp time.Time `json:"ts"`
+	Quality   Quality   `json:"q,omitempty"`
 }
 
 type PaginationLimit struct {
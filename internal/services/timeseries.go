@@ -8,6 +8,9 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,12 +23,35 @@ import (
 )
 
 const insertDataToTimeseries = `
-INSERT INTO tsdata(ts_uuid, value, ts, created_by)
-SELECT $1::uuid, x.v, x.ts, $2::uuid
+INSERT INTO tsdata(ts_uuid, value, ts, created_by, quality)
+SELECT $1::uuid, x.v, x.ts, $2::uuid, x.q
 FROM
-json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz);
+json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz, "q" smallint);
 `
 
+const insertDataToTimeseriesSkipDuplicates = `
+INSERT INTO tsdata(ts_uuid, value, ts, created_by, quality)
+SELECT $1::uuid, x.v, x.ts, $2::uuid, x.q
+FROM
+json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz, "q" smallint)
+ON CONFLICT (ts_uuid, ts) DO NOTHING;
+`
+
+const insertDataToQuarantine = `
+INSERT INTO tsdata_quarantine(ts_uuid, value, ts, reason, created_by, quality)
+SELECT $1::uuid, x.v, x.ts, x.reason, $2::uuid, x.q
+FROM
+json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz, "reason" text, "q" smallint)
+ON CONFLICT (ts_uuid, ts) DO UPDATE
+SET value = EXCLUDED.value, reason = EXCLUDED.reason, created_by = EXCLUDED.created_by, quality = EXCLUDED.quality, created = NOW();
+`
+
+// Number of data points to insert per statement when streaming data into a time series
+const tsdataBatchSize = 5000
+
+// Upper limit of rejected data points listed in the result of an insert
+const maxRejectedPoints = 1000
+
 // NewTimeseries defines model for NewTimeseries.
 type NewTimeseriesParams struct {
 	CreatedBy  uuid.UUID
@@ -35,6 +61,12 @@ type NewTimeseriesParams struct {
 	Tags       []string
 	LowerBound sql.NullFloat64
 	UpperBound sql.NullFloat64
+	Retention  time.Duration
+	// Empty for time series that store their own data
+	Expression string
+	// Empty for a gauge
+	Kind     rest.TsKind
+	Rollover sql.NullFloat64
 }
 
 func inValidRange(v float32, leLimit, geLimit *float32) bool {
@@ -70,6 +102,35 @@ func inValidRange(v float32, leLimit, geLimit *float32) bool {
 	return false
 }
 
+// convertAggregate converts the result of an aggregate function between units.
+//
+// A count is never converted. Aggregates that are a difference between values or a
+// spread (sum, delta, rate, integral and stddev) are only scaled, as the offset
+// of a unit (for example C to F) does not apply to them.
+func convertAggregate(aggregate string, value float64, from, to units.Unit) (float64, error) {
+	switch aggregate {
+	case "count":
+		return value, nil
+	case "sum", "delta", "rate", "integral", "stddev":
+		zero, err := units.NewValue(0, from).Convert(to)
+		if err != nil {
+			return 0, ie.ErrorInvalidUnitConversion
+		}
+		conv, err := units.NewValue(value, from).Convert(to)
+		if err != nil {
+			return 0, ie.ErrorInvalidUnitConversion
+		}
+		return conv.Float() - zero.Float(), nil
+	}
+
+	conv, err := units.NewValue(value, from).Convert(to)
+	if err != nil {
+		return 0, ie.ErrorInvalidUnitConversion
+	}
+
+	return conv.Float(), nil
+}
+
 // User represents the repository used for interacting with User records.
 type TimeseriesService struct {
 	q  *postgres.Queries
@@ -97,7 +158,55 @@ func (svc *TimeseriesService) Exists(ctx context.Context, id uuid.UUID) (bool, e
 	return found > 0, nil
 }
 
+// newRestTimeseries converts a stored time series to its API representation
+func newRestTimeseries(item postgres.Timeseries) *rest.Timeseries {
+	t := &rest.Timeseries{
+		CreatedBy: item.CreatedBy.String(),
+		Name:      item.Name,
+		SiUnit:    item.SiUnit,
+		Tags:      item.Tags,
+		Kind:      rest.TsKind(item.Kind),
+		Uuid:      item.Uuid.String(),
+	}
+
+	if item.LowerBound.Valid {
+		v := item.LowerBound.Float64
+		t.LowerBound = &v
+	}
+
+	if item.UpperBound.Valid {
+		v := item.UpperBound.Float64
+		t.UpperBound = &v
+	}
+
+	if item.ThingUuid != NilUUID {
+		v := item.ThingUuid.String()
+		t.ThingUuid = &v
+	}
+
+	if item.Retention.Valid {
+		v := formatRetention(item.Retention.Int64)
+		t.Retention = &v
+	}
+
+	if item.Expression.Valid {
+		v := item.Expression.String
+		t.Expression = &v
+	}
+
+	if item.Rollover.Valid {
+		v := item.Rollover.Float64
+		t.Rollover = &v
+	}
+
+	return t
+}
+
 func (svc *TimeseriesService) AddTimeseries(ctx context.Context, opt *NewTimeseriesParams) (*rest.Timeseries, error) {
+	if err := validateUnit(opt.SiUnit); err != nil {
+		return nil, err
+	}
+
 	// Use a transaction for this action
 	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
@@ -113,6 +222,12 @@ func (svc *TimeseriesService) AddTimeseries(ctx context.Context, opt *NewTimeser
 		}
 	}
 
+	kind, err := validateKind(opt.Kind, opt.Rollover)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	params := postgres.CreateTimeseriesParams{
 		CreatedBy:  opt.CreatedBy,
 		ThingUuid:  opt.ThingUuid,
@@ -121,6 +236,17 @@ func (svc *TimeseriesService) AddTimeseries(ctx context.Context, opt *NewTimeser
 		LowerBound: opt.LowerBound,
 		UpperBound: opt.UpperBound,
 		Tags:       tags,
+		Retention:  retentionToNullInt64(opt.Retention),
+		Kind:       string(kind),
+		Rollover:   opt.Rollover,
+	}
+
+	if opt.Expression != "" {
+		if err := validateExpression(ctx, q, NilUUID, opt.Expression); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		params.Expression = sql.NullString{String: opt.Expression, Valid: true}
 	}
 
 	timeseries, err := q.CreateTimeseries(ctx, params)
@@ -131,121 +257,463 @@ func (svc *TimeseriesService) AddTimeseries(ctx context.Context, opt *NewTimeser
 
 	tx.Commit()
 
-	var lb *float64
-	var ub *float64
+	return newRestTimeseries(postgres.Timeseries(timeseries)), nil
+}
+
+// dataPointFilter converts incoming data points to the unit of a time series
+// and checks them against the bounds of the time series.
+// A reset of a counter is accepted below the lower bound, points of a counter must be checked in order of time.
+type dataPointFilter struct {
+	convert    bool
+	fromUnit   units.Unit
+	toUnit     units.Unit
+	lowerBound sql.NullFloat64
+	upperBound sql.NullFloat64
+	counter    bool
+	// Value of the last point accepted, or the stored value before the first point
+	prev   sql.NullFloat64
+	seeded bool
+}
 
-	if timeseries.LowerBound.Valid {
-		lb = &timeseries.LowerBound.Float64
+func newDataPointFilter(series postgres.Timeseries, unit *string) (*dataPointFilter, error) {
+	f := &dataPointFilter{
+		lowerBound: series.LowerBound,
+		upperBound: series.UpperBound,
+		counter:    isCounter(series),
 	}
 
-	if timeseries.UpperBound.Valid {
-		ub = &timeseries.UpperBound.Float64
+	if unit != nil && *unit != series.SiUnit {
+		var err error
+
+		f.fromUnit, err = units.Find(*unit)
+		if err != nil {
+			return nil, ie.ErrorInvalidUnit
+		}
+
+		f.toUnit, err = units.Find(series.SiUnit)
+		if err != nil {
+			// This should never error out, as there should be no incompatible units in the DB
+			return nil, ie.ErrorInvalidUnit
+		}
+
+		f.convert = true
 	}
 
-	t := &rest.Timeseries{
-		Uuid:       timeseries.Uuid.String(),
-		CreatedBy:  timeseries.CreatedBy.String(),
-		Name:       timeseries.Name,
-		SiUnit:     timeseries.SiUnit,
-		LowerBound: lb,
-		UpperBound: ub,
-		Tags:       timeseries.Tags,
+	return f, nil
+}
+
+// Apply converts the point in place and returns the reason if the point is outside of the bounds.
+// An empty reason means that the point is accepted.
+func (f *dataPointFilter) Apply(p *DataPoint) (rest.TsRejectedPointReason, error) {
+	if f.convert {
+		v := units.NewValue(p.Value, f.fromUnit)
+		conv, err := v.Convert(f.toUnit)
+		if err != nil {
+			return "", ie.ErrorInvalidUnitConversion
+		}
+		p.Value = float64(conv.Float())
 	}
 
-	if timeseries.ThingUuid != NilUUID {
-		v := timeseries.ThingUuid.String()
-		t.ThingUuid = &v
+	if f.lowerBound.Valid && p.Value < f.lowerBound.Float64 && f.afterReset(p.Value) == false {
+		return rest.TsRejectedPointReasonBelowLowerBound, nil
+	}
+	if f.upperBound.Valid && p.Value > f.upperBound.Float64 {
+		return rest.TsRejectedPointReasonAboveUpperBound, nil
+	}
+
+	f.prev = sql.NullFloat64{Float64: p.Value, Valid: true}
+
+	return "", nil
+}
+
+// quarantinedPoint is a data point rejected by the bounds of a time series
+type quarantinedPoint struct {
+	Value     float64                    `json:"v"`
+	Timestamp time.Time                  `json:"ts"`
+	Reason    rest.TsRejectedPointReason `json:"reason"`
+	Quality   Quality                    `json:"q,omitempty"`
+}
+
+func newTsInsertResult() *rest.TsInsertResult {
+	return &rest.TsInsertResult{
+		Rejected: make([]rest.TsRejectedPoint, 0),
+	}
+}
+
+// reject counts a rejected point and lists it in the result, up to maxRejectedPoints
+func reject(result *rest.TsInsertResult, p DataPoint, reason rest.TsRejectedPointReason) {
+	result.Filtered++
+	if len(result.Rejected) < maxRejectedPoints {
+		result.Rejected = append(result.Rejected, rest.TsRejectedPoint{
+			V:      p.Value,
+			Ts:     p.Timestamp,
+			Reason: reason,
+		})
+	}
+}
+
+// addTsInsertResult adds the counts and the rejected points of src to dst, up to maxRejectedPoints
+func addTsInsertResult(dst, src *rest.TsInsertResult) {
+	dst.Accepted += src.Accepted
+	dst.Filtered += src.Filtered
+	dst.Duplicates += src.Duplicates
+	dst.Overwritten += src.Overwritten
+	dst.Superseded += src.Superseded
+	dst.Quarantined += src.Quarantined
+
+	for _, item := range src.Rejected {
+		if len(dst.Rejected) == maxRejectedPoints {
+			break
+		}
+		dst.Rejected = append(dst.Rejected, item)
+	}
+}
+
+// quarantine writes rejected points to the quarantine of a time series
+func quarantine(ctx context.Context, tx *sql.Tx, id, createdBy uuid.UUID, points []quarantinedPoint) (int64, error) {
+	if len(points) == 0 {
+		return 0, nil
+	}
+
+	// A row can only be updated once per statement, the last point of a timestamp wins
+	data, err := json.Marshal(lastQuarantinedPerTimestamp(points))
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, insertDataToQuarantine, id, createdBy, data)
+	if err != nil {
+		return 0, err
 	}
 
-	return t, nil
+	return res.RowsAffected()
 }
 
 type AddDataToTimeseriesParams struct {
-	Uuid      uuid.UUID
-	Points    []DataPoint
-	CreatedBy uuid.UUID
-	Unit      *string
+	Uuid       uuid.UUID
+	Points     []DataPoint
+	CreatedBy  uuid.UUID
+	Unit       *string
+	Quarantine bool
+	OnConflict ConflictMode
 }
 
-func (svc *TimeseriesService) AddDataToTimeseries(ctx context.Context, p AddDataToTimeseriesParams) (int64, error) {
+func (svc *TimeseriesService) AddDataToTimeseries(ctx context.Context, p AddDataToTimeseriesParams) (*rest.TsInsertResult, error) {
 	series, err := svc.q.GetTimeseriesByUUID(ctx, p.Uuid)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	filteredPoints := make([]*DataPoint, 0)
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
 
-	var fromUnit units.Unit
-	var toUnit units.Unit
+	result, err := svc.addDataTx(ctx, tx, series, p)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
-	if p.Unit != nil {
-		tsUnit, err := svc.q.GetUnitFromTimeseries(ctx, p.Uuid)
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	svc.refreshLatest(ctx, p.Uuid)
+
+	return result, nil
+}
+
+// AddDataToManyTimeseries adds data to several time series in a single transaction.
+// The results are in the same order as the params.
+func (svc *TimeseriesService) AddDataToManyTimeseries(ctx context.Context, params []AddDataToTimeseriesParams) ([]*rest.TsBatchInsertResult, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := svc.addDataToManyTx(ctx, tx, params)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	uuids := make([]uuid.UUID, len(params))
+	for i, p := range params {
+		uuids[i] = p.Uuid
+	}
+
+	svc.refreshLatest(ctx, uuids...)
+
+	return results, nil
+}
+
+// addDataToManyTx adds data to several time series within a transaction
+func (svc *TimeseriesService) addDataToManyTx(ctx context.Context, tx *sql.Tx, params []AddDataToTimeseriesParams) ([]*rest.TsBatchInsertResult, error) {
+	uuids := make([]uuid.UUID, len(params))
+	seen := make(map[uuid.UUID]bool, len(params))
+	for i, p := range params {
+		if seen[p.Uuid] {
+			return nil, ie.NewBadRequestError(fmt.Errorf("timeseries %v occurs more than once", p.Uuid))
+		}
+		seen[p.Uuid] = true
+		uuids[i] = p.Uuid
+	}
+
+	found, err := svc.q.WithTx(tx).GetTimeseriesByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, err
+	} else if len(found) != len(uuids) {
+		return nil, sql.ErrNoRows
+	}
+
+	series := make(map[uuid.UUID]postgres.Timeseries, len(found))
+	for _, item := range found {
+		series[item.Uuid] = item
+	}
+
+	// Write in a fixed order to avoid deadlocks between transactions
+	order := make([]int, len(params))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return params[order[i]].Uuid.String() < params[order[j]].Uuid.String()
+	})
+
+	results := make([]*rest.TsBatchInsertResult, len(params))
+	for _, i := range order {
+		result, err := svc.addDataTx(ctx, tx, series[params[i].Uuid], params[i])
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 
-		if tsUnit == *p.Unit {
-			p.Unit = nil
-		} else {
+		results[i] = &rest.TsBatchInsertResult{
+			Uuid:   params[i].Uuid.String(),
+			Result: *result,
+		}
+	}
 
-			fromUnit, err = units.Find(*p.Unit)
-			if err != nil {
-				return 0, ie.ErrorInvalidUnit
-			}
+	return results, nil
+}
 
-			toUnit, err = units.Find(tsUnit)
-			if err != nil {
-				// This should never error out, as there should be no incompatible units in the DB
-				return 0, ie.ErrorInvalidUnit
-			}
+// addDataTx filters and inserts data points within a transaction, and updates the rollups and the quarantine
+func (svc *TimeseriesService) addDataTx(ctx context.Context, tx *sql.Tx, series postgres.Timeseries, p AddDataToTimeseriesParams) (*rest.TsInsertResult, error) {
+	if series.Expression.Valid {
+		return nil, errDerivedTimeseries(series.Uuid)
+	}
+
+	filter, err := newDataPointFilter(series, p.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	points := p.Points
+	if filter.counter {
+		points = sortDataPoints(points)
+	}
+	if len(points) > 0 {
+		if err := filter.Seed(ctx, svc.q.WithTx(tx), p.Uuid, points[0].Timestamp); err != nil {
+			return nil, err
 		}
 	}
 
-	for _, item := range p.Points {
+	result := newTsInsertResult()
+	filteredPoints := make([]DataPoint, 0)
+	rejectedPoints := make([]quarantinedPoint, 0)
+	hours := make(rollupHourSet)
+
+	for _, item := range points {
 		// Do not use a pointer to the item variable as this is a known gotcha.
 		pItem := item
 
-		if p.Unit != nil {
-			v := units.NewValue(pItem.Value, fromUnit)
-			conv, err := v.Convert(toUnit)
-			if err != nil {
-				return 0, ie.ErrorInvalidUnitConversion
+		reason, err := filter.Apply(&pItem)
+		if err != nil {
+			return nil, err
+		} else if reason != "" {
+			reject(result, pItem, reason)
+			if p.Quarantine {
+				rejectedPoints = append(rejectedPoints, quarantinedPoint{pItem.Value, pItem.Timestamp, reason, pItem.Quality})
 			}
-			pItem.Value = float64(conv.Float())
+			continue
 		}
 
-		if series.LowerBound.Valid {
-			// Should we skip this value
-			if pItem.Value < series.LowerBound.Float64 {
-				continue
-			}
+		filteredPoints = append(filteredPoints, pItem)
+		hours.Add(pItem.Timestamp)
+	}
+
+	count, err := insertTsData(ctx, tx, p.Uuid, p.CreatedBy, filteredPoints, p.OnConflict.OrDefault(ConflictError))
+	if err != nil {
+		return nil, err
+	}
+	count.addTo(result)
+
+	if err := refreshRollups(ctx, svc.q.WithTx(tx), p.Uuid, hours); err != nil {
+		return nil, err
+	}
+
+	result.Quarantined, err = quarantine(ctx, tx, p.Uuid, p.CreatedBy, rejectedPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type AddDataStreamToTimeseriesParams struct {
+	Uuid       uuid.UUID
+	Reader     DataPointReader
+	CreatedBy  uuid.UUID
+	Unit       *string
+	Quarantine bool
+	OnConflict ConflictMode
+}
+
+// AddDataStreamToTimeseries reads data points from a stream and inserts them in batches.
+// Every batch is committed on its own, so the batches before a failure are kept. On failure the result of the committed
+// batches is returned together with the error; the batches are read in order, so it covers the leading data points of the stream.
+// Sending the stream again resumes it, as points with a timestamp that already exists are skipped and counted as duplicates.
+func (svc *TimeseriesService) AddDataStreamToTimeseries(ctx context.Context, p AddDataStreamToTimeseriesParams) (*rest.TsInsertResult, error) {
+	series, err := svc.q.GetTimeseriesByUUID(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if series.Expression.Valid {
+		return nil, errDerivedTimeseries(series.Uuid)
+	}
+
+	// Check the unit before reading the stream, every batch uses a filter of its own
+	if _, err := newDataPointFilter(series, p.Unit); err != nil {
+		return nil, err
+	}
+
+	// Streams are inserted in batches, skipping existing timestamps makes a resent stream succeed by default
+	onConflict := p.OnConflict.OrDefault(ConflictIgnore)
+
+	result := newTsInsertResult()
+	batch := make([]DataPoint, 0, tsdataBatchSize)
+	committed := false
+
+	// The latest values change with every committed batch, also when a later batch fails
+	defer func() {
+		if committed {
+			svc.refreshLatest(ctx, p.Uuid)
 		}
-		if series.UpperBound.Valid {
-			// Should we skip this value
-			if pItem.Value > series.UpperBound.Float64 {
-				continue
+	}()
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		// Use a transaction for each batch
+		tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+		if err != nil {
+			return err
+		}
+
+		// Count in a result of its own, a batch that is rolled back is not part of the result
+		batchResult := newTsInsertResult()
+		if err := svc.addDataBatchTx(ctx, tx, series, p, onConflict, batch, batchResult); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+
+		addTsInsertResult(result, batchResult)
+		committed = true
+		batch = batch[:0]
+
+		return nil
+	}
+
+	for {
+		point, err := p.Reader.ReadPoint()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return result, err
+		}
+
+		batch = append(batch, point)
+
+		if len(batch) == tsdataBatchSize {
+			if err := flush(); err != nil {
+				return result, err
 			}
 		}
+	}
 
-		filteredPoints = append(filteredPoints, &pItem)
+	if err := flush(); err != nil {
+		return result, err
 	}
 
-	data, err := json.Marshal(filteredPoints)
+	return result, nil
+}
+
+// addDataBatchTx filters and inserts one batch of a stream within a transaction, and updates the rollups and the quarantine.
+// The data points of a counter are checked in order of time, from the value stored before the batch. The counts are added to result.
+func (svc *TimeseriesService) addDataBatchTx(ctx context.Context, tx *sql.Tx, series postgres.Timeseries, p AddDataStreamToTimeseriesParams, onConflict ConflictMode, points []DataPoint, result *rest.TsInsertResult) error {
+	q := svc.q.WithTx(tx)
+
+	filter, err := newDataPointFilter(series, p.Unit)
 	if err != nil {
-		return 0, err
+		return err
+	}
+
+	if filter.counter {
+		points = sortDataPoints(points)
+	}
+	if err := filter.Seed(ctx, q, p.Uuid, points[0].Timestamp); err != nil {
+		return err
+	}
+
+	accepted := make([]DataPoint, 0, len(points))
+	rejected := make([]quarantinedPoint, 0)
+	hours := make(rollupHourSet)
+
+	for _, point := range points {
+		// Do not use a pointer to the item variable as this is a known gotcha.
+		pItem := point
+
+		reason, err := filter.Apply(&pItem)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			reject(result, pItem, reason)
+			if p.Quarantine {
+				rejected = append(rejected, quarantinedPoint{pItem.Value, pItem.Timestamp, reason, pItem.Quality})
+			}
+			continue
+		}
+
+		accepted = append(accepted, pItem)
+		hours.Add(pItem.Timestamp)
 	}
 
-	result, err := svc.db.ExecContext(ctx, insertDataToTimeseries, p.Uuid, p.CreatedBy, data)
+	count, err := quarantine(ctx, tx, p.Uuid, p.CreatedBy, rejected)
 	if err != nil {
-		return 0, err
+		return err
 	}
+	result.Quarantined += count
 
-	count, err := result.RowsAffected()
+	inserted, err := insertTsData(ctx, tx, p.Uuid, p.CreatedBy, accepted, onConflict)
 	if err != nil {
-		return 0, err
+		return err
 	}
+	inserted.addTo(result)
 
-	return count, nil
+	return refreshRollups(ctx, q, p.Uuid, hours)
 }
 
 func (svc *TimeseriesService) FindByTags(ctx context.Context, p FindByTagsParams) ([]*rest.Timeseries, error) {
@@ -268,32 +736,7 @@ func (svc *TimeseriesService) FindByTags(ctx context.Context, p FindByTagsParams
 	}
 
 	for _, item := range tsList {
-		var lBound *float64
-		var uBound *float64
-
-		if item.LowerBound.Valid {
-			lBound = &item.LowerBound.Float64
-		}
-		if item.UpperBound.Valid {
-			uBound = &item.UpperBound.Float64
-		}
-
-		t := &rest.Timeseries{
-			CreatedBy:  item.CreatedBy.String(),
-			LowerBound: lBound,
-			Name:       item.Name,
-			SiUnit:     item.SiUnit,
-			Tags:       item.Tags,
-			UpperBound: uBound,
-			Uuid:       item.Uuid.String(),
-		}
-
-		if item.ThingUuid != NilUUID {
-			v := item.ThingUuid.String()
-			t.ThingUuid = &v
-		}
-
-		timeseries = append(timeseries, t)
+		timeseries = append(timeseries, newRestTimeseries(item))
 	}
 
 	return timeseries, nil
@@ -315,32 +758,7 @@ func (svc *TimeseriesService) FindByThing(ctx context.Context, thing uuid.UUID)
 	}
 
 	for _, item := range tsList {
-		var lBound *float64
-		var uBound *float64
-
-		if item.LowerBound.Valid {
-			lBound = &item.LowerBound.Float64
-		}
-		if item.UpperBound.Valid {
-			uBound = &item.UpperBound.Float64
-		}
-
-		t := &rest.Timeseries{
-			CreatedBy:  item.CreatedBy.String(),
-			LowerBound: lBound,
-			Name:       item.Name,
-			SiUnit:     item.SiUnit,
-			Tags:       item.Tags,
-			UpperBound: uBound,
-			Uuid:       item.Uuid.String(),
-		}
-
-		if item.ThingUuid != NilUUID {
-			v := item.ThingUuid.String()
-			t.ThingUuid = &v
-		}
-
-		timeseries = append(timeseries, t)
+		timeseries = append(timeseries, newRestTimeseries(item))
 	}
 
 	return timeseries, nil
@@ -352,32 +770,7 @@ func (svc *TimeseriesService) FindByUuid(ctx context.Context, id uuid.UUID) (*re
 		return nil, err
 	}
 
-	var lBound *float64
-	var uBound *float64
-
-	if t.LowerBound.Valid {
-		lBound = &t.LowerBound.Float64
-	}
-	if t.UpperBound.Valid {
-		uBound = &t.UpperBound.Float64
-	}
-
-	timeseries := &rest.Timeseries{
-		Uuid:       t.Uuid.String(),
-		Name:       t.Name,
-		SiUnit:     t.SiUnit,
-		Tags:       t.Tags,
-		LowerBound: lBound,
-		UpperBound: uBound,
-		CreatedBy:  t.CreatedBy.String(),
-	}
-
-	if t.ThingUuid != NilUUID {
-		v := t.ThingUuid.String()
-		timeseries.ThingUuid = &v
-	}
-
-	return timeseries, nil
+	return newRestTimeseries(t), nil
 }
 
 func (svc *TimeseriesService) FindAll(ctx context.Context, p FindAllParams) ([]*rest.Timeseries, error) {
@@ -399,32 +792,7 @@ func (svc *TimeseriesService) FindAll(ctx context.Context, p FindAllParams) ([]*
 	}
 
 	for _, item := range tsList {
-		var lBound *float64
-		var uBound *float64
-
-		if item.LowerBound.Valid {
-			lBound = &item.LowerBound.Float64
-		}
-		if item.UpperBound.Valid {
-			uBound = &item.UpperBound.Float64
-		}
-
-		t := &rest.Timeseries{
-			Uuid:       item.Uuid.String(),
-			Name:       item.Name,
-			SiUnit:     item.SiUnit,
-			UpperBound: uBound,
-			LowerBound: lBound,
-			Tags:       item.Tags,
-			CreatedBy:  item.CreatedBy.String(),
-		}
-
-		if item.ThingUuid != NilUUID {
-			v := item.ThingUuid.String()
-			t.ThingUuid = &v
-		}
-
-		timeseries = append(timeseries, t)
+		timeseries = append(timeseries, newRestTimeseries(item))
 	}
 
 	return timeseries, nil
@@ -439,11 +807,17 @@ type QuerySingleSourceDataParams struct {
 	Unit        *string
 	Aggregate   string
 	Precision   string
+	Origin      *time.Time
 	Timezone    string
+	Fill        FillMode
+	// Data points of these qualities are left out
+	ExcludeQuality []Quality
 }
 
 func (svc *TimeseriesService) QuerySingleSourceData(ctx context.Context, p QuerySingleSourceDataParams) ([]*rest.TsRow, error) {
 	tsdata := make([]*rest.TsRow, 0)
+	// Quality of each row in tsdata, passed on to the filled rows
+	qualities := make([]Quality, 0)
 
 	var fromUnit units.Unit
 	var toUnit units.Unit
@@ -476,18 +850,26 @@ func (svc *TimeseriesService) QuerySingleSourceData(ctx context.Context, p Query
 		return nil, err
 	}
 
+	seq, err := newBucketSequence(p.Precision, p.Origin, tzloc)
+	if err != nil {
+		return nil, err
+	}
+
 	params := postgres.GetTsDataRangeAggParams{
-		Aggregate: p.Aggregate,
-		Truncate:  p.Precision,
-		Timezone:  p.Timezone,
+		Aggregate:    p.Aggregate,
+		BucketMonths: seq.Months(),
+		BucketWidth:  seq.Microseconds(),
+		Origin:       seq.Origin(),
+		Timezone:     p.Timezone,
 		TsUuids: []uuid.UUID{
 			p.Uuid, // Expects a list of time series
 		},
-		Start: p.Start,
-		Stop:  p.End,
+		Start:          p.Start,
+		Stop:           p.End,
+		ExcludeQuality: qualityCodes(p.ExcludeQuality),
 	}
 
-	dataList, err := svc.q.GetTsDataRangeAgg(ctx, params)
+	dataList, err := svc.getSourceRangeAgg(ctx, params, seq)
 	if err != nil {
 		return nil, err
 	}
@@ -495,12 +877,11 @@ func (svc *TimeseriesService) QuerySingleSourceData(ctx context.Context, p Query
 	for _, item := range dataList {
 		var f float32
 		if p.Unit != nil {
-			v := units.NewValue(item.Value, fromUnit)
-			conv, err := v.Convert(toUnit)
+			v, err := convertAggregate(p.Aggregate, item.Value, fromUnit, toUnit)
 			if err != nil {
-				return nil, ie.ErrorInvalidUnitConversion
+				return nil, err
 			}
-			f = float32(conv.Float())
+			f = float32(v)
 		} else {
 			f = float32(item.Value)
 		}
@@ -509,14 +890,51 @@ func (svc *TimeseriesService) QuerySingleSourceData(ctx context.Context, p Query
 			continue
 		}
 
+		q := nullQuality(item.Quality)
 		d := rest.TsRow{
-			V:  f,
+			V:  &f,
 			Ts: item.Ts.In(tzloc),
+			Q:  q.Rest(),
 		}
 		tsdata = append(tsdata, &d)
+		qualities = append(qualities, q)
+	}
+
+	if p.Fill.Enabled() == false {
+		return tsdata, nil
+	}
+
+	buckets, err := seq.buckets(p.Start, p.End)
+	if err != nil {
+		return nil, err
 	}
 
-	return tsdata, nil
+	filled := make([]*rest.TsRow, 0, len(buckets))
+	filler := newWideFiller(p.Fill, 1, buckets, func(row TsWideRow) error {
+		filled = append(filled, &rest.TsRow{
+			V:  row.Values[0],
+			Ts: row.Ts.In(tzloc),
+			Q:  row.quality(0).Rest(),
+		})
+		return nil
+	})
+
+	for i, item := range tsdata {
+		err := filler.Push(TsWideRow{
+			Ts:        item.Ts,
+			Values:    []*float32{item.V},
+			Qualities: []Quality{qualities[i]},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := filler.Close(); err != nil {
+		return nil, err
+	}
+
+	return filled, nil
 }
 
 type QueryMultiSourceDataParams struct {
@@ -527,56 +945,293 @@ type QueryMultiSourceDataParams struct {
 	LessOrEq    *float32
 	Aggregate   string
 	Precision   string
+	Origin      *time.Time
 	Timezone    string
+	Fill        FillMode
+	// Unit of the result for all time series, nil keeps the unit of each time series
+	Unit *string
+	// Unit of the result per time series, takes precedence over Unit
+	Units map[uuid.UUID]string
+	// Time series selected by a TimeseriesSelector. When set, every time series is part of the result,
+	// also without data, together with its metadata.
+	Metadata map[uuid.UUID]*rest.Timeseries
+	// Data points of these qualities are left out
+	ExcludeQuality []Quality
 }
 
+// QueryMultiSourceData queries several time series.
+// A time series where the requested unit can not be used is part of the result with an error, instead of failing the query.
 func (svc *TimeseriesService) QueryMultiSourceData(ctx context.Context, p QueryMultiSourceDataParams) ([]*rest.TsResults, error) {
+	resolved, err := svc.resolveResultUnits(ctx, p.Uuids, p.Unit, p.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	newResults := func(id uuid.UUID) *rest.TsResults {
+		r := &rest.TsResults{
+			Uuid: id.String(),
+			Unit: resolved[id].Unit,
+			Data: make([]rest.TsRow, 0),
+		}
+		if err := resolved[id].Err; err != nil {
+			msg := err.Error()
+			r.Error = &msg
+		}
+		if p.Metadata != nil {
+			r.Timeseries = p.Metadata[id]
+		}
+		return r
+	}
+
+	if p.Fill.Enabled() {
+		// Every requested time series is part of the result when filling gaps
+		tsResult := make([]*rest.TsResults, len(p.Uuids))
+		for i, id := range p.Uuids {
+			tsResult[i] = newResults(id)
+		}
+
+		err := svc.queryMultiSourceDataWide(ctx, p, resolved, func(row TsWideRow) error {
+			for i, v := range row.Values {
+				if tsResult[i].Error != nil {
+					continue
+				}
+				tsResult[i].Data = append(tsResult[i].Data, rest.TsRow{
+					V:  v,
+					Ts: row.Ts,
+					Q:  row.quality(i).Rest(),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return tsResult, nil
+	}
+
 	tzloc, err := time.LoadLocation(p.Timezone)
 	if err != nil {
 		return nil, ie.NewInvalidRequestError(err)
 	}
 
+	seq, err := newBucketSequence(p.Precision, p.Origin, tzloc)
+	if err != nil {
+		return nil, err
+	}
+
 	params := postgres.GetTsDataRangeAggParams{
-		Aggregate: p.Aggregate,
-		Truncate:  p.Precision,
-		Timezone:  p.Timezone,
-		TsUuids:   p.Uuids,
-		Start:     p.Start,
-		Stop:      p.End,
+		Aggregate:      p.Aggregate,
+		BucketMonths:   seq.Months(),
+		BucketWidth:    seq.Microseconds(),
+		Origin:         seq.Origin(),
+		Timezone:       p.Timezone,
+		TsUuids:        validResultUnits(p.Uuids, resolved),
+		Start:          p.Start,
+		Stop:           p.End,
+		ExcludeQuality: qualityCodes(p.ExcludeQuality),
 	}
 
 	mapping := make(map[uuid.UUID][]rest.TsRow, 0)
-	dataList, err := svc.q.GetTsDataRangeAgg(ctx, params)
+	if len(params.TsUuids) > 0 {
+		dataList, err := svc.getSourceRangeAgg(ctx, params, seq)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range dataList {
+			if _, ok := mapping[item.TsUuid]; ok == false {
+				mapping[item.TsUuid] = make([]rest.TsRow, 0)
+			}
+
+			v, err := resolved[item.TsUuid].Convert(p.Aggregate, item.Value)
+			if err != nil {
+				return nil, err
+			}
+			f := float32(v)
+
+			if inValidRange(f, p.LessOrEq, p.GreaterOrEq) == false {
+				continue
+			}
+
+			mapping[item.TsUuid] = append(mapping[item.TsUuid], rest.TsRow{
+				V:  &f,
+				Ts: item.Ts.In(tzloc),
+				Q:  nullQuality(item.Quality).Rest(),
+			})
+		}
+	}
+
+	// Time series with data or with an error, in the order requested
+	tsResult := make([]*rest.TsResults, 0)
+	seen := make(map[uuid.UUID]bool)
+	for _, id := range p.Uuids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		data, ok := mapping[id]
+		if ok == false && resolved[id].Err == nil && p.Metadata == nil {
+			continue
+		}
+
+		r := newResults(id)
+		if ok {
+			r.Data = data
+		}
+		tsResult = append(tsResult, r)
+	}
+
+	return tsResult, nil
+}
+
+// validResultUnits returns the time series of uuids that can be expressed in their requested unit
+func validResultUnits(uuids []uuid.UUID, resolved map[uuid.UUID]*resultUnit) []uuid.UUID {
+	valid := make([]uuid.UUID, 0, len(uuids))
+	for _, id := range uuids {
+		if resolved[id].Err == nil {
+			valid = append(valid, id)
+		}
+	}
+	return valid
+}
+
+// TsWideRow is one row of a wide table with one value per time series.
+// A nil value means the time series has no data for the timestamp.
+type TsWideRow struct {
+	Ts     time.Time
+	Values []*float32
+	// Quality per value, nil when every value is a plain measurement
+	Qualities []Quality
+}
+
+// quality returns the quality of the value in column i
+func (r TsWideRow) quality(i int) Quality {
+	if r.Qualities == nil {
+		return QualityNone
+	}
+	return r.Qualities[i]
+}
+
+// QueryMultiSourceDataWide queries several time series and calls fn for each timestamp, in order, with the values aligned in the order of p.Uuids.
+// The result is never collected in memory, except for rows held back while waiting for the next value to interpolate.
+// Fails if the requested unit can not be used for any of the time series.
+func (svc *TimeseriesService) QueryMultiSourceDataWide(ctx context.Context, p QueryMultiSourceDataParams, fn func(TsWideRow) error) error {
+	resolved, err := svc.resolveResultUnits(ctx, p.Uuids, p.Unit, p.Units)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	for _, item := range dataList {
-		if _, ok := mapping[item.TsUuid]; ok == false {
-			mapping[item.TsUuid] = make([]rest.TsRow, 0)
+	if err := firstUnitError(p.Uuids, resolved); err != nil {
+		return err
+	}
+
+	return svc.queryMultiSourceDataWide(ctx, p, resolved, fn)
+}
+
+// queryMultiSourceDataWide is QueryMultiSourceDataWide where the values of time series with a unit error are always nil
+func (svc *TimeseriesService) queryMultiSourceDataWide(ctx context.Context, p QueryMultiSourceDataParams, resolved map[uuid.UUID]*resultUnit, fn func(TsWideRow) error) error {
+	tzloc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return ie.NewInvalidRequestError(err)
+	}
+
+	seq, err := newBucketSequence(p.Precision, p.Origin, tzloc)
+	if err != nil {
+		return err
+	}
+
+	var filler *wideFiller
+	if p.Fill.Enabled() {
+		buckets, err := seq.buckets(p.Start, p.End)
+		if err != nil {
+			return err
 		}
 
-		f := float32(item.Value)
+		filler = newWideFiller(p.Fill, len(p.Uuids), buckets, fn)
+		fn = filler.Push
+	}
 
-		if inValidRange(f, p.LessOrEq, p.GreaterOrEq) == false {
-			continue
+	params := postgres.GetTsDataRangeAggParams{
+		Aggregate:      p.Aggregate,
+		BucketMonths:   seq.Months(),
+		BucketWidth:    seq.Microseconds(),
+		Origin:         seq.Origin(),
+		Timezone:       p.Timezone,
+		TsUuids:        validResultUnits(p.Uuids, resolved),
+		Start:          p.Start,
+		Stop:           p.End,
+		ExcludeQuality: qualityCodes(p.ExcludeQuality),
+	}
+
+	columns := make(map[uuid.UUID]int)
+	for i, id := range p.Uuids {
+		columns[id] = i
+	}
+
+	var row *TsWideRow
+	empty := true
+
+	emit := func() error {
+		if row == nil || empty {
+			return nil
 		}
+		return fn(*row)
+	}
 
-		mapping[item.TsUuid] = append(mapping[item.TsUuid], rest.TsRow{
-			V:  f,
-			Ts: item.Ts.In(tzloc),
+	if len(params.TsUuids) > 0 {
+		err = svc.forEachSourceRangeAgg(ctx, params, seq, func(item postgres.GetTsDataRangeAggRow) error {
+			if row == nil || row.Ts.Equal(item.Ts) == false {
+				if err := emit(); err != nil {
+					return err
+				}
+
+				row = &TsWideRow{
+					Ts:     item.Ts.In(tzloc),
+					Values: make([]*float32, len(p.Uuids)),
+				}
+				empty = true
+			}
+
+			v, err := resolved[item.TsUuid].Convert(p.Aggregate, item.Value)
+			if err != nil {
+				return err
+			}
+			f := float32(v)
+
+			if inValidRange(f, p.LessOrEq, p.GreaterOrEq) == false {
+				return nil
+			}
+
+			if i, ok := columns[item.TsUuid]; ok {
+				row.Values[i] = &f
+				empty = false
+
+				if q := nullQuality(item.Quality); q != QualityNone {
+					if row.Qualities == nil {
+						row.Qualities = make([]Quality, len(p.Uuids))
+					}
+					row.Qualities[i] = q
+				}
+			}
+
+			return nil
 		})
+		if err != nil {
+			return err
+		}
 	}
 
-	tsResult := make([]*rest.TsResults, 0)
-	for key, data := range mapping {
-		tsResult = append(tsResult, &rest.TsResults{
-			Uuid: key.String(),
-			Data: data,
-		})
+	if err := emit(); err != nil {
+		return err
 	}
 
-	return tsResult, nil
+	if filler != nil {
+		return filler.Close()
+	}
+
+	return nil
 }
 
 type UpdateTimeseriesParams struct {
@@ -587,9 +1242,22 @@ type UpdateTimeseriesParams struct {
 	Name       *string
 	SiUnit     *string
 	Tags       *[]string
+	// Zero removes the retention
+	Retention *time.Duration
+	// Empty removes the expression
+	Expression *string
+	Kind       *rest.TsKind
+	// Zero removes the rollover value
+	Rollover *float64
+	// Convert the stored data from the current unit to SiUnit
+	ConvertData bool
 }
 
 func (svc *TimeseriesService) UpdateTimeseries(ctx context.Context, p UpdateTimeseriesParams) (int64, error) {
+	if p.ConvertData && p.SiUnit == nil {
+		return 0, ie.NewBadRequestError(fmt.Errorf("convert_data requires a new si_unit"))
+	}
+
 	// Use a transaction for this action
 	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
@@ -614,7 +1282,17 @@ func (svc *TimeseriesService) UpdateTimeseries(ctx context.Context, p UpdateTime
 	}
 
 	if p.SiUnit != nil {
-		// FIXME: Check SI unit against Gonum
+		if err := validateUnit(*p.SiUnit); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		if p.ConvertData {
+			if err := convertStoredData(ctx, q, p); err != nil {
+				tx.Rollback()
+				return 0, err
+			}
+		}
 
 		params := postgres.SetTimeseriesSiUnitParams{
 			Uuid:   p.Uuid,
@@ -667,6 +1345,47 @@ func (svc *TimeseriesService) UpdateTimeseries(ctx context.Context, p UpdateTime
 		count += c
 	}
 
+	if p.Retention != nil {
+		params := postgres.SetTimeseriesRetentionParams{
+			Uuid:      p.Uuid,
+			Retention: retentionToNullInt64(*p.Retention),
+		}
+		c, err := q.SetTimeseriesRetention(ctx, params)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
+	if p.Expression != nil {
+		params := postgres.SetTimeseriesExpressionParams{
+			Uuid: p.Uuid,
+		}
+		if *p.Expression != "" {
+			if err := validateExpression(ctx, q, p.Uuid, *p.Expression); err != nil {
+				tx.Rollback()
+				return 0, err
+			}
+			params.Expression = sql.NullString{String: *p.Expression, Valid: true}
+		}
+		c, err := q.SetTimeseriesExpression(ctx, params)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
+	if p.Kind != nil || p.Rollover != nil {
+		c, err := setKind(ctx, q, p.Uuid, p.Kind, p.Rollover)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
 	if p.Tags != nil {
 		params := postgres.SetTimeseriesTagsParams{
 			Uuid: p.Uuid,
@@ -685,9 +1404,32 @@ func (svc *TimeseriesService) UpdateTimeseries(ctx context.Context, p UpdateTime
 	return count, nil
 }
 
+// DeleteTimeseries deletes a time series, unless it is an input of a derived time series
 func (svc *TimeseriesService) DeleteTimeseries(ctx context.Context, tsUUID uuid.UUID) (int64, error) {
-	count, err := svc.q.DeleteTimeseries(ctx, tsUUID)
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	used, err := q.CountTimeseriesUsingInput(ctx, tsUUID.String())
 	if err != nil {
+		tx.Rollback()
+		return 0, err
+	} else if used > 0 {
+		tx.Rollback()
+		return 0, ie.NewBadRequestError(fmt.Errorf("timeseries is used by a derived timeseries and can not be deleted"))
+	}
+
+	count, err := q.DeleteTimeseries(ctx, tsUUID)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 
@@ -723,10 +1465,45 @@ func (svc *TimeseriesService) DeleteTsData(ctx context.Context, p DeleteTsDataPa
 		params.Le = float64(*p.LessOrEq)
 	}
 
-	count, err := svc.q.DeleteTsDataRange(ctx, params)
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	// The archiver holds the lock while it moves data into an archive
+	if err := q.LockTsDataRollup(ctx, p.Uuid); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.DeleteTsDataRange(ctx, params)
 	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	archived, err := deleteArchivedData(ctx, q, params)
+	if err != nil {
+		tx.Rollback()
 		return 0, err
 	}
+	count += archived
+
+	if count > 0 {
+		if err := invalidateRollups(ctx, q, tsuuids, p.Start, p.End); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	latestCacheFor(svc.db).remove(p.Uuid)
 
 	return count, nil
 }
@@ -0,0 +1,140 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Aggregates of a counter that are compensated for resets and rollovers, and so can not be answered from the rollups
+var counterAggregates = map[string]bool{
+	"delta": true,
+	"rate":  true,
+	"sum":   true,
+}
+
+// validateKind checks the kind of a time series and its rollover value. An empty kind is a gauge.
+func validateKind(kind rest.TsKind, rollover sql.NullFloat64) (rest.TsKind, error) {
+	switch kind {
+	case "":
+		kind = rest.TsKindGauge
+	case rest.TsKindGauge, rest.TsKindCounter:
+	default:
+		return "", ie.NewBadRequestError(fmt.Errorf("kind %v is not one of gauge or counter", kind))
+	}
+
+	if rollover.Valid {
+		if kind != rest.TsKindCounter {
+			return "", ie.NewBadRequestError(fmt.Errorf("rollover requires a timeseries of the kind counter"))
+		} else if rollover.Float64 <= 0 {
+			return "", ie.NewBadRequestError(fmt.Errorf("rollover must be more than zero"))
+		}
+	}
+
+	return kind, nil
+}
+
+// setKind changes the kind of a time series and its rollover value, a nil value keeps the current one.
+// A zero rollover removes the rollover value, and so does changing the kind to gauge.
+func setKind(ctx context.Context, q *postgres.Queries, id uuid.UUID, kind *rest.TsKind, rollover *float64) (int64, error) {
+	series, err := q.GetTimeseriesByUUID(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	params := postgres.SetTimeseriesKindParams{
+		Uuid:     id,
+		Kind:     series.Kind,
+		Rollover: series.Rollover,
+	}
+
+	if kind != nil {
+		if *kind == rest.TsKindGauge {
+			params.Rollover = sql.NullFloat64{}
+		}
+		params.Kind = string(*kind)
+	}
+
+	if rollover != nil {
+		params.Rollover = sql.NullFloat64{Float64: *rollover, Valid: *rollover != 0}
+	}
+
+	k, err := validateKind(rest.TsKind(params.Kind), params.Rollover)
+	if err != nil {
+		return 0, err
+	}
+	params.Kind = string(k)
+
+	return q.SetTimeseriesKind(ctx, params)
+}
+
+func isCounter(series postgres.Timeseries) bool {
+	return series.Kind == string(rest.TsKindCounter)
+}
+
+// withCounters adds the counters among the time series of an aggregated query to its parameters
+func withCounters(params *postgres.GetTsDataRangeAggParams, series []postgres.Timeseries) {
+	for _, item := range series {
+		if isCounter(item) == false {
+			continue
+		}
+
+		// Zero is a counter without a rollover value
+		params.CounterUuids = append(params.CounterUuids, item.Uuid)
+		params.CounterRollovers = append(params.CounterRollovers, item.Rollover.Float64)
+	}
+}
+
+// afterReset reports if a value of a counter below the lower bound follows a reset. It does when it is less than
+// the value before it, or when the value before it is below the lower bound as the counter has yet to reach it again.
+// A counter restarts from zero, so such a value is never negative.
+func (f *dataPointFilter) afterReset(v float64) bool {
+	if f.counter == false || f.prev.Valid == false || v < 0 {
+		return false
+	}
+	return v < f.prev.Float64 || f.prev.Float64 < f.lowerBound.Float64
+}
+
+// Seed looks up the stored value before ts, to find a reset of a counter in the first data point checked.
+// Does nothing unless the time series is a counter with a lower bound, or when already seeded.
+func (f *dataPointFilter) Seed(ctx context.Context, q *postgres.Queries, id uuid.UUID, ts time.Time) error {
+	if f.counter == false || f.lowerBound.Valid == false || f.seeded {
+		return nil
+	}
+	f.seeded = true
+
+	v, err := q.GetTsDataValueBefore(ctx, postgres.GetTsDataValueBeforeParams{
+		TsUuid: id,
+		Before: ts,
+	})
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	f.prev = sql.NullFloat64{Float64: v, Valid: true}
+
+	return nil
+}
+
+// sortDataPoints returns a copy of points in order of time
+func sortDataPoints(points []DataPoint) []DataPoint {
+	sorted := append([]DataPoint{}, points...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+	return sorted
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestCounterValidateKind(t *testing.T) {
+	kind, err := validateKind("", sql.NullFloat64{})
+	if err != nil {
+		log.Fatal(err)
+	} else if kind != rest.TsKindGauge {
+		log.Fatalf("Kind does not match expected: %v", kind)
+	}
+
+	kind, err = validateKind(rest.TsKindCounter, sql.NullFloat64{Float64: 65536, Valid: true})
+	if err != nil {
+		log.Fatal(err)
+	} else if kind != rest.TsKindCounter {
+		log.Fatalf("Kind does not match expected: %v", kind)
+	}
+
+	if _, err := validateKind("histogram", sql.NullFloat64{}); err == nil {
+		log.Fatal("Expected error for unknown kind")
+	}
+	if _, err := validateKind(rest.TsKindGauge, sql.NullFloat64{Float64: 100, Valid: true}); err == nil {
+		log.Fatal("Expected error for rollover of a gauge")
+	}
+	if _, err := validateKind(rest.TsKindCounter, sql.NullFloat64{Float64: -1, Valid: true}); err == nil {
+		log.Fatal("Expected error for negative rollover")
+	}
+}
+
+func TestCounterFilterAcceptsReset(t *testing.T) {
+	series := postgres.Timeseries{
+		Kind:       string(rest.TsKindCounter),
+		LowerBound: sql.NullFloat64{Float64: 1000, Valid: true},
+		UpperBound: sql.NullFloat64{Float64: 5000, Valid: true},
+	}
+
+	filter, err := newDataPointFilter(series, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	points := sortDataPoints([]DataPoint{
+		{Value: 3, Timestamp: t0.Add(3 * time.Minute)},
+		{Value: 1200, Timestamp: t0},
+		{Value: 1300, Timestamp: t0.Add(time.Minute)},
+		{Value: 500, Timestamp: t0.Add(4 * time.Minute)},
+		{Value: 1, Timestamp: t0.Add(2 * time.Minute)},
+		{Value: -1, Timestamp: t0.Add(5 * time.Minute)},
+	})
+
+	// 1 is a reset, 3 and 500 count up from it and -1 is never a reset
+	expected := []rest.TsRejectedPointReason{
+		"",
+		"",
+		"",
+		"",
+		"",
+		rest.TsRejectedPointReasonBelowLowerBound,
+	}
+
+	// Without a value before it, the first point below the lower bound is rejected
+	first := DataPoint{Value: 1, Timestamp: t0.Add(-time.Minute)}
+	if reason, err := filter.Apply(&first); err != nil {
+		log.Fatal(err)
+	} else if reason != rest.TsRejectedPointReasonBelowLowerBound {
+		log.Fatal("Expected the first point to be rejected")
+	}
+
+	for i, p := range points {
+		reason, err := filter.Apply(&p)
+		if err != nil {
+			log.Fatal(err)
+		} else if reason != expected[i] {
+			log.Fatalf("Reason of point %v does not match expected: %v", p, reason)
+		}
+	}
+
+	// A gauge keeps its lower bound
+	series.Kind = string(rest.TsKindGauge)
+	filter, err = newDataPointFilter(series, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, v := range []float64{1200, 1} {
+		p := DataPoint{Value: v, Timestamp: t0}
+		reason, err := filter.Apply(&p)
+		if err != nil {
+			log.Fatal(err)
+		} else if v == 1 && reason != rest.TsRejectedPointReasonBelowLowerBound {
+			log.Fatal("Expected the point of a gauge to be rejected")
+		}
+	}
+}
+
+func TestCounterExcludesRollups(t *testing.T) {
+	seq, err := newBucketSequence("hour", nil, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	counter := postgres.Timeseries{
+		Uuid:     uuid.MustParse("1896048c-bdc9-43c4-af41-4a946b9a341e"),
+		Kind:     string(rest.TsKindCounter),
+		Rollover: sql.NullFloat64{Float64: 65536, Valid: true},
+	}
+	gauge := postgres.Timeseries{
+		Uuid: uuid.MustParse("6e5b6f36-8a4a-4ac6-9ab7-8bb7d84dc4ee"),
+		Kind: string(rest.TsKindGauge),
+	}
+
+	params := postgres.GetTsDataRangeAggParams{
+		Aggregate: "sum",
+		Start:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Stop:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	withCounters(&params, []postgres.Timeseries{gauge})
+	if _, ok := planQueryRollup(params, seq); ok == false {
+		log.Fatal("Expected a rollup plan for the sum of a gauge")
+	}
+
+	withCounters(&params, []postgres.Timeseries{counter, gauge})
+	if len(params.CounterUuids) != 1 || params.CounterUuids[0] != counter.Uuid || params.CounterRollovers[0] != 65536 {
+		log.Fatalf("Counters do not match expected: %v %v", params.CounterUuids, params.CounterRollovers)
+	}
+
+	// The sum of a counter is compensated for resets
+	if _, ok := planQueryRollup(params, seq); ok {
+		log.Fatal("Expected no rollup plan for the sum of a counter")
+	}
+
+	// The maximum of a counter is not
+	params.Aggregate = "max"
+	if _, ok := planQueryRollup(params, seq); ok == false {
+		log.Fatal("Expected a rollup plan for the max of a counter")
+	}
+}
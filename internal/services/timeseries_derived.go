@@ -0,0 +1,391 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	"github.com/google/uuid"
+
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// derivedExpression is the expression of a derived time series.
+// Input time series are referenced by their UUID in brackets, for example [1896048c-bdc9-43c4-af41-4a946b9a341e] * 3.6
+type derivedExpression struct {
+	expr *govaluate.EvaluableExpression
+	// Variable name of each input time series
+	vars map[string]uuid.UUID
+}
+
+// parseExpression parses the expression of a derived time series
+func parseExpression(s string) (*derivedExpression, error) {
+	expr, err := govaluate.NewEvaluableExpression(s)
+	if err != nil {
+		return nil, ie.NewBadRequestError(fmt.Errorf("expression is not valid: %v", err))
+	}
+
+	d := &derivedExpression{
+		expr: expr,
+		vars: make(map[string]uuid.UUID),
+	}
+
+	for _, name := range expr.Vars() {
+		id, err := uuid.Parse(name)
+		if err != nil {
+			return nil, ie.NewBadRequestError(fmt.Errorf("expression variable %v is not a timeseries UUID", name))
+		}
+		d.vars[name] = id
+	}
+
+	if len(d.vars) == 0 {
+		return nil, ie.NewBadRequestError(fmt.Errorf("expression does not use any timeseries"))
+	}
+
+	return d, nil
+}
+
+// Inputs returns the UUID of every input time series
+func (d *derivedExpression) Inputs() []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	inputs := make([]uuid.UUID, 0, len(d.vars))
+	for _, id := range d.vars {
+		if seen[id] == false {
+			seen[id] = true
+			inputs = append(inputs, id)
+		}
+	}
+	return inputs
+}
+
+// Evaluate computes the expression from the values of the input time series.
+// Returns false when an input is missing or the result is not a finite number.
+func (d *derivedExpression) Evaluate(values map[uuid.UUID]float64) (float64, bool, error) {
+	params := make(map[string]interface{}, len(d.vars))
+	for name, id := range d.vars {
+		v, ok := values[id]
+		if ok == false {
+			return 0, false, nil
+		}
+		params[name] = v
+	}
+
+	result, err := d.expr.Evaluate(params)
+	if err != nil {
+		return 0, false, ie.NewBadRequestError(fmt.Errorf("expression could not be evaluated: %v", err))
+	}
+
+	f, ok := result.(float64)
+	if ok == false {
+		return 0, false, ie.NewBadRequestError(fmt.Errorf("expression does not result in a number"))
+	}
+
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false, nil
+	}
+
+	return f, true, nil
+}
+
+// Quality returns the most severe quality of the input time series
+func (d *derivedExpression) Quality(qualities map[uuid.UUID]Quality) Quality {
+	worst := QualityNone
+	for _, id := range d.vars {
+		if q := qualities[id]; q > worst {
+			worst = q
+		}
+	}
+	return worst
+}
+
+// errMsgDerivedNoData is the error of a derived time series in results that are read from stored data only
+const errMsgDerivedNoData = "derived timeseries store no data, use a query instead"
+
+// errDerivedTimeseries is returned when writing data to a derived time series
+func errDerivedTimeseries(id uuid.UUID) error {
+	return ie.NewBadRequestError(fmt.Errorf("timeseries %v is derived and can not store data", id))
+}
+
+// validateExpression checks that an expression can be used by the time series self.
+// Inputs must exist and store their own data, derived time series can not be nested.
+func validateExpression(ctx context.Context, q *postgres.Queries, self uuid.UUID, s string) error {
+	d, err := parseExpression(s)
+	if err != nil {
+		return err
+	}
+
+	inputs := d.Inputs()
+	found, err := q.GetTimeseriesByUUIDs(ctx, inputs)
+	if err != nil {
+		return err
+	} else if len(found) != len(inputs) {
+		return ie.NewBadRequestError(fmt.Errorf("expression uses a timeseries that does not exist"))
+	}
+
+	values := make(map[uuid.UUID]float64)
+	for _, item := range found {
+		if item.Uuid == self {
+			return ie.NewBadRequestError(fmt.Errorf("expression can not use its own timeseries"))
+		} else if item.Expression.Valid {
+			return ie.NewBadRequestError(fmt.Errorf("expression can not use the derived timeseries %v", item.Uuid))
+		}
+		values[item.Uuid] = 1
+	}
+
+	if self != NilUUID {
+		count, err := q.CountTimeseriesUsingInput(ctx, self.String())
+		if err != nil {
+			return err
+		} else if count > 0 {
+			return ie.NewBadRequestError(fmt.Errorf("timeseries is used by a derived timeseries and can not be derived itself"))
+		}
+	}
+
+	// Catch expressions resulting in something else than a number
+	_, _, err = d.Evaluate(values)
+	return err
+}
+
+// tsSources resolves the requested time series of a query into the time series that store data
+type tsSources struct {
+	// Time series to read from tsdata
+	stored []uuid.UUID
+	// Requested time series that store data
+	direct map[uuid.UUID]bool
+	// Requested derived time series
+	derived map[uuid.UUID]*derivedExpression
+	// Derived time series in the order requested
+	order []uuid.UUID
+	// Time series in stored of the kind counter
+	counters []postgres.Timeseries
+}
+
+// resolveSources replaces every derived time series in uuids with its inputs
+func (svc *TimeseriesService) resolveSources(ctx context.Context, uuids []uuid.UUID) (*tsSources, error) {
+	src := &tsSources{
+		stored:  make([]uuid.UUID, 0, len(uuids)),
+		direct:  make(map[uuid.UUID]bool),
+		derived: make(map[uuid.UUID]*derivedExpression),
+	}
+
+	found, err := svc.q.GetTimeseriesByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range found {
+		if item.Expression.Valid == false {
+			continue
+		}
+
+		d, err := parseExpression(item.Expression.String)
+		if err != nil {
+			return nil, err
+		}
+		src.derived[item.Uuid] = d
+	}
+
+	add := func(id uuid.UUID) {
+		for _, s := range src.stored {
+			if s == id {
+				return
+			}
+		}
+		src.stored = append(src.stored, id)
+	}
+
+	for _, id := range uuids {
+		if d, ok := src.derived[id]; ok {
+			src.order = append(src.order, id)
+			for _, input := range d.Inputs() {
+				add(input)
+			}
+		} else {
+			src.direct[id] = true
+			add(id)
+		}
+	}
+
+	// The inputs of derived time series are counters as well
+	if len(src.derived) > 0 {
+		inputs, err := svc.q.GetTimeseriesByUUIDs(ctx, src.Inputs())
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, inputs...)
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, item := range found {
+		if isCounter(item) && seen[item.Uuid] == false {
+			seen[item.Uuid] = true
+			src.counters = append(src.counters, item)
+		}
+	}
+
+	return src, nil
+}
+
+// Inputs returns the inputs of every requested derived time series
+func (s *tsSources) Inputs() []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	inputs := make([]uuid.UUID, 0)
+	for _, id := range s.order {
+		for _, input := range s.derived[id].Inputs() {
+			if seen[input] == false {
+				seen[input] = true
+				inputs = append(inputs, input)
+			}
+		}
+	}
+	return inputs
+}
+
+// FindExpressionInputs returns the input time series of every derived time series in uuids
+func (svc *TimeseriesService) FindExpressionInputs(ctx context.Context, uuids []uuid.UUID) ([]uuid.UUID, error) {
+	src, err := svc.resolveSources(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	return src.Inputs(), nil
+}
+
+// derivedAligner passes on the rows of the requested stored time series, and computes the derived time series
+// from the rows of each bucket. Rows must be pushed in order of time.
+type derivedAligner struct {
+	src    *tsSources
+	emit   func(postgres.GetTsDataRangeAggRow) error
+	ts     time.Time
+	values map[uuid.UUID]float64
+	// Quality of the values, only set for values that are not plain measurements
+	qualities map[uuid.UUID]Quality
+}
+
+func newDerivedAligner(src *tsSources, emit func(postgres.GetTsDataRangeAggRow) error) *derivedAligner {
+	return &derivedAligner{
+		src:       src,
+		emit:      emit,
+		values:    make(map[uuid.UUID]float64),
+		qualities: make(map[uuid.UUID]Quality),
+	}
+}
+
+func (a *derivedAligner) Push(row postgres.GetTsDataRangeAggRow) error {
+	if len(a.values) > 0 && row.Ts.Equal(a.ts) == false {
+		if err := a.flush(); err != nil {
+			return err
+		}
+	}
+
+	a.ts = row.Ts
+	a.values[row.TsUuid] = row.Value
+	if q := nullQuality(row.Quality); q != QualityNone {
+		a.qualities[row.TsUuid] = q
+	}
+
+	if a.src.direct[row.TsUuid] {
+		return a.emit(row)
+	}
+
+	return nil
+}
+
+// Close computes the derived time series of the last bucket
+func (a *derivedAligner) Close() error {
+	if len(a.values) == 0 {
+		return nil
+	}
+	return a.flush()
+}
+
+func (a *derivedAligner) flush() error {
+	for _, id := range a.src.order {
+		v, ok, err := a.src.derived[id].Evaluate(a.values)
+		if err != nil {
+			return err
+		} else if ok == false {
+			continue
+		}
+
+		row := postgres.GetTsDataRangeAggRow{
+			TsUuid: id,
+			Value:  v,
+			Ts:     a.ts,
+		}
+		if q := a.src.derived[id].Quality(a.qualities); q != QualityNone {
+			row.Quality = sql.NullInt32{Int32: int32(q), Valid: true}
+		}
+
+		if err := a.emit(row); err != nil {
+			return err
+		}
+	}
+
+	a.values = make(map[uuid.UUID]float64)
+	a.qualities = make(map[uuid.UUID]Quality)
+
+	return nil
+}
+
+// getSourceRangeAgg is getTsDataRangeAgg for time series that may be derived
+func (svc *TimeseriesService) getSourceRangeAgg(ctx context.Context, params postgres.GetTsDataRangeAggParams, seq *bucketSequence) ([]postgres.GetTsDataRangeAggRow, error) {
+	src, err := svc.resolveSources(ctx, params.TsUuids)
+	if err != nil {
+		return nil, err
+	}
+
+	params.TsUuids = src.stored
+	withCounters(&params, src.counters)
+	rows, err := svc.getTsDataRangeAgg(ctx, params, seq)
+	if err != nil || len(src.derived) == 0 {
+		return rows, err
+	}
+
+	result := make([]postgres.GetTsDataRangeAggRow, 0, len(rows))
+	aligner := newDerivedAligner(src, func(row postgres.GetTsDataRangeAggRow) error {
+		result = append(result, row)
+		return nil
+	})
+
+	for _, row := range rows {
+		if err := aligner.Push(row); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := aligner.Close(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// forEachSourceRangeAgg is forEachTsDataRangeAgg for time series that may be derived
+func (svc *TimeseriesService) forEachSourceRangeAgg(ctx context.Context, params postgres.GetTsDataRangeAggParams, seq *bucketSequence, fn func(postgres.GetTsDataRangeAggRow) error) error {
+	src, err := svc.resolveSources(ctx, params.TsUuids)
+	if err != nil {
+		return err
+	}
+
+	params.TsUuids = src.stored
+	withCounters(&params, src.counters)
+	if len(src.derived) == 0 {
+		return svc.forEachTsDataRangeAgg(ctx, params, seq, fn)
+	}
+
+	aligner := newDerivedAligner(src, fn)
+	if err := svc.forEachTsDataRangeAgg(ctx, params, seq, aligner.Push); err != nil {
+		return err
+	}
+
+	return aligner.Close()
+}
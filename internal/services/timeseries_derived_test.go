@@ -0,0 +1,162 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestParseExpression(t *testing.T) {
+	a := uuid.MustParse("1896048c-bdc9-43c4-af41-4a946b9a341e")
+	b := uuid.MustParse("6e5b6f36-8a4a-4ac6-9ab7-8bb7d84dc4ee")
+
+	d, err := parseExpression("[" + a.String() + "] / [" + b.String() + "] * 3.6")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(d.Inputs()) != 2 {
+		log.Fatal("Inputs do not match expected")
+	}
+
+	v, ok, err := d.Evaluate(map[uuid.UUID]float64{a: 10, b: 2})
+	if err != nil {
+		log.Fatal(err)
+	} else if ok == false || v != 18 {
+		log.Fatal("Result does not match expected")
+	}
+
+	// A missing input or a division by zero has no result
+	if _, ok, _ := d.Evaluate(map[uuid.UUID]float64{a: 10}); ok {
+		log.Fatal("Expected no result for missing input")
+	}
+	if _, ok, _ := d.Evaluate(map[uuid.UUID]float64{a: 10, b: 0}); ok {
+		log.Fatal("Expected no result for division by zero")
+	}
+
+	for _, s := range []string{"", "1 + 2", "[not-a-uuid] + 1", "[" + a.String() + "] +"} {
+		if _, err := parseExpression(s); err == nil {
+			log.Fatalf("Expected error for %v", s)
+		}
+	}
+}
+
+func TestDerivedAligner(t *testing.T) {
+	a := uuid.MustParse("1896048c-bdc9-43c4-af41-4a946b9a341e")
+	b := uuid.MustParse("6e5b6f36-8a4a-4ac6-9ab7-8bb7d84dc4ee")
+	c := uuid.MustParse("a3f2e6a1-0d6b-4c3e-9a57-0c1d3e7c3b11")
+
+	d, err := parseExpression("[" + a.String() + "] + [" + b.String() + "]")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The derived c and the stored a are requested
+	src := &tsSources{
+		stored:  []uuid.UUID{a, b},
+		direct:  map[uuid.UUID]bool{a: true},
+		derived: map[uuid.UUID]*derivedExpression{c: d},
+		order:   []uuid.UUID{c},
+	}
+
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+
+	result := make([]postgres.GetTsDataRangeAggRow, 0)
+	aligner := newDerivedAligner(src, func(row postgres.GetTsDataRangeAggRow) error {
+		result = append(result, row)
+		return nil
+	})
+
+	rows := []postgres.GetTsDataRangeAggRow{
+		{TsUuid: a, Value: 1, Ts: t0},
+		{TsUuid: b, Value: 2, Ts: t0},
+		// b has no value for t1, so c has no value either
+		{TsUuid: a, Value: 5, Ts: t1},
+	}
+
+	for _, row := range rows {
+		if err := aligner.Push(row); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := aligner.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	expected := []postgres.GetTsDataRangeAggRow{
+		{TsUuid: a, Value: 1, Ts: t0},
+		{TsUuid: c, Value: 3, Ts: t0},
+		{TsUuid: a, Value: 5, Ts: t1},
+	}
+
+	if len(result) != len(expected) {
+		log.Fatal("Number of rows does not match expected")
+	}
+	for i := range expected {
+		if result[i].TsUuid != expected[i].TsUuid || result[i].Value != expected[i].Value || result[i].Ts.Equal(expected[i].Ts) == false {
+			log.Fatalf("Row %v does not match expected", i)
+		}
+	}
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestDeleteDerivedInput(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+	createdBy := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	input, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyInputTimeseries",
+		SiUnit:    "W",
+		CreatedBy: createdBy,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	derived, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:       "MyDerivedTimeseries",
+		SiUnit:     "kW",
+		CreatedBy:  createdBy,
+		Tags:       []string{},
+		Expression: "[" + input.Uuid + "] / 1000",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	inputUUID := uuid.MustParse(input.Uuid)
+
+	if _, err := svc.DeleteTimeseries(ctx, inputUUID); err == nil {
+		log.Fatal("Expected error for deleting the input of a derived timeseries")
+	}
+
+	if ok, err := svc.Exists(ctx, inputUUID); err != nil {
+		log.Fatal(err)
+	} else if ok == false {
+		log.Fatal("Input of a derived timeseries was deleted")
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, uuid.MustParse(derived.Uuid)); err != nil {
+		log.Fatal(err)
+	}
+
+	// Once no longer used, the input can be deleted
+	count, err := svc.DeleteTimeseries(ctx, inputUUID)
+	if err != nil {
+		log.Fatal(err)
+	} else if count == 0 {
+		log.Fatal("Timeseries was not deleted")
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// ParseRetention parses an ISO-8601 (P90D) or Go style (90d, 36h) duration of whole seconds.
+// Months and years are not accepted, as their length varies.
+func ParseRetention(s string) (time.Duration, error) {
+	var w BucketWidth
+	var err error
+	if strings.HasPrefix(s, "P") {
+		w, err = parseISODuration(s)
+	} else {
+		w, err = parseGoDuration(s)
+	}
+	if err != nil {
+		return 0, ie.NewBadRequestError(fmt.Errorf("retention %v is not a valid duration", s))
+	}
+
+	switch {
+	case w.Months > 0:
+		return 0, ie.NewBadRequestError(fmt.Errorf("retention %v can not use months or years, use days instead", s))
+	case w.Duration < time.Second:
+		return 0, ie.NewBadRequestError(fmt.Errorf("retention %v is shorter than one second", s))
+	case w.Duration%time.Second != 0:
+		return 0, ie.NewBadRequestError(fmt.Errorf("retention %v is not a whole number of seconds", s))
+	}
+
+	return w.Duration, nil
+}
+
+// formatRetention formats seconds as an ISO-8601 duration, for example P90D or PT36H
+func formatRetention(seconds int64) string {
+	d := seconds / 86400
+	h := seconds % 86400 / 3600
+	m := seconds % 3600 / 60
+	s := seconds % 60
+
+	var b strings.Builder
+	b.WriteString("P")
+	if d > 0 {
+		fmt.Fprintf(&b, "%dD", d)
+	}
+	if h > 0 || m > 0 || s > 0 {
+		b.WriteString("T")
+		if h > 0 {
+			fmt.Fprintf(&b, "%dH", h)
+		}
+		if m > 0 {
+			fmt.Fprintf(&b, "%dM", m)
+		}
+		if s > 0 {
+			fmt.Fprintf(&b, "%dS", s)
+		}
+	}
+
+	return b.String()
+}
+
+// retentionToNullInt64 converts a retention to the value stored in the database, zero keeps data forever
+func retentionToNullInt64(d time.Duration) sql.NullInt64 {
+	if d <= 0 {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(d / time.Second), Valid: true}
+}
+
+// RetentionResult is the number of data points deleted from a time series
+type RetentionResult struct {
+	Uuid    uuid.UUID
+	Deleted int64
+}
+
+// EnforceRetention deletes all data older than the retention of each time series, and the archives of such data.
+// Data is deleted in ranges no longer than window, oldest first, to keep every delete short.
+// Only time series where data was deleted are part of the result.
+func (svc *TimeseriesService) EnforceRetention(ctx context.Context, now time.Time, window time.Duration) ([]RetentionResult, error) {
+	series, err := svc.q.FindTimeseriesWithRetention(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]RetentionResult, 0)
+	for _, item := range series {
+		// BETWEEN is inclusive, keep data at the cutoff
+		cutoff := now.Add(-time.Duration(item.Retention) * time.Second)
+		last := cutoff.Add(-time.Microsecond)
+
+		// Archives are kept until all of their data is older than the cutoff
+		deleted, err := svc.deleteTsDataArchivesBefore(ctx, item.Uuid, cutoff)
+		if err != nil {
+			return result, err
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+
+			first, err := svc.q.GetTsDataFirstTimestampBefore(ctx, postgres.GetTsDataFirstTimestampBeforeParams{
+				TsUuid: item.Uuid,
+				Before: cutoff,
+			})
+			if err == sql.ErrNoRows {
+				break
+			} else if err != nil {
+				return result, err
+			}
+
+			stop := first.Add(window)
+			if stop.After(last) {
+				stop = last
+			}
+
+			count, err := svc.deleteTsDataWindow(ctx, item.Uuid, first, stop)
+			if err != nil {
+				return result, err
+			}
+			deleted += count
+		}
+
+		if deleted > 0 {
+			result = append(result, RetentionResult{
+				Uuid:    item.Uuid,
+				Deleted: deleted,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// deleteTsDataArchivesBefore deletes the archives of a time series where all data is older than cutoff, and updates the rollups.
+// It returns the number of archived data points deleted.
+func (svc *TimeseriesService) deleteTsDataArchivesBefore(ctx context.Context, id uuid.UUID, cutoff time.Time) (int64, error) {
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	// The archiver holds the lock while it moves data into an archive
+	if err := q.LockTsDataRollup(ctx, id); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	archives, err := q.DeleteTsDataArchivesBefore(ctx, postgres.DeleteTsDataArchivesBeforeParams{
+		TsUuid: id,
+		Before: cutoff,
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	} else if len(archives) == 0 {
+		tx.Rollback()
+		return 0, nil
+	}
+
+	var count int64
+	start := archives[0].Start
+	stop := archives[0].Stop
+	for _, archive := range archives {
+		count += archive.Count
+		if archive.Start.Before(start) {
+			start = archive.Start
+		}
+		if archive.Stop.After(stop) {
+			stop = archive.Stop
+		}
+	}
+
+	// Rollups of archived hours are kept until the archive is deleted
+	if err := invalidateRollups(ctx, q, []uuid.UUID{id}, start, stop); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// deleteTsDataWindow deletes all data of a time series from start to stop, and updates the rollups
+func (svc *TimeseriesService) deleteTsDataWindow(ctx context.Context, id uuid.UUID, start, stop time.Time) (int64, error) {
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	count, err := q.DeleteTsDataRange(ctx, postgres.DeleteTsDataRangeParams{
+		TsUuids: []uuid.UUID{id},
+		Start:   start,
+		Stop:    stop,
+		GeNull:  true,
+		LeNull:  true,
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := invalidateRollups(ctx, q, []uuid.UUID{id}, start, stop); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"testing"
+	"time"
+)
+
+func TestParseRetention(t *testing.T) {
+	valid := map[string]time.Duration{
+		"P90D":  90 * 24 * time.Hour,
+		"90d":   90 * 24 * time.Hour,
+		"PT36H": 36 * time.Hour,
+		"2w":    14 * 24 * time.Hour,
+	}
+
+	for s, expected := range valid {
+		d, err := ParseRetention(s)
+		if err != nil {
+			log.Fatal(s, err)
+		}
+		if d != expected {
+			log.Fatalf("Retention of %v does not match expected", s)
+		}
+	}
+
+	for _, s := range []string{"", "P1M", "1y", "500ms", "1.5s", "forever"} {
+		if _, err := ParseRetention(s); err == nil {
+			log.Fatalf("Expected error for %v", s)
+		}
+	}
+
+	if formatRetention(90*86400) != "P90D" || formatRetention(86400+3600+61) != "P1DT1H1M1S" || formatRetention(1800) != "PT30M" {
+		log.Fatal("Formatted retention does not match expected")
+	}
+}
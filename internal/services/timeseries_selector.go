@@ -0,0 +1,131 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Maximum number of time series a selector may match
+const maxSelectedTimeseries = 100
+
+// TimeseriesSelector selects time series by their tags and thing, instead of by their UUID
+type TimeseriesSelector struct {
+	// Token of the user, only time series with readable data are selected
+	Token []byte
+	Tags  []string
+	// Require all of the tags instead of any of them
+	MatchAll bool
+	Thing    *uuid.UUID
+}
+
+// ParseTagsMatch parses how the tags of a selector are matched, "any" (the default) or "all"
+func ParseTagsMatch(s *string) (bool, error) {
+	if s == nil || *s == "any" {
+		return false, nil
+	} else if *s == "all" {
+		return true, nil
+	}
+	return false, ie.NewBadRequestError(fmt.Errorf("tags_match must be any or all"))
+}
+
+// SelectTimeseries returns the time series matched by a selector, ordered by name.
+// Time series where the user may not read the data are left out, as are derived time series where
+// the user may not read the data of every input.
+func (svc *TimeseriesService) SelectTimeseries(ctx context.Context, sel TimeseriesSelector) ([]*rest.Timeseries, error) {
+	if len(sel.Tags) == 0 && sel.Thing == nil {
+		return nil, ie.NewBadRequestError(fmt.Errorf("a selector requires tags or a thing"))
+	}
+
+	params := postgres.FindTimeseriesBySelectorParams{
+		Token:     sel.Token,
+		AnyTags:   []string{},
+		AllTags:   []string{},
+		ThingNull: sel.Thing == nil,
+		ArgLimit:  maxSelectedTimeseries + 1,
+	}
+
+	if sel.MatchAll {
+		params.AllTags = sel.Tags
+	} else if sel.Tags != nil {
+		params.AnyTags = sel.Tags
+	}
+
+	if sel.Thing != nil {
+		params.ThingUuid = *sel.Thing
+	}
+
+	found, err := svc.q.FindTimeseriesBySelector(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(found) > maxSelectedTimeseries {
+		return nil, ie.NewBadRequestError(fmt.Errorf("selector matches more than %v timeseries", maxSelectedTimeseries))
+	}
+
+	return svc.readableTimeseries(ctx, sel.Token, found)
+}
+
+// SearchTimeseries returns the time series where the name or one of the tags contains the text, ignoring case, ordered by name.
+// As with a selector, time series where the user may not read the data, or the data of every input, are left out.
+// At most limit time series are returned.
+func (svc *TimeseriesService) SearchTimeseries(ctx context.Context, token []byte, text string, limit int64) ([]*rest.Timeseries, error) {
+	found, err := svc.q.SearchTimeseries(ctx, postgres.SearchTimeseriesParams{
+		Token:    token,
+		Text:     text,
+		ArgLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.readableTimeseries(ctx, token, found)
+}
+
+// readableTimeseries leaves out the derived time series where the user may not read the data of every input
+func (svc *TimeseriesService) readableTimeseries(ctx context.Context, token []byte, found []postgres.Timeseries) ([]*rest.Timeseries, error) {
+	selected := make([]*rest.Timeseries, 0, len(found))
+	for _, item := range found {
+		if item.Expression.Valid {
+			readable, err := svc.canReadInputs(ctx, token, item.Expression.String)
+			if err != nil {
+				return nil, err
+			} else if readable == false {
+				continue
+			}
+		}
+
+		selected = append(selected, newRestTimeseries(item))
+	}
+
+	return selected, nil
+}
+
+// canReadInputs checks that the user may read the data of every input of an expression
+func (svc *TimeseriesService) canReadInputs(ctx context.Context, token []byte, expression string) (bool, error) {
+	d, err := parseExpression(expression)
+	if err != nil {
+		return false, err
+	}
+
+	resources := make([]string, 0)
+	for _, id := range d.Inputs() {
+		resources = append(resources, fmt.Sprintf("timeseries/%v/data", id))
+	}
+
+	return svc.q.CheckUserTokenHasAccessMany(ctx, postgres.CheckUserTokenHasAccessManyParams{
+		Token:     token,
+		Action:    postgres.PolicyActionRead,
+		Resources: resources,
+	})
+}
@@ -0,0 +1,29 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"testing"
+)
+
+func TestParseTagsMatch(t *testing.T) {
+	any, all := "any", "all"
+
+	if v, err := ParseTagsMatch(nil); err != nil || v != false {
+		log.Fatal("Tags match should default to any")
+	}
+	if v, err := ParseTagsMatch(&any); err != nil || v != false {
+		log.Fatal("Tags match any does not match expected")
+	}
+	if v, err := ParseTagsMatch(&all); err != nil || v != true {
+		log.Fatal("Tags match all does not match expected")
+	}
+
+	some := "some"
+	if _, err := ParseTagsMatch(&some); err == nil {
+		log.Fatal("Unknown tags match should fail")
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// taggedSeries is a time series identified by a tag, as used by the write protocols of other systems
+type taggedSeries struct {
+	// Tag identifying the time series
+	Tag string
+	// Name, unit and further tags of the time series, when it is created
+	Name   string
+	SiUnit string
+	Tags   []string
+}
+
+// findTaggedSeries returns the time series carrying each of the tags, and the tags that no time series carries.
+// With thing, only the time series of the thing are considered. A tag may only be carried by one time series.
+func findTaggedSeries(ctx context.Context, q *postgres.Queries, tags []string, thing *uuid.UUID) (map[string]uuid.UUID, []string, error) {
+	params := postgres.FindTimeseriesByEachTagParams{
+		Tags:      tags,
+		ThingNull: thing == nil,
+	}
+	if thing != nil {
+		params.ThingUuid = *thing
+	}
+
+	rows, err := q.FindTimeseriesByEachTag(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := make(map[string]uuid.UUID, len(tags))
+	for _, row := range rows {
+		if _, ok := found[row.Tag]; ok {
+			return nil, nil, ie.NewBadRequestError(fmt.Errorf("more than one timeseries has the tag %v", row.Tag))
+		}
+		found[row.Tag] = row.Uuid
+	}
+
+	missing := make([]string, 0)
+	for _, tag := range tags {
+		if _, ok := found[tag]; ok == false {
+			missing = append(missing, tag)
+		}
+	}
+
+	return found, missing, nil
+}
+
+// createTaggedSeries creates the time series that do not exist yet, in thing if not nil,
+// and returns the time series of every tag. It is meant to run within a transaction that also writes the data.
+// The user of token must have create access to timeseries, when any time series is created.
+func createTaggedSeries(ctx context.Context, q *postgres.Queries, token []byte, series []taggedSeries, thing *uuid.UUID, createdBy uuid.UUID) (map[string]uuid.UUID, error) {
+	for _, item := range series {
+		if err := validateUnit(item.SiUnit); err != nil {
+			return nil, err
+		}
+	}
+
+	byTag := make(map[string]taggedSeries, len(series))
+	tags := make([]string, 0, len(series))
+	for _, item := range series {
+		if _, ok := byTag[item.Tag]; ok == false {
+			byTag[item.Tag] = item
+			tags = append(tags, item.Tag)
+		}
+	}
+
+	// Keep concurrent writers from creating the same time series, in a fixed order to avoid deadlocks
+	sort.Strings(tags)
+	for _, tag := range tags {
+		if err := q.LockTimeseriesTag(ctx, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	// Another writer may have created some of them in the meantime
+	found, missing, err := findTaggedSeries(ctx, q, tags, thing)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(missing) > 0 {
+		ok, err := q.CheckUserTokenHasAccess(ctx, postgres.CheckUserTokenHasAccessParams{
+			Token:    token,
+			Action:   postgres.PolicyActionCreate,
+			Resource: "timeseries",
+		})
+		if err != nil {
+			return nil, err
+		} else if ok == false {
+			return nil, ie.ErrorForbidden
+		}
+	}
+
+	thingUuid := NilUUID
+	if thing != nil {
+		thingUuid = *thing
+	}
+
+	for _, tag := range missing {
+		item := byTag[tag]
+		ts, err := q.CreateTimeseries(ctx, postgres.CreateTimeseriesParams{
+			CreatedBy: createdBy,
+			ThingUuid: thingUuid,
+			Name:      item.Name,
+			SiUnit:    item.SiUnit,
+			Tags:      append([]string{item.Tag}, item.Tags...),
+		})
+		if err != nil {
+			return nil, err
+		}
+		found[tag] = ts.Uuid
+	}
+
+	return found, nil
+}
+
+// addTaggedDataTx adds data to the time series of tagged series within the transaction that created the missing ones.
+// The user must be allowed to write the data of every time series in uuids, else nothing is written.
+func (svc *TimeseriesService) addTaggedDataTx(ctx context.Context, tx *sql.Tx, token []byte, uuids []uuid.UUID, params []AddDataToTimeseriesParams) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	resources := make([]string, len(uuids))
+	for i, id := range uuids {
+		resources[i] = fmt.Sprintf("timeseries/%v/data", id.String())
+	}
+
+	// Checked within the transaction, as the policies of created time series are not committed yet
+	ok, err := svc.q.WithTx(tx).CheckUserTokenHasAccessMany(ctx, postgres.CheckUserTokenHasAccessManyParams{
+		Token:     token,
+		Action:    postgres.PolicyActionCreate,
+		Resources: resources,
+	})
+	if err != nil {
+		return err
+	} else if ok == false {
+		return ie.ErrorForbidden
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	_, err = svc.addDataToManyTx(ctx, tx, params)
+	return err
+}
@@ -6,10 +6,13 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"log"
 	"testing"
 
 	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
 )
 
 // Tests can run in any order, so we need to run everything (Timeseries related) in one function
@@ -19,6 +22,7 @@ func TestTimeseriesAll(t *testing.T) {
 
 	params := &NewTimeseriesParams{
 		Name:      "MyTimeseries",
+		SiUnit:    "C",
 		CreatedBy: uuid.MustParse("00000000-0000-1000-8000-000000000000"), // UUID for Root user
 		Tags:      []string{},
 	}
@@ -49,6 +53,33 @@ func TestTimeseriesAll(t *testing.T) {
 	}
 }
 
+func TestNewRestTimeseries(t *testing.T) {
+	thing := uuid.New()
+	ts := newRestTimeseries(postgres.Timeseries{
+		Uuid:       uuid.New(),
+		ThingUuid:  thing,
+		Name:       "Power",
+		SiUnit:     "kW",
+		LowerBound: sql.NullFloat64{Float64: -10, Valid: true},
+		Tags:       []string{"energy"},
+	})
+
+	if ts.ThingUuid == nil || *ts.ThingUuid != thing.String() {
+		log.Fatal("Thing does not match expected")
+	}
+	if ts.LowerBound == nil || *ts.LowerBound != -10 || ts.UpperBound != nil {
+		log.Fatal("Bounds do not match expected")
+	}
+	if ts.Expression != nil || ts.Retention != nil {
+		log.Fatal("Unset fields should be nil")
+	}
+
+	ts = newRestTimeseries(postgres.Timeseries{Uuid: uuid.New(), ThingUuid: NilUUID})
+	if ts.ThingUuid != nil {
+		log.Fatal("Nil thing should not be set")
+	}
+}
+
 type RangeRowT struct {
 	V   float32
 	Le  *float32
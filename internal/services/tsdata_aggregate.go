@@ -0,0 +1,320 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"database/sql"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+// aggPoint is a data point read by an aggregated query, from tsdata or from an archive
+type aggPoint struct {
+	id      uuid.UUID
+	ts      time.Time
+	value   float64
+	quality Quality
+}
+
+// bucketAggregate accumulates the data points of a time series within a bucket.
+// Only the aggregates of the percentiles need every value of the bucket.
+type bucketAggregate struct {
+	start time.Time
+
+	count    int64
+	sum      float64
+	min      float64
+	max      float64
+	first    aggPoint
+	last     aggPoint
+	quality  Quality
+	integral float64
+
+	// Running mean and sum of squared differences, for the standard deviation
+	mean float64
+	m2   float64
+
+	// Counters; the increase of every data point, and the increase within the bucket
+	increase    float64
+	hasIncrease bool
+	continued   float64
+
+	values []float64
+}
+
+// seriesAggregator computes the aggregates of GetTsDataRangeAgg in Go, for the data points of a time series
+// pushed in order of time. It mirrors the query, for queries that read archived data.
+type seriesAggregator struct {
+	id        uuid.UUID
+	aggregate string
+	seq       *bucketSequence
+	counter   bool
+	// Rollover value of a counter, zero for none
+	rollover float64
+
+	bucket *bucketAggregate
+	prev   *aggPoint
+}
+
+func newSeriesAggregator(id uuid.UUID, aggregate string, seq *bucketSequence) *seriesAggregator {
+	return &seriesAggregator{
+		id:        id,
+		aggregate: aggregate,
+		seq:       seq,
+	}
+}
+
+// Push adds a data point, and returns the row of the bucket before when the data point starts a new bucket.
+// A data point at the same time as the one before is skipped, so a time read from both tsdata and an archive counts once.
+func (s *seriesAggregator) Push(p aggPoint) (postgres.GetTsDataRangeAggRow, bool) {
+	if s.prev != nil && p.ts.After(s.prev.ts) == false {
+		return postgres.GetTsDataRangeAggRow{}, false
+	}
+
+	var row postgres.GetTsDataRangeAggRow
+	var ok bool
+
+	start := s.seq.truncate(p.ts)
+	if s.bucket != nil && s.bucket.start.Equal(start) == false {
+		row, ok = s.Flush()
+	}
+
+	b := s.bucket
+	if b == nil {
+		b = &bucketAggregate{
+			start: start,
+			min:   p.value,
+			max:   p.value,
+			first: p,
+		}
+		s.bucket = b
+	} else {
+		b.integral += (p.value + b.last.value) / 2 * p.ts.Sub(b.last.ts).Seconds()
+	}
+
+	if s.counter && s.prev != nil {
+		increase := counterIncrease(s.prev.value, p.value, s.rollover)
+		b.increase += increase
+		b.hasIncrease = true
+		if b.count > 0 {
+			b.continued += increase
+		}
+	}
+
+	b.count++
+	b.sum += p.value
+	b.min = math.Min(b.min, p.value)
+	b.max = math.Max(b.max, p.value)
+	b.last = p
+	if p.quality > b.quality {
+		b.quality = p.quality
+	}
+
+	delta := p.value - b.mean
+	b.mean += delta / float64(b.count)
+	b.m2 += delta * (p.value - b.mean)
+
+	switch s.aggregate {
+	case "median", "p95", "p99":
+		b.values = append(b.values, p.value)
+	}
+
+	s.prev = &p
+
+	return row, ok
+}
+
+// Flush returns the row of the current bucket, false when there is none or the aggregate is undefined
+func (s *seriesAggregator) Flush() (postgres.GetTsDataRangeAggRow, bool) {
+	b := s.bucket
+	if b == nil {
+		return postgres.GetTsDataRangeAggRow{}, false
+	}
+	s.bucket = nil
+
+	value, ok := b.value(s.aggregate, s.counter)
+	if ok == false {
+		return postgres.GetTsDataRangeAggRow{}, false
+	}
+
+	return postgres.GetTsDataRangeAggRow{
+		TsUuid: s.id,
+		Value:  value,
+		Ts:     b.start,
+		Quality: sql.NullInt32{
+			Int32: int32(b.quality),
+			Valid: b.quality != QualityNone,
+		},
+	}, true
+}
+
+// value returns the aggregate of the bucket, false when it is undefined such as the rate of a single value
+func (b *bucketAggregate) value(aggregate string, counter bool) (float64, bool) {
+	seconds := b.last.ts.Sub(b.first.ts).Seconds()
+
+	switch aggregate {
+	case "avg":
+		return b.sum / float64(b.count), true
+	case "min":
+		return b.min, true
+	case "max":
+		return b.max, true
+	case "count":
+		return float64(b.count), true
+	case "sum":
+		if counter {
+			return b.increase, b.hasIncrease
+		}
+		return b.sum, true
+	case "first":
+		return b.first.value, true
+	case "last":
+		return b.last.value, true
+	case "delta":
+		if counter {
+			return b.continued, true
+		}
+		return b.last.value - b.first.value, true
+	case "rate":
+		if seconds == 0 {
+			return 0, false
+		} else if counter {
+			return b.continued / seconds, true
+		}
+		return (b.last.value - b.first.value) / seconds, true
+	case "integral":
+		return b.integral / 3600, true
+	case "median":
+		return percentileCont(b.values, 0.5), true
+	case "p95":
+		return percentileCont(b.values, 0.95), true
+	case "p99":
+		return percentileCont(b.values, 0.99), true
+	case "stddev":
+		if b.count < 2 {
+			return 0, false
+		}
+		return math.Sqrt(b.m2 / float64(b.count-1)), true
+	}
+
+	return 0, false
+}
+
+// counterIncrease mirrors tsdata_counter_increase, for a counter with a value before
+func counterIncrease(prev, value, rollover float64) float64 {
+	if value >= prev {
+		return value - prev
+	} else if rollover > 0 {
+		return rollover - prev + value
+	}
+	return value
+}
+
+// percentileCont mirrors percentile_cont, interpolating between the values closest to the fraction p.
+// The values are sorted in place.
+func percentileCont(values []float64, p float64) float64 {
+	sort.Float64s(values)
+
+	pos := p * float64(len(values)-1)
+	lo := math.Floor(pos)
+	hi := math.Ceil(pos)
+
+	return values[int(lo)] + (pos-lo)*(values[int(hi)]-values[int(lo)])
+}
+
+// tsdataAggregator runs the aggregators of every time series of a query, over data points pushed in order of time,
+// and passes the rows to fn in order of time like GetTsDataRangeAgg.
+type tsdataAggregator struct {
+	series  map[uuid.UUID]*seriesAggregator
+	exclude map[Quality]bool
+	fn      func(postgres.GetTsDataRangeAggRow) error
+
+	// Rows of finished buckets, passed on once no time series can have a bucket before them
+	pending []postgres.GetTsDataRangeAggRow
+}
+
+func newTsDataAggregator(params postgres.GetTsDataRangeAggParams, seq *bucketSequence, fn func(postgres.GetTsDataRangeAggRow) error) *tsdataAggregator {
+	a := &tsdataAggregator{
+		series:  make(map[uuid.UUID]*seriesAggregator, len(params.TsUuids)),
+		exclude: make(map[Quality]bool, len(params.ExcludeQuality)),
+		fn:      fn,
+	}
+
+	for _, id := range params.TsUuids {
+		a.series[id] = newSeriesAggregator(id, params.Aggregate, seq)
+	}
+	for i, id := range params.CounterUuids {
+		if s, ok := a.series[id]; ok {
+			s.counter = true
+			s.rollover = params.CounterRollovers[i]
+		}
+	}
+	for _, q := range params.ExcludeQuality {
+		a.exclude[Quality(q)] = true
+	}
+
+	return a
+}
+
+// Push adds a data point. Data points of an excluded quality are left out, plain measurements never are.
+func (a *tsdataAggregator) Push(p aggPoint) error {
+	s, ok := a.series[p.id]
+	if ok == false || (p.quality != QualityNone && a.exclude[p.quality]) {
+		return nil
+	}
+
+	row, ok := s.Push(p)
+	if ok == false {
+		return nil
+	}
+	a.pending = append(a.pending, row)
+
+	// Buckets still open start no later than any bucket to come
+	var open *time.Time
+	for _, s := range a.series {
+		if s.bucket != nil && (open == nil || s.bucket.start.Before(*open)) {
+			open = &s.bucket.start
+		}
+	}
+
+	return a.release(open)
+}
+
+// Close passes on the rows of every bucket still open
+func (a *tsdataAggregator) Close() error {
+	for _, s := range a.series {
+		if row, ok := s.Flush(); ok {
+			a.pending = append(a.pending, row)
+		}
+	}
+
+	return a.release(nil)
+}
+
+// release passes on the pending rows before open, or every pending row when nil
+func (a *tsdataAggregator) release(open *time.Time) error {
+	sort.SliceStable(a.pending, func(i, j int) bool {
+		return a.pending[i].Ts.Before(a.pending[j].Ts)
+	})
+
+	n := 0
+	for _, row := range a.pending {
+		if open != nil && row.Ts.Before(*open) == false {
+			break
+		}
+		if err := a.fn(row); err != nil {
+			return err
+		}
+		n++
+	}
+	a.pending = a.pending[n:]
+
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"math"
+	"testing"
+	"time"
+
+	units "github.com/ganehag/go-units"
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestConvertAggregate(t *testing.T) {
+	from, err := units.Find("C")
+	if err != nil {
+		log.Fatal(err)
+	}
+	to, err := units.Find("F")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	v, err := convertAggregate("avg", 10, from, to)
+	if err != nil || math.Abs(v-50) > 1e-9 {
+		log.Fatal("Converted avg does not match expected")
+	}
+
+	v, err = convertAggregate("delta", 10, from, to)
+	if err != nil || math.Abs(v-18) > 1e-9 {
+		log.Fatal("Converted delta does not match expected")
+	}
+
+	v, err = convertAggregate("count", 10, from, to)
+	if err != nil || v != 10 {
+		log.Fatal("Converted count does not match expected")
+	}
+}
+
+func TestSeriesAggregator(t *testing.T) {
+	seq, err := newBucketSequence("1h", nil, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	id := uuid.New()
+	start := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	points := []aggPoint{
+		{id: id, ts: start, value: 1},
+		{id: id, ts: start.Add(30 * time.Minute), value: 3},
+		{id: id, ts: start.Add(30 * time.Minute), value: 100},
+		{id: id, ts: start.Add(60 * time.Minute), value: 5, quality: QualityEstimated},
+	}
+
+	aggregate := func(name string, counter bool) []postgres.GetTsDataRangeAggRow {
+		s := newSeriesAggregator(id, name, seq)
+		s.counter = counter
+
+		rows := make([]postgres.GetTsDataRangeAggRow, 0)
+		for _, p := range points {
+			if row, ok := s.Push(p); ok {
+				rows = append(rows, row)
+			}
+		}
+		if row, ok := s.Flush(); ok {
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	// The second data point at the same time is skipped
+	rows := aggregate("avg", false)
+	if len(rows) != 2 || rows[0].Value != 2 || rows[0].Ts.Equal(start) == false || rows[0].Quality.Valid {
+		log.Fatal("Avg does not match expected")
+	}
+	if rows[1].Value != 5 || rows[1].Quality.Int32 != int32(QualityEstimated) {
+		log.Fatal("Avg of the second bucket does not match expected")
+	}
+
+	// The trapezoid from 1 to 3 over half an hour
+	rows = aggregate("integral", false)
+	if len(rows) != 2 || rows[0].Value != 1 {
+		log.Fatal("Integral does not match expected")
+	}
+
+	// Undefined for a single data point
+	rows = aggregate("rate", false)
+	if len(rows) != 1 || math.Abs(rows[0].Value-2.0/1800) > 1e-12 {
+		log.Fatal("Rate does not match expected")
+	}
+
+	rows = aggregate("stddev", false)
+	if len(rows) != 1 || math.Abs(rows[0].Value-math.Sqrt2) > 1e-12 {
+		log.Fatal("Stddev does not match expected")
+	}
+
+	// The sum of a counter includes the increase from the bucket before, the delta does not
+	rows = aggregate("sum", true)
+	if len(rows) != 2 || rows[0].Value != 2 || rows[1].Value != 2 {
+		log.Fatal("Sum of a counter does not match expected")
+	}
+
+	rows = aggregate("delta", true)
+	if len(rows) != 2 || rows[0].Value != 2 || rows[1].Value != 0 {
+		log.Fatal("Delta of a counter does not match expected")
+	}
+}
+
+func TestCounterIncrease(t *testing.T) {
+	if counterIncrease(5, 8, 0) != 3 {
+		log.Fatal("Increase does not match expected")
+	}
+	if counterIncrease(8, 2, 0) != 2 {
+		log.Fatal("Increase after a reset does not match expected")
+	}
+	if counterIncrease(8, 2, 10) != 4 {
+		log.Fatal("Increase after a rollover does not match expected")
+	}
+}
+
+func TestPercentileCont(t *testing.T) {
+	if v := percentileCont([]float64{4, 1, 3, 2}, 0.5); v != 2.5 {
+		log.Fatal("Median does not match expected")
+	}
+	if v := percentileCont([]float64{0, 10}, 0.95); math.Abs(v-9.5) > 1e-12 {
+		log.Fatal("Percentile does not match expected")
+	}
+	if v := percentileCont([]float64{7}, 0.99); v != 7 {
+		log.Fatal("Percentile of a single value does not match expected")
+	}
+}
+
+func TestTsDataAggregatorOrder(t *testing.T) {
+	seq, err := newBucketSequence("1h", nil, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	a := uuid.New()
+	b := uuid.New()
+	start := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	rows := make([]postgres.GetTsDataRangeAggRow, 0)
+	agg := newTsDataAggregator(postgres.GetTsDataRangeAggParams{
+		Aggregate:      "count",
+		TsUuids:        []uuid.UUID{a, b},
+		ExcludeQuality: []int16{int16(QualityBad)},
+	}, seq, func(row postgres.GetTsDataRangeAggRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+
+	points := []aggPoint{
+		{id: a, ts: start, value: 1},
+		{id: b, ts: start.Add(10 * time.Minute), value: 1},
+		{id: a, ts: start.Add(70 * time.Minute), value: 1},
+		{id: a, ts: start.Add(80 * time.Minute), value: 1, quality: QualityBad},
+		{id: a, ts: start.Add(130 * time.Minute), value: 1},
+		{id: b, ts: start.Add(140 * time.Minute), value: 1},
+	}
+	for _, p := range points {
+		if err := agg.Push(p); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := agg.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	if len(rows) != 5 {
+		log.Fatal("Rows does not match expected")
+	}
+	for i := 1; i < len(rows); i++ {
+		if rows[i].Ts.Before(rows[i-1].Ts) {
+			log.Fatal("Rows are not in order of time")
+		}
+	}
+	for _, row := range rows {
+		if row.Value != 1 {
+			log.Fatal("Data point of an excluded quality was counted")
+		}
+	}
+}
@@ -0,0 +1,544 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"container/heap"
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/internal/tsarchive"
+	"github.com/self-host/self-host/postgres"
+)
+
+// ArchiveResult is the number of data points moved from tsdata of a time series, and the number of datasets holding them
+type ArchiveResult struct {
+	Uuid     uuid.UUID
+	Archived int64
+	Datasets int
+}
+
+// ArchiveTsData moves all data older than cutoff into datasets of the format tsarchive, one dataset per window of time.
+// The cutoff and the windows are whole hours, so that the rollups of archived hours stay complete.
+// Only time series where data was archived are part of the result.
+func (svc *TimeseriesService) ArchiveTsData(ctx context.Context, cutoff time.Time, window time.Duration) ([]ArchiveResult, error) {
+	cutoff = cutoff.Truncate(time.Hour)
+	window = window.Truncate(time.Hour)
+	if window < time.Hour {
+		window = time.Hour
+	}
+
+	series, err := svc.q.FindTimeseriesWithTsDataBefore(ctx, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ArchiveResult, 0)
+	for _, item := range series {
+		r := ArchiveResult{
+			Uuid: item.Uuid,
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+
+			first, err := svc.q.GetTsDataFirstTimestampBefore(ctx, postgres.GetTsDataFirstTimestampBeforeParams{
+				TsUuid: item.Uuid,
+				Before: cutoff,
+			})
+			if err == sql.ErrNoRows {
+				break
+			} else if err != nil {
+				return result, err
+			}
+
+			start := first.Truncate(time.Hour)
+			stop := start.Add(window)
+			if stop.After(cutoff) {
+				stop = cutoff
+			}
+
+			count, err := svc.archiveTsDataWindow(ctx, item.Uuid, item.Name, start, stop)
+			if err != nil {
+				return result, err
+			} else if count > 0 {
+				r.Archived += count
+				r.Datasets++
+			}
+		}
+
+		if r.Archived > 0 {
+			result = append(result, r)
+		}
+	}
+
+	return result, nil
+}
+
+// archiveTsDataWindow moves the data of a time series from start up to stop into a new dataset.
+// The data is deleted and archived in the same transaction. It holds the rollup lock of the time series,
+// so that a concurrent write either is archived as well or fails once it finds the archive.
+func (svc *TimeseriesService) archiveTsDataWindow(ctx context.Context, id uuid.UUID, name string, start, stop time.Time) (int64, error) {
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	if err := q.LockTsDataRollup(ctx, id); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	rows, err := q.DeleteTsDataRangeReturning(ctx, postgres.DeleteTsDataRangeReturningParams{
+		TsUuid: id,
+		Start:  start,
+		Stop:   stop.Add(-time.Microsecond),
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	} else if len(rows) == 0 {
+		tx.Rollback()
+		return 0, nil
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Ts.Before(rows[j].Ts)
+	})
+
+	points := make([]tsarchive.Point, len(rows))
+	for i, row := range rows {
+		points[i] = tsarchive.Point{
+			Ts:      row.Ts.UnixNano() / int64(time.Microsecond),
+			Value:   row.Value,
+			Quality: uint8(nullQuality(row.Quality)),
+		}
+	}
+
+	content, err := tsarchive.Encode(points)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	_, err = q.CreateTsDataArchive(ctx, postgres.CreateTsDataArchiveParams{
+		Name:    fmt.Sprintf("%v %v", name, start.UTC().Format(time.RFC3339)),
+		Content: content,
+		TsUuid:  id,
+		Start:   rows[0].Ts,
+		Stop:    rows[len(rows)-1].Ts,
+		Count:   int64(len(rows)),
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int64(len(rows)), nil
+}
+
+// rewriteArchives replaces the data points of every archive with the result of fn, which must keep them in order of time.
+// An archive left without data points is deleted. It returns the number of data points removed.
+func rewriteArchives(ctx context.Context, q *postgres.Queries, archives []postgres.FindTsDataArchivesRow, fn func([]tsarchive.Point) []tsarchive.Point) (int64, error) {
+	var removed int64
+	for _, archive := range archives {
+		content, err := q.GetTsDataArchiveContent(ctx, archive.DatasetUuid)
+		if err != nil {
+			return removed, err
+		}
+
+		points, err := tsarchive.Decode(content)
+		if err != nil {
+			return removed, fmt.Errorf("archive of timeseries %v from %v: %w", archive.TsUuid, archive.Start, err)
+		}
+
+		count := len(points)
+		points = fn(points)
+		removed += int64(count - len(points))
+
+		if len(points) == 0 {
+			if _, err := q.DeleteTsDataArchive(ctx, archive.DatasetUuid); err != nil {
+				return removed, err
+			}
+			continue
+		}
+
+		content, err = tsarchive.Encode(points)
+		if err != nil {
+			return removed, err
+		}
+
+		_, err = q.UpdateTsDataArchive(ctx, postgres.UpdateTsDataArchiveParams{
+			Content:     content,
+			DatasetUuid: archive.DatasetUuid,
+			Start:       time.Unix(0, points[0].Ts*int64(time.Microsecond)),
+			Stop:        time.Unix(0, points[len(points)-1].Ts*int64(time.Microsecond)),
+			Count:       int64(len(points)),
+		})
+		if err != nil {
+			return removed, err
+		}
+	}
+
+	return removed, nil
+}
+
+// deleteArchivedData deletes the archived data points matched by a delete of tsdata, and returns the number deleted
+func deleteArchivedData(ctx context.Context, q *postgres.Queries, p postgres.DeleteTsDataRangeParams) (int64, error) {
+	archives, err := q.FindTsDataArchives(ctx, postgres.FindTsDataArchivesParams{
+		TsUuids: p.TsUuids,
+		Start:   p.Start,
+		Stop:    p.Stop,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return rewriteArchives(ctx, q, archives, func(points []tsarchive.Point) []tsarchive.Point {
+		return deleteArchivedPoints(points, p)
+	})
+}
+
+// deleteArchivedPoints removes the data points matched by a delete of tsdata, keeping the order of the others
+func deleteArchivedPoints(points []tsarchive.Point, p postgres.DeleteTsDataRangeParams) []tsarchive.Point {
+	kept := points[:0]
+	for _, point := range points {
+		ts := time.Unix(0, point.Ts*int64(time.Microsecond))
+
+		if ts.Before(p.Start) || ts.After(p.Stop) ||
+			(p.GeNull == false && point.Value < p.Ge) ||
+			(p.LeNull == false && point.Value > p.Le) {
+			kept = append(kept, point)
+		}
+	}
+
+	return kept
+}
+
+// convertArchivedData converts every archived data point of a time series, for a change of unit
+func convertArchivedData(ctx context.Context, q *postgres.Queries, id uuid.UUID, c *linearConversion) error {
+	rows, err := q.FindTsDataArchivesByTimeseries(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	archives := make([]postgres.FindTsDataArchivesRow, len(rows))
+	for i, row := range rows {
+		archives[i] = postgres.FindTsDataArchivesRow(row)
+	}
+
+	_, err = rewriteArchives(ctx, q, archives, func(points []tsarchive.Point) []tsarchive.Point {
+		return convertArchivedPoints(points, c)
+	})
+
+	return err
+}
+
+// convertArchivedPoints converts the value of every data point in place
+func convertArchivedPoints(points []tsarchive.Point, c *linearConversion) []tsarchive.Point {
+	for i := range points {
+		points[i].Value = c.Apply(points[i].Value)
+	}
+
+	return points
+}
+
+// addArchivedRollups adds the archived data points from start up to stop to the rollups.
+// Use after the rollups of the range are recomputed from tsdata.
+func addArchivedRollups(ctx context.Context, q *postgres.Queries, archives []postgres.FindTsDataArchivesRow, start, stop time.Time) error {
+	for _, r := range newArchiveReaders(q, archives, start, stop.Add(-time.Microsecond)) {
+		params := postgres.AddTsDataRollupPartsParams{
+			TsUuid: r.id,
+		}
+
+		// Index of the current part of every width
+		last := make([]int, len(rollupWidths))
+		for i := range last {
+			last[i] = -1
+		}
+
+		for {
+			ok, err := r.Next(ctx)
+			if err != nil {
+				return err
+			} else if ok == false {
+				break
+			}
+
+			p := r.head
+			for i, width := range rollupWidths {
+				ts := p.ts.Truncate(width)
+
+				if j := last[i]; j >= 0 && params.Ts[j].Equal(ts) {
+					params.Counts[j]++
+					params.Sums[j] += p.value
+					params.Mins[j] = math.Min(params.Mins[j], p.value)
+					params.Maxs[j] = math.Max(params.Maxs[j], p.value)
+					if q := int16(p.quality); q > params.Qualities[j] {
+						params.Qualities[j] = q
+					}
+					continue
+				}
+
+				last[i] = len(params.Ts)
+				params.Widths = append(params.Widths, int32(width/time.Second))
+				params.Ts = append(params.Ts, ts)
+				params.Counts = append(params.Counts, 1)
+				params.Sums = append(params.Sums, p.value)
+				params.Mins = append(params.Mins, p.value)
+				params.Maxs = append(params.Maxs, p.value)
+				params.Qualities = append(params.Qualities, int16(p.quality))
+			}
+		}
+
+		if len(params.Ts) == 0 {
+			continue
+		}
+
+		if _, err := q.AddTsDataRollupParts(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// archiveReader reads the archived data points of a time series in order of time, decoding one archive at a time
+type archiveReader struct {
+	q        *postgres.Queries
+	id       uuid.UUID
+	archives []postgres.FindTsDataArchivesRow
+	start    time.Time
+	stop     time.Time
+
+	points []tsarchive.Point
+	// The data point read by the last call to Next
+	head aggPoint
+}
+
+// newArchiveReaders returns a reader per time series of archives, for the data points from start to stop, both inclusive.
+// The archives must be ordered by time series and start, like FindTsDataArchives.
+func newArchiveReaders(q *postgres.Queries, archives []postgres.FindTsDataArchivesRow, start, stop time.Time) []*archiveReader {
+	readers := make([]*archiveReader, 0)
+	for i, archive := range archives {
+		if i == 0 || archives[i-1].TsUuid != archive.TsUuid {
+			readers = append(readers, &archiveReader{
+				q:     q,
+				id:    archive.TsUuid,
+				start: start,
+				stop:  stop,
+			})
+		}
+
+		r := readers[len(readers)-1]
+		r.archives = append(r.archives, archive)
+	}
+
+	return readers
+}
+
+// Next reads the next data point into head, and reports false once there are no more
+func (r *archiveReader) Next(ctx context.Context) (bool, error) {
+	for {
+		for len(r.points) > 0 {
+			p := r.points[0]
+			r.points = r.points[1:]
+
+			ts := time.Unix(0, p.Ts*int64(time.Microsecond))
+			if ts.Before(r.start) {
+				continue
+			} else if ts.After(r.stop) {
+				r.points = nil
+				r.archives = nil
+				return false, nil
+			}
+
+			r.head = aggPoint{
+				id:      r.id,
+				ts:      ts,
+				value:   p.Value,
+				quality: Quality(p.Quality),
+			}
+			return true, nil
+		}
+
+		if len(r.archives) == 0 {
+			return false, nil
+		}
+
+		archive := r.archives[0]
+		r.archives = r.archives[1:]
+
+		content, err := r.q.GetTsDataArchiveContent(ctx, archive.DatasetUuid)
+		if err != nil {
+			return false, err
+		}
+
+		r.points, err = tsarchive.Decode(content)
+		if err != nil {
+			return false, fmt.Errorf("archive of timeseries %v from %v: %w", archive.TsUuid, archive.Start, err)
+		}
+	}
+}
+
+// archiveQueue orders the readers of several time series by their next data point
+type archiveQueue []*archiveReader
+
+func (h archiveQueue) Len() int            { return len(h) }
+func (h archiveQueue) Less(i, j int) bool  { return h[i].head.ts.Before(h[j].head.ts) }
+func (h archiveQueue) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *archiveQueue) Push(x interface{}) { *h = append(*h, x.(*archiveReader)) }
+func (h *archiveQueue) Pop() interface{} {
+	old := *h
+	r := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return r
+}
+
+// newArchiveQueue reads the first data point of every reader, readers without data points are left out
+func newArchiveQueue(ctx context.Context, readers []*archiveReader) (*archiveQueue, error) {
+	h := make(archiveQueue, 0, len(readers))
+	for _, r := range readers {
+		ok, err := r.Next(ctx)
+		if err != nil {
+			return nil, err
+		} else if ok {
+			h = append(h, r)
+		}
+	}
+	heap.Init(&h)
+
+	return &h, nil
+}
+
+// PushBefore passes the data points before ts to fn in order of time, every remaining one when ts is nil
+func (h *archiveQueue) PushBefore(ctx context.Context, ts *time.Time, fn func(aggPoint) error) error {
+	for h.Len() > 0 {
+		r := (*h)[0]
+		if ts != nil && r.head.ts.Before(*ts) == false {
+			return nil
+		}
+
+		if err := fn(r.head); err != nil {
+			return err
+		}
+
+		ok, err := r.Next(ctx)
+		if err != nil {
+			return err
+		} else if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	return nil
+}
+
+// forEachTsDataRangeAggArchived answers an aggregated query in Go, from the data points of tsdata merged in order of time
+// with the archived data points. A data point in both is read from tsdata.
+func (svc *TimeseriesService) forEachTsDataRangeAggArchived(ctx context.Context, params postgres.GetTsDataRangeAggParams, seq *bucketSequence, archives []postgres.FindTsDataArchivesRow, fn func(postgres.GetTsDataRangeAggRow) error) error {
+	agg := newTsDataAggregator(params, seq, fn)
+
+	queue, err := newArchiveQueue(ctx, newArchiveReaders(svc.q, archives, params.Start, params.Stop))
+	if err != nil {
+		return err
+	}
+
+	err = svc.q.ForEachTsDataRange(ctx, postgres.GetTsDataRangeParams{
+		TsUuids:        params.TsUuids,
+		Start:          params.Start,
+		Stop:           params.Stop,
+		ExcludeQuality: params.ExcludeQuality,
+	}, func(row postgres.GetTsDataRangeRow) error {
+		if err := queue.PushBefore(ctx, &row.Ts, agg.Push); err != nil {
+			return err
+		}
+
+		return agg.Push(aggPoint{
+			id:      row.TsUuid,
+			ts:      row.Ts,
+			value:   row.Value,
+			quality: nullQuality(row.Quality),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := queue.PushBefore(ctx, nil, agg.Push); err != nil {
+		return err
+	}
+
+	return agg.Close()
+}
+
+// withArchivedEdges adds the archived data points at the edges of a query answered from the rollups,
+// aggregated per bucket of seq. Rollups are kept when data is archived, only the edges are read from archives.
+func (svc *TimeseriesService) withArchivedEdges(ctx context.Context, params *postgres.GetTsDataRangeAggRollupParams, seq *bucketSequence, archives []postgres.FindTsDataArchivesRow) error {
+	edges := [][2]time.Time{
+		{params.Start, params.RollupStart.Add(-time.Microsecond)},
+		{params.RollupStop, params.Stop},
+	}
+
+	for _, edge := range edges {
+		if edge[1].Before(edge[0]) {
+			continue
+		}
+
+		for _, r := range newArchiveReaders(svc.q, archives, edge[0], edge[1]) {
+			var bucket time.Time
+			for n := 0; ; n++ {
+				ok, err := r.Next(ctx)
+				if err != nil {
+					return err
+				} else if ok == false {
+					break
+				}
+
+				p := r.head
+				last := len(params.ArchivedUuids) - 1
+
+				if start := seq.truncate(p.ts); n == 0 || start.Equal(bucket) == false {
+					// The part is at the time of its first data point, which is in the same bucket
+					bucket = start
+					params.ArchivedUuids = append(params.ArchivedUuids, p.id)
+					params.ArchivedTs = append(params.ArchivedTs, p.ts)
+					params.ArchivedCount = append(params.ArchivedCount, 1)
+					params.ArchivedSum = append(params.ArchivedSum, p.value)
+					params.ArchivedMin = append(params.ArchivedMin, p.value)
+					params.ArchivedMax = append(params.ArchivedMax, p.value)
+					params.ArchivedQuality = append(params.ArchivedQuality, int16(p.quality))
+					continue
+				}
+
+				params.ArchivedCount[last]++
+				params.ArchivedSum[last] += p.value
+				params.ArchivedMin[last] = math.Min(params.ArchivedMin[last], p.value)
+				params.ArchivedMax[last] = math.Max(params.ArchivedMax[last], p.value)
+				if q := int16(p.quality); q > params.ArchivedQuality[last] {
+					params.ArchivedQuality[last] = q
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/internal/tsarchive"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestDeleteArchivedPoints(t *testing.T) {
+	start := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	us := func(d time.Duration) int64 {
+		return start.Add(d).UnixNano() / int64(time.Microsecond)
+	}
+
+	points := []tsarchive.Point{
+		{Ts: us(0), Value: 1},
+		{Ts: us(time.Minute), Value: 5},
+		{Ts: us(2 * time.Minute), Value: 9},
+		{Ts: us(3 * time.Minute), Value: 5},
+	}
+
+	// Only values from 4 up to 6 within the first two minutes
+	kept := deleteArchivedPoints(points, postgres.DeleteTsDataRangeParams{
+		Start: start,
+		Stop:  start.Add(2 * time.Minute),
+		Ge:    4,
+		Le:    6,
+	})
+
+	if len(kept) != 3 || kept[0].Value != 1 || kept[1].Value != 9 || kept[2].Ts != us(3*time.Minute) {
+		log.Fatal("Kept points does not match expected")
+	}
+
+	kept = deleteArchivedPoints(kept, postgres.DeleteTsDataRangeParams{
+		Start:  start,
+		Stop:   start.Add(time.Hour),
+		GeNull: true,
+		LeNull: true,
+	})
+
+	if len(kept) != 0 {
+		log.Fatal("Kept points does not match expected")
+	}
+}
+
+func TestConvertArchivedPoints(t *testing.T) {
+	c, err := newLinearConversion("C", "F")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	points := convertArchivedPoints([]tsarchive.Point{
+		{Ts: 1, Value: 0},
+		{Ts: 2, Value: 100, Quality: uint8(QualityEstimated)},
+	}, c)
+
+	if math.Abs(points[0].Value-32) > 1e-9 || math.Abs(points[1].Value-212) > 1e-9 {
+		log.Fatal("Converted values does not match expected")
+	}
+	if points[1].Ts != 2 || points[1].Quality != uint8(QualityEstimated) {
+		log.Fatal("Converted point does not keep its time and quality")
+	}
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestArchiveConvertData(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyArchivedTimeseries",
+		SiUnit:    "C",
+		CreatedBy: uuid.MustParse("00000000-0000-1000-8000-000000000000"), // UUID for Root user
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID := uuid.MustParse(timeseries.Uuid)
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid: tsUUID,
+		Points: []DataPoint{
+			{Value: 10, Timestamp: start},
+			{Value: 20, Timestamp: start.Add(30 * time.Minute)},
+		},
+		CreatedBy: uuid.MustParse("00000000-0000-1000-8000-000000000000"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	archived, err := svc.ArchiveTsData(ctx, start.Add(24*time.Hour), 24*time.Hour)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	found := false
+	for _, r := range archived {
+		if r.Uuid == tsUUID && r.Archived == 2 {
+			found = true
+		}
+	}
+	if found == false {
+		log.Fatal("Data was not archived")
+	}
+
+	unit := "F"
+	_, err = svc.UpdateTimeseries(ctx, UpdateTimeseriesParams{
+		Uuid:        tsUUID,
+		SiUnit:      &unit,
+		ConvertData: true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The first value is not answered from the rollups, and so is read from the archive
+	rows, err := svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+		Uuid:      tsUUID,
+		Start:     start,
+		End:       start.Add(time.Hour),
+		Aggregate: "first",
+		Precision: "1h",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0].V == nil || math.Abs(float64(*rows[0].V)-50) > 1e-3 {
+		log.Fatal("Archived data was not converted")
+	}
+
+	rows, err = svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+		Uuid:      tsUUID,
+		Start:     start,
+		End:       start.Add(time.Hour),
+		Aggregate: "avg",
+		Precision: "1h",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0].V == nil || math.Abs(float64(*rows[0].V)-59) > 1e-3 {
+		log.Fatal("Rollups of archived data were not converted")
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, tsUUID); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+// Upper limit of a calendar bucket width, one millennium
+const maxBucketMonths = 12000
+
+var (
+	// ISO-8601 duration, for example P1M, P2W or PT7M
+	isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+	// One part of a Go style duration, extended with days, weeks, months and years. For example 3d or 1h30m
+	goDurationPartRegexp = regexp.MustCompile(`(\d+(?:\.\d+)?)(ns|us|µs|ms|mo|s|m|h|d|w|y)`)
+)
+
+// BucketWidth is the width of the buckets in an aggregated query.
+// A width is either a number of calendar months or a fixed duration, never both.
+type BucketWidth struct {
+	Months   int
+	Duration time.Duration
+
+	// Default origin, in wall clock time
+	origin time.Time
+}
+
+// Default origins, a Monday so that weeks start on Monday and the first of a year for calendar units
+var (
+	defaultFixedOrigin    = time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC)
+	defaultCalendarOrigin = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// Named precisions from before bucket widths could be arbitrary durations
+var namedBucketWidths = map[string]BucketWidth{
+	"microseconds": {Duration: time.Microsecond, origin: defaultFixedOrigin},
+	"milliseconds": {Duration: time.Millisecond, origin: defaultFixedOrigin},
+	"second":       {Duration: time.Second, origin: defaultFixedOrigin},
+	"minute":       {Duration: time.Minute, origin: defaultFixedOrigin},
+	"minute5":      {Duration: 5 * time.Minute, origin: defaultFixedOrigin},
+	"minute10":     {Duration: 10 * time.Minute, origin: defaultFixedOrigin},
+	"minute15":     {Duration: 15 * time.Minute, origin: defaultFixedOrigin},
+	"minute20":     {Duration: 20 * time.Minute, origin: defaultFixedOrigin},
+	"minute30":     {Duration: 30 * time.Minute, origin: defaultFixedOrigin},
+	"hour":         {Duration: time.Hour, origin: defaultFixedOrigin},
+	"day":          {Duration: 24 * time.Hour, origin: defaultFixedOrigin},
+	"week":         {Duration: 7 * 24 * time.Hour, origin: defaultFixedOrigin},
+	"month":        {Months: 1, origin: defaultCalendarOrigin},
+	"year":         {Months: 12, origin: defaultCalendarOrigin},
+	"decade":       {Months: 120, origin: defaultCalendarOrigin},
+	// Centuries and millennia start on year 1
+	"century":   {Months: 1200, origin: time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)},
+	"millennia": {Months: 12000, origin: time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)},
+}
+
+// ParseBucketWidth parses a named precision, an ISO-8601 duration (PT7M) or a Go style duration (2h, 3d).
+// Go style durations also accept the units d (day), w (week), mo (month) and y (year).
+func ParseBucketWidth(s string) (BucketWidth, error) {
+	if w, ok := namedBucketWidths[s]; ok {
+		return w, nil
+	}
+
+	var w BucketWidth
+	var err error
+	if strings.HasPrefix(s, "P") {
+		w, err = parseISODuration(s)
+	} else {
+		w, err = parseGoDuration(s)
+	}
+	if err != nil {
+		return BucketWidth{}, ie.NewBadRequestError(err)
+	}
+
+	switch {
+	case w.Months > 0 && w.Duration > 0:
+		return BucketWidth{}, ie.NewBadRequestError(fmt.Errorf("precision %v mixes calendar months and years with fixed units", s))
+	case w.Months > maxBucketMonths:
+		return BucketWidth{}, ie.NewBadRequestError(fmt.Errorf("precision %v exceeds %v months", s, maxBucketMonths))
+	case w.Months == 0 && w.Duration < time.Microsecond:
+		return BucketWidth{}, ie.NewBadRequestError(fmt.Errorf("precision %v is shorter than one microsecond", s))
+	case w.Duration%time.Microsecond != 0:
+		return BucketWidth{}, ie.NewBadRequestError(fmt.Errorf("precision %v is not a whole number of microseconds", s))
+	}
+
+	if w.Months > 0 {
+		w.origin = defaultCalendarOrigin
+	} else {
+		w.origin = defaultFixedOrigin
+	}
+
+	return w, nil
+}
+
+func parseISODuration(s string) (BucketWidth, error) {
+	m := isoDurationRegexp.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return BucketWidth{}, fmt.Errorf("precision %v is not a valid duration", s)
+	}
+
+	var w BucketWidth
+	atoi := func(v string) int {
+		if v == "" {
+			return 0
+		}
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+
+	w.Months = atoi(m[1])*12 + atoi(m[2])
+	w.Duration = time.Duration(atoi(m[3]))*7*24*time.Hour +
+		time.Duration(atoi(m[4]))*24*time.Hour +
+		time.Duration(atoi(m[5]))*time.Hour +
+		time.Duration(atoi(m[6]))*time.Minute
+
+	if m[7] != "" {
+		sec, err := strconv.ParseFloat(m[7], 64)
+		if err != nil {
+			return BucketWidth{}, fmt.Errorf("precision %v is not a valid duration", s)
+		}
+		w.Duration += time.Duration(sec * float64(time.Second))
+	}
+
+	return w, nil
+}
+
+func parseGoDuration(s string) (BucketWidth, error) {
+	parts := goDurationPartRegexp.FindAllStringSubmatch(s, -1)
+
+	var w BucketWidth
+	var length int
+	for _, part := range parts {
+		length += len(part[0])
+
+		switch part[2] {
+		case "mo", "y":
+			n, err := strconv.Atoi(part[1])
+			if err != nil {
+				return BucketWidth{}, fmt.Errorf("precision %v must use whole months and years", s)
+			}
+			if part[2] == "y" {
+				n *= 12
+			}
+			w.Months += n
+		case "d", "w":
+			n, err := strconv.ParseFloat(part[1], 64)
+			if err != nil {
+				return BucketWidth{}, fmt.Errorf("precision %v is not a valid duration", s)
+			}
+			unit := 24 * time.Hour
+			if part[2] == "w" {
+				unit *= 7
+			}
+			w.Duration += time.Duration(n * float64(unit))
+		default:
+			d, err := time.ParseDuration(part[0])
+			if err != nil {
+				return BucketWidth{}, fmt.Errorf("precision %v is not a valid duration", s)
+			}
+			w.Duration += d
+		}
+	}
+
+	if len(parts) == 0 || length != len(s) {
+		return BucketWidth{}, fmt.Errorf("precision %v is not a valid duration", s)
+	}
+
+	return w, nil
+}
+
+// bucketSequence locates buckets of a width counted from an origin, in the wall clock time of a time zone.
+// It mirrors the tsdata_bucket function used by GetTsDataRangeAgg.
+type bucketSequence struct {
+	width BucketWidth
+	loc   *time.Location
+	// Origin in wall clock time
+	origin time.Time
+}
+
+// newBucketSequence parses precision and anchors the buckets to origin, or to the default origin of the width when nil
+func newBucketSequence(precision string, origin *time.Time, loc *time.Location) (*bucketSequence, error) {
+	width, err := ParseBucketWidth(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bucketSequence{
+		width:  width,
+		loc:    loc,
+		origin: width.origin,
+	}
+
+	if origin != nil {
+		b.origin = wallClock(*origin, loc)
+	}
+
+	return b, nil
+}
+
+// Origin returns the origin as an absolute time
+func (b *bucketSequence) Origin() time.Time {
+	return fromWallClock(b.origin, b.loc)
+}
+
+// Months returns the width in calendar months, zero for fixed widths
+func (b *bucketSequence) Months() int32 {
+	return int32(b.width.Months)
+}
+
+// Microseconds returns the fixed width in microseconds, zero for calendar widths
+func (b *bucketSequence) Microseconds() int64 {
+	return int64(b.width.Duration / time.Microsecond)
+}
+
+// index returns the number of buckets between the origin and the bucket containing t
+func (b *bucketSequence) index(t time.Time) int64 {
+	wall := wallClock(t, b.loc)
+
+	if b.width.Months > 0 {
+		months := int64((wall.Year()-b.origin.Year())*12 + int(wall.Month()-b.origin.Month()))
+		i := floorDiv(months, int64(b.width.Months))
+		if addMonths(b.origin, int(i)*b.width.Months).After(wall) {
+			i--
+		}
+		return i
+	}
+
+	return floorDiv(int64(wall.Sub(b.origin)), int64(b.width.Duration))
+}
+
+// start returns the start of the bucket at index i
+func (b *bucketSequence) start(i int64) time.Time {
+	if b.width.Months > 0 {
+		return fromWallClock(addMonths(b.origin, int(i)*b.width.Months), b.loc)
+	}
+
+	return fromWallClock(b.origin.Add(time.Duration(i)*b.width.Duration), b.loc)
+}
+
+// truncate returns the start of the bucket containing t
+func (b *bucketSequence) truncate(t time.Time) time.Time {
+	return b.start(b.index(t))
+}
+
+// buckets returns the start of every bucket between start and end
+func (b *bucketSequence) buckets(start, end time.Time) ([]time.Time, error) {
+	list := make([]time.Time, 0)
+	for i := b.index(start); ; i++ {
+		t := b.start(i)
+		if t.After(end) {
+			break
+		}
+		if len(list) == maxFillBuckets {
+			return nil, ie.NewBadRequestError(fmt.Errorf("fill would generate more than %v buckets, use a coarser precision", maxFillBuckets))
+		}
+		list = append(list, t)
+	}
+
+	return list, nil
+}
+
+// wallClock returns the wall clock time of t in loc, expressed in UTC
+func wallClock(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// fromWallClock returns the time at the wall clock time w in loc
+func fromWallClock(w time.Time, loc *time.Location) time.Time {
+	return time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), loc)
+}
+
+// addMonths adds n months to t, clamping the day to the end of the month like PostgreSQL
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"testing"
+	"time"
+)
+
+func TestParseBucketWidth(t *testing.T) {
+	valid := map[string]BucketWidth{
+		"minute15": {Duration: 15 * time.Minute},
+		"PT7M":     {Duration: 7 * time.Minute},
+		"P1DT12H":  {Duration: 36 * time.Hour},
+		"P2W":      {Duration: 14 * 24 * time.Hour},
+		"P1Y6M":    {Months: 18},
+		"2h":       {Duration: 2 * time.Hour},
+		"1h30m":    {Duration: 90 * time.Minute},
+		"3d":       {Duration: 72 * time.Hour},
+		"6mo":      {Months: 6},
+		"1y":       {Months: 12},
+	}
+
+	for s, expected := range valid {
+		w, err := ParseBucketWidth(s)
+		if err != nil {
+			log.Fatal(s, err)
+		}
+		if w.Months != expected.Months || w.Duration != expected.Duration {
+			log.Fatalf("Width of %v does not match expected", s)
+		}
+	}
+
+	for _, s := range []string{"", "P", "PT", "P1M2D", "1mo2d", "2x", "h", "0s", "1ns", "1.5mo", "fortnight"} {
+		if _, err := ParseBucketWidth(s); err == nil {
+			log.Fatalf("Expected error for %v", s)
+		}
+	}
+}
+
+func TestBucketSequence(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Stockholm")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	start := time.Date(2021, 3, 1, 10, 7, 0, 0, time.UTC)
+
+	seq, err := newBucketSequence("minute15", nil, loc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buckets, err := seq.buckets(start, start.Add(time.Hour))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(buckets) != 5 || buckets[0].Equal(time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)) == false {
+		log.Fatal("Buckets does not match expected")
+	}
+
+	seq, err = newBucketSequence("1d", nil, loc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buckets, err = seq.buckets(start, start.Add(48*time.Hour))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Midnight in Stockholm is 23:00 UTC during winter time
+	if len(buckets) != 3 || buckets[0].Equal(time.Date(2021, 2, 28, 23, 0, 0, 0, time.UTC)) == false {
+		log.Fatal("Buckets does not match expected")
+	}
+
+	// Days follow the wall clock when daylight saving time starts
+	dst := time.Date(2021, 3, 28, 12, 0, 0, 0, time.UTC)
+	if seq.truncate(dst.Add(24*time.Hour)).Sub(seq.truncate(dst)) != 23*time.Hour {
+		log.Fatal("Day with daylight saving time does not match expected")
+	}
+
+	// Weeks start on Monday
+	seq, err = newBucketSequence("P1W", nil, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if seq.truncate(start).Equal(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)) == false {
+		log.Fatal("Week does not match expected")
+	}
+
+	// Buckets of seven minutes anchored to an origin
+	origin := time.Date(2021, 3, 1, 10, 1, 0, 0, time.UTC)
+	seq, err = newBucketSequence("PT7M", &origin, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if seq.truncate(start).Equal(origin) == false || seq.truncate(origin.Add(-time.Second)).Equal(origin.Add(-7*time.Minute)) == false {
+		log.Fatal("Seven minute bucket does not match expected")
+	}
+
+	// Quarters anchored to February, days past the end of a month are clamped
+	origin = time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	seq, err = newBucketSequence("3mo", &origin, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if seq.truncate(start).Equal(origin) == false || seq.truncate(time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)).Equal(time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)) == false {
+		log.Fatal("Quarter does not match expected")
+	}
+
+	if addMonths(time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC), 1).Equal(time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)) == false {
+		log.Fatal("Month addition does not match expected")
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+const overwriteDataInTimeseries = `
+WITH upsert AS (
+  INSERT INTO tsdata(ts_uuid, value, ts, created_by, quality)
+  SELECT $1::uuid, x.v, x.ts, $2::uuid, x.q
+  FROM
+  json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz, "q" smallint)
+  ON CONFLICT (ts_uuid, ts) DO UPDATE
+  SET value = EXCLUDED.value, created_by = EXCLUDED.created_by, quality = EXCLUDED.quality
+  RETURNING (xmax = 0) AS inserted
+)
+SELECT COUNT(*) FILTER (WHERE inserted) FROM upsert;
+`
+
+const (
+	ConflictError     = "error"
+	ConflictIgnore    = "ignore"
+	ConflictOverwrite = "overwrite"
+)
+
+// ConflictMode declares how data points with a timestamp that already exists are handled
+type ConflictMode string
+
+// ParseConflictMode parses one of "error", "ignore" or "overwrite". An empty string is returned as is.
+func ParseConflictMode(s string) (ConflictMode, error) {
+	switch s {
+	case "", ConflictError, ConflictIgnore, ConflictOverwrite:
+		return ConflictMode(s), nil
+	}
+
+	return "", ie.NewBadRequestError(fmt.Errorf("on_conflict must be one of error, ignore or overwrite"))
+}
+
+// OrDefault returns the mode, or def when no mode is set
+func (m ConflictMode) OrDefault(def ConflictMode) ConflictMode {
+	if m == "" {
+		return def
+	}
+	return m
+}
+
+// tsInsertCount is the outcome of inserting a batch of data points
+type tsInsertCount struct {
+	Inserted    int64
+	Duplicates  int64
+	Overwritten int64
+	// Left out for another data point of the batch with the same timestamp
+	Superseded int64
+}
+
+// addTo adds the counts to an insert result
+func (c tsInsertCount) addTo(result *rest.TsInsertResult) {
+	result.Accepted += c.Inserted
+	result.Duplicates += c.Duplicates
+	result.Overwritten += c.Overwritten
+	result.Superseded += c.Superseded
+}
+
+// insertTsData inserts a batch of data points into a time series, resolving existing timestamps by mode.
+// A timestamp that occurs more than once in the batch is an error, or is stored once as the mode would resolve it with
+// an existing timestamp; the first data point when ignoring, the last when overwriting.
+func insertTsData(ctx context.Context, tx *sql.Tx, id, createdBy uuid.UUID, points []DataPoint, mode ConflictMode) (tsInsertCount, error) {
+	var c tsInsertCount
+	if len(points) == 0 {
+		return c, nil
+	}
+
+	count := int64(len(points))
+	switch mode {
+	case ConflictOverwrite:
+		// A row can only be updated once per statement, the last point of a timestamp wins
+		points = lastPointPerTimestamp(points)
+	case ConflictIgnore:
+		points = firstPointPerTimestamp(points)
+	}
+	c.Superseded = count - int64(len(points))
+
+	data, err := json.Marshal(points)
+	if err != nil {
+		return c, err
+	}
+
+	switch mode {
+	case ConflictOverwrite:
+		if err := tx.QueryRowContext(ctx, overwriteDataInTimeseries, id, createdBy, data).Scan(&c.Inserted); err != nil {
+			return c, err
+		}
+		c.Overwritten = int64(len(points)) - c.Inserted
+	case ConflictIgnore:
+		res, err := tx.ExecContext(ctx, insertDataToTimeseriesSkipDuplicates, id, createdBy, data)
+		if err != nil {
+			return c, err
+		}
+		c.Inserted, err = res.RowsAffected()
+		if err != nil {
+			return c, err
+		}
+		c.Duplicates = int64(len(points)) - c.Inserted
+	default:
+		res, err := tx.ExecContext(ctx, insertDataToTimeseries, id, createdBy, data)
+		if err != nil {
+			return c, err
+		}
+		c.Inserted, err = res.RowsAffected()
+		if err != nil {
+			return c, err
+		}
+	}
+
+	return c, nil
+}
+
+// firstPointPerTimestamp removes all but the first point of every timestamp, keeping the order
+func firstPointPerTimestamp(points []DataPoint) []DataPoint {
+	seen := make(map[int64]bool, len(points))
+	result := make([]DataPoint, 0, len(points))
+	for _, p := range points {
+		if seen[p.Timestamp.UnixNano()] == false {
+			seen[p.Timestamp.UnixNano()] = true
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+// lastPointPerTimestamp removes all but the last point of every timestamp, keeping the order
+func lastPointPerTimestamp(points []DataPoint) []DataPoint {
+	last := make(map[int64]int, len(points))
+	for i, p := range points {
+		last[p.Timestamp.UnixNano()] = i
+	}
+
+	if len(last) == len(points) {
+		return points
+	}
+
+	result := make([]DataPoint, 0, len(last))
+	for i, p := range points {
+		if last[p.Timestamp.UnixNano()] == i {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+)
+
+func TestParseConflictMode(t *testing.T) {
+	for _, s := range []string{"", "error", "ignore", "overwrite"} {
+		m, err := ParseConflictMode(s)
+		if err != nil {
+			log.Fatal(s, err)
+		}
+		if string(m) != s {
+			log.Fatalf("Conflict mode %v does not match expected", s)
+		}
+	}
+
+	if _, err := ParseConflictMode("replace"); err == nil {
+		log.Fatal("Expected error for replace")
+	}
+
+	if ConflictMode("").OrDefault(ConflictIgnore) != ConflictIgnore || ConflictMode(ConflictOverwrite).OrDefault(ConflictIgnore) != ConflictOverwrite {
+		log.Fatal("Default conflict mode does not match expected")
+	}
+}
+
+func TestLastPointPerTimestamp(t *testing.T) {
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	points := []DataPoint{
+		{Value: 1, Timestamp: t0},
+		{Value: 2, Timestamp: t0.Add(time.Minute)},
+		{Value: 3, Timestamp: t0},
+	}
+
+	result := lastPointPerTimestamp(points)
+	if len(result) != 2 {
+		log.Fatal("Duplicate timestamps were not removed")
+	}
+	if result[0].Value != 2 || result[1].Value != 3 {
+		log.Fatal("The last point of a timestamp was not kept")
+	}
+}
+
+func TestFirstPointPerTimestamp(t *testing.T) {
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	points := []DataPoint{
+		{Value: 1, Timestamp: t0},
+		{Value: 2, Timestamp: t0.Add(time.Minute)},
+		{Value: 3, Timestamp: t0},
+	}
+
+	result := firstPointPerTimestamp(points)
+	if len(result) != 2 {
+		log.Fatal("Duplicate timestamps were not removed")
+	}
+	if result[0].Value != 1 || result[1].Value != 2 {
+		log.Fatal("The first point of a timestamp was not kept")
+	}
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestInsertConflictModes(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+	createdBy := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyConflictTimeseries",
+		SiUnit:    "C",
+		CreatedBy: createdBy,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID := uuid.MustParse(timeseries.Uuid)
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	minute := func(n int) time.Time {
+		return t0.Add(time.Duration(n) * time.Minute)
+	}
+
+	insert := func(mode ConflictMode, points ...DataPoint) (*rest.TsInsertResult, error) {
+		return svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+			Uuid:       tsUUID,
+			Points:     points,
+			CreatedBy:  createdBy,
+			OnConflict: mode,
+		})
+	}
+
+	result, err := insert(ConflictError, DataPoint{Value: 1, Timestamp: minute(0)}, DataPoint{Value: 2, Timestamp: minute(1)})
+	if err != nil {
+		log.Fatal(err)
+	} else if result.Accepted != 2 {
+		log.Fatal("Result of error mode does not match expected")
+	}
+
+	// An existing timestamp, or one that occurs twice, fails the request and stores nothing
+	if _, err := insert(ConflictError, DataPoint{Value: 5, Timestamp: minute(1)}, DataPoint{Value: 5, Timestamp: minute(5)}); err == nil {
+		log.Fatal("Expected error for existing timestamp")
+	}
+	if _, err := insert(ConflictError, DataPoint{Value: 5, Timestamp: minute(5)}, DataPoint{Value: 6, Timestamp: minute(5)}); err == nil {
+		log.Fatal("Expected error for repeated timestamp")
+	}
+
+	// The first point of a repeated timestamp is stored
+	result, err = insert(ConflictIgnore,
+		DataPoint{Value: 5, Timestamp: minute(1)},
+		DataPoint{Value: 3, Timestamp: minute(2)},
+		DataPoint{Value: 4, Timestamp: minute(2)},
+	)
+	if err != nil {
+		log.Fatal(err)
+	} else if result.Accepted != 1 || result.Duplicates != 1 || result.Superseded != 1 {
+		log.Fatal("Result of ignore mode does not match expected")
+	}
+
+	// The last point of a repeated timestamp is stored
+	result, err = insert(ConflictOverwrite,
+		DataPoint{Value: 7, Timestamp: minute(1)},
+		DataPoint{Value: 1, Timestamp: minute(3)},
+		DataPoint{Value: 9, Timestamp: minute(3)},
+	)
+	if err != nil {
+		log.Fatal(err)
+	} else if result.Accepted != 1 || result.Overwritten != 1 || result.Superseded != 1 {
+		log.Fatal("Result of overwrite mode does not match expected")
+	}
+
+	rows, err := svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+		Uuid:      tsUUID,
+		Start:     t0,
+		End:       t0.Add(time.Hour),
+		Aggregate: "sum",
+		Precision: "1h",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// 1 + 7 + 3 + 9
+	if len(rows) != 1 || rows[0].V == nil || *rows[0].V != 20 {
+		log.Fatal("Stored data does not match expected")
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, tsUUID); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+// Upper limit of buckets generated when filling gaps, one year of one minute buckets
+const maxFillBuckets = 366*24*60 + 1
+
+const (
+	FillNone     = "none"
+	FillNull     = "null"
+	FillPrevious = "previous"
+	FillLinear   = "linear"
+	FillConstant = "constant"
+)
+
+// FillMode declares how buckets without data are filled in an aggregated query
+type FillMode struct {
+	Mode  string
+	Value float32
+}
+
+// ParseFillMode parses one of "none", "null", "previous", "linear" or a constant number
+func ParseFillMode(s string) (FillMode, error) {
+	switch s {
+	case "", FillNone:
+		return FillMode{Mode: FillNone}, nil
+	case FillNull, FillPrevious, FillLinear:
+		return FillMode{Mode: s}, nil
+	}
+
+	v, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return FillMode{}, ie.NewBadRequestError(fmt.Errorf("fill must be one of none, null, previous, linear or a number"))
+	}
+
+	return FillMode{Mode: FillConstant, Value: float32(v)}, nil
+}
+
+// Enabled reports if gaps should be filled
+func (m FillMode) Enabled() bool {
+	return m.Mode != "" && m.Mode != FillNone
+}
+
+// wideFiller fills the gaps of wide rows before passing them on, in order.
+// All timestamps from buckets are emitted, together with any other timestamp pushed.
+type wideFiller struct {
+	mode    FillMode
+	columns int
+	buckets []time.Time
+	emit    func(TsWideRow) error
+
+	// Rows held back until a later value makes linear interpolation possible
+	pending []TsWideRow
+	// Absolute index of the first row in pending
+	offset int
+	// Last known value, timestamp and absolute row index per column
+	last    []*float32
+	lastTs  []time.Time
+	lastIdx []int
+}
+
+func newWideFiller(mode FillMode, columns int, buckets []time.Time, emit func(TsWideRow) error) *wideFiller {
+	f := &wideFiller{
+		mode:    mode,
+		columns: columns,
+		buckets: buckets,
+		emit:    emit,
+		pending: make([]TsWideRow, 0),
+		last:    make([]*float32, columns),
+		lastTs:  make([]time.Time, columns),
+		lastIdx: make([]int, columns),
+	}
+
+	for i := range f.lastIdx {
+		f.lastIdx[i] = -1
+	}
+
+	return f
+}
+
+// Push adds a row with data, rows must be pushed in timestamp order
+func (f *wideFiller) Push(row TsWideRow) error {
+	for len(f.buckets) > 0 && f.buckets[0].Before(row.Ts) {
+		if err := f.add(TsWideRow{
+			Ts:     f.buckets[0],
+			Values: make([]*float32, f.columns),
+		}); err != nil {
+			return err
+		}
+		f.buckets = f.buckets[1:]
+	}
+
+	if len(f.buckets) > 0 && f.buckets[0].Equal(row.Ts) {
+		f.buckets = f.buckets[1:]
+	}
+
+	return f.add(row)
+}
+
+// Close emits all remaining buckets and held back rows
+func (f *wideFiller) Close() error {
+	for _, ts := range f.buckets {
+		if err := f.add(TsWideRow{
+			Ts:     ts,
+			Values: make([]*float32, f.columns),
+		}); err != nil {
+			return err
+		}
+	}
+	f.buckets = nil
+
+	for _, row := range f.pending {
+		if err := f.emit(row); err != nil {
+			return err
+		}
+	}
+	f.pending = nil
+
+	return nil
+}
+
+func (f *wideFiller) add(row TsWideRow) error {
+	switch f.mode.Mode {
+	case FillNull:
+	case FillConstant:
+		for i, v := range row.Values {
+			if v == nil {
+				c := f.mode.Value
+				row.Values[i] = &c
+			}
+		}
+	case FillPrevious:
+		for i, v := range row.Values {
+			if v == nil {
+				row.Values[i] = f.last[i]
+			} else {
+				f.last[i] = v
+			}
+		}
+	case FillLinear:
+		return f.addLinear(row)
+	}
+
+	return f.emit(row)
+}
+
+func (f *wideFiller) addLinear(row TsWideRow) error {
+	index := f.offset + len(f.pending)
+	f.pending = append(f.pending, row)
+
+	for c, v := range row.Values {
+		if v == nil {
+			continue
+		}
+
+		// Interpolate the held back rows between the previous value and this one
+		if f.last[c] != nil {
+			prev := *f.last[c]
+			span := row.Ts.Sub(f.lastTs[c]).Seconds()
+
+			for i := f.lastIdx[c] + 1; i < index; i++ {
+				if i < f.offset {
+					continue
+				}
+				p := &f.pending[i-f.offset]
+				ratio := p.Ts.Sub(f.lastTs[c]).Seconds() / span
+				iv := prev + float32(ratio)*(*v-prev)
+				p.Values[c] = &iv
+			}
+		}
+
+		f.last[c] = v
+		f.lastTs[c] = row.Ts
+		f.lastIdx[c] = index
+	}
+
+	// Emit rows where no column waits for a later value
+	for len(f.pending) > 0 {
+		front := f.pending[0]
+		for c, v := range front.Values {
+			if v == nil && f.last[c] != nil && f.lastIdx[c] < f.offset {
+				return nil
+			}
+		}
+
+		if err := f.emit(front); err != nil {
+			return err
+		}
+		f.pending = f.pending[1:]
+		f.offset++
+	}
+
+	return nil
+}
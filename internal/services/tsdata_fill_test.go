@@ -0,0 +1,77 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"testing"
+	"time"
+)
+
+func fillSeries(mode FillMode, buckets []time.Time, rows []TsWideRow) []TsWideRow {
+	result := make([]TsWideRow, 0)
+	filler := newWideFiller(mode, 1, buckets, func(row TsWideRow) error {
+		result = append(result, row)
+		return nil
+	})
+
+	for _, row := range rows {
+		if err := filler.Push(row); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if err := filler.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	return result
+}
+
+func TestWideFiller(t *testing.T) {
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	buckets := []time.Time{t0, t0.Add(time.Hour), t0.Add(2 * time.Hour), t0.Add(3 * time.Hour), t0.Add(4 * time.Hour)}
+
+	v1 := float32(10)
+	v4 := float32(40)
+	rows := []TsWideRow{
+		{Ts: buckets[1], Values: []*float32{&v1}},
+		{Ts: buckets[4], Values: []*float32{&v4}},
+	}
+
+	copyRows := func() []TsWideRow {
+		c := make([]TsWideRow, len(rows))
+		for i, row := range rows {
+			c[i] = TsWideRow{Ts: row.Ts, Values: append([]*float32{}, row.Values...)}
+		}
+		return c
+	}
+
+	result := fillSeries(FillMode{Mode: FillNull}, buckets, copyRows())
+	if len(result) != 5 || result[0].Values[0] != nil || result[2].Values[0] != nil || *result[4].Values[0] != 40 {
+		log.Fatal("Null fill does not match expected")
+	}
+
+	result = fillSeries(FillMode{Mode: FillConstant, Value: -1}, buckets, copyRows())
+	if *result[0].Values[0] != -1 || *result[3].Values[0] != -1 || *result[1].Values[0] != 10 {
+		log.Fatal("Constant fill does not match expected")
+	}
+
+	result = fillSeries(FillMode{Mode: FillPrevious}, buckets, copyRows())
+	if result[0].Values[0] != nil || *result[2].Values[0] != 10 || *result[3].Values[0] != 10 {
+		log.Fatal("Previous fill does not match expected")
+	}
+
+	result = fillSeries(FillMode{Mode: FillLinear}, buckets, copyRows())
+	if len(result) != 5 || result[0].Values[0] != nil || *result[2].Values[0] != 20 || *result[3].Values[0] != 30 {
+		log.Fatal("Linear fill does not match expected")
+	}
+
+	for i, row := range result {
+		if row.Ts.Equal(buckets[i]) == false {
+			log.Fatal("Rows are out of order")
+		}
+	}
+}
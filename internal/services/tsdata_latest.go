@@ -0,0 +1,281 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+const (
+	// Maximum number of data points per time series returned by FindLatestTsData
+	latestMaxCount = 100
+
+	// Maximum number of time series kept in the latest value cache of a database
+	latestCacheSize = 100000
+)
+
+// latestEntry holds the newest data points of a time series, at a version of its data
+type latestEntry struct {
+	version int64
+	// Newest first
+	points []DataPoint
+	// Set when points holds every data point of the time series
+	complete bool
+}
+
+// covers reports if the entry holds the newest count data points of the time series
+func (e *latestEntry) covers(count int) bool {
+	return e.complete || len(e.points) >= count
+}
+
+// latestCache holds the newest data points of the time series in one database.
+//
+// The version of the data of each time series is maintained by a trigger on tsdata (see tsdata_version).
+// An entry is only used while its version matches the version in the database, which keeps
+// the cache correct when several instances write to the same database.
+type latestCache struct {
+	mux     sync.Mutex
+	entries map[uuid.UUID]*latestEntry
+}
+
+var (
+	latestCaches    = make(map[*sql.DB]*latestCache)
+	latestCachesMux sync.Mutex
+)
+
+// latestCacheFor returns the latest value cache of a database
+func latestCacheFor(db *sql.DB) *latestCache {
+	latestCachesMux.Lock()
+	defer latestCachesMux.Unlock()
+
+	c, ok := latestCaches[db]
+	if ok == false {
+		c = &latestCache{
+			entries: make(map[uuid.UUID]*latestEntry),
+		}
+		latestCaches[db] = c
+	}
+
+	return c
+}
+
+// get returns the entry of a time series if it is at version and covers count data points
+func (c *latestCache) get(id uuid.UUID, version int64, count int) (*latestEntry, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	e, ok := c.entries[id]
+	if ok == false || e.version != version || e.covers(count) == false {
+		return nil, false
+	}
+
+	return e, true
+}
+
+// depth returns the number of data points cached for a time series, zero when it is not cached
+func (c *latestCache) depth(id uuid.UUID) int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if e, ok := c.entries[id]; ok {
+		return len(e.points)
+	}
+
+	return 0
+}
+
+func (c *latestCache) put(id uuid.UUID, e *latestEntry) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	// A slower reader may have loaded an older version than the one already cached
+	if prev, ok := c.entries[id]; ok && prev.version > e.version {
+		return
+	}
+
+	if _, ok := c.entries[id]; ok == false && len(c.entries) >= latestCacheSize {
+		// Evict an arbitrary entry, it is reloaded on its next use
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+
+	c.entries[id] = e
+}
+
+func (c *latestCache) remove(ids ...uuid.UUID) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for _, id := range ids {
+		delete(c.entries, id)
+	}
+}
+
+type FindLatestTsDataParams struct {
+	Uuids []uuid.UUID
+	// Number of data points per time series
+	Count int
+}
+
+// FindLatestTsData returns the newest data points of every time series, newest first.
+// The results are in the same order as the uuids.
+func (svc *TimeseriesService) FindLatestTsData(ctx context.Context, p FindLatestTsDataParams) ([]*rest.TsResults, error) {
+	if p.Count < 1 || p.Count > latestMaxCount {
+		return nil, ie.NewBadRequestError(fmt.Errorf("count must be between 1 and %v", latestMaxCount))
+	}
+
+	series, entries, err := svc.loadLatest(ctx, p.Uuids, p.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rest.TsResults, len(p.Uuids))
+	for i, id := range p.Uuids {
+		s := series[id]
+		result[i] = &rest.TsResults{
+			Uuid: id.String(),
+			Unit: s.SiUnit,
+			Data: make([]rest.TsRow, 0),
+		}
+
+		if s.Derived {
+			msg := errMsgDerivedNoData
+			result[i].Error = &msg
+			continue
+		}
+
+		points := entries[id].points
+		if len(points) > p.Count {
+			points = points[:p.Count]
+		}
+
+		for _, point := range points {
+			v := float32(point.Value)
+			result[i].Data = append(result[i].Data, rest.TsRow{
+				V:  &v,
+				Ts: point.Timestamp,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// loadLatest returns the newest count data points of every time series that is not derived,
+// from the cache where it is up to date and from the database otherwise.
+func (svc *TimeseriesService) loadLatest(ctx context.Context, uuids []uuid.UUID, count int) (map[uuid.UUID]postgres.GetTsDataVersionsRow, map[uuid.UUID]*latestEntry, error) {
+	// The versions and data points must be read from the same snapshot
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	q := svc.q.WithTx(tx)
+
+	versions, err := q.GetTsDataVersions(ctx, uuids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	series := make(map[uuid.UUID]postgres.GetTsDataVersionsRow, len(versions))
+	for _, item := range versions {
+		series[item.Uuid] = item
+	}
+
+	for _, id := range uuids {
+		if _, ok := series[id]; ok == false {
+			return nil, nil, sql.ErrNoRows
+		}
+	}
+
+	cache := latestCacheFor(svc.db)
+	entries := make(map[uuid.UUID]*latestEntry, len(versions))
+	misses := make([]uuid.UUID, 0)
+	for _, item := range versions {
+		if item.Derived {
+			continue
+		}
+
+		if e, ok := cache.get(item.Uuid, item.Version, count); ok {
+			entries[item.Uuid] = e
+		} else {
+			entries[item.Uuid] = &latestEntry{
+				version: item.Version,
+				points:  make([]DataPoint, 0, count),
+			}
+			misses = append(misses, item.Uuid)
+		}
+	}
+
+	if len(misses) == 0 {
+		return series, entries, nil
+	}
+
+	rows, err := q.GetTsDataLatest(ctx, postgres.GetTsDataLatestParams{
+		TsUuids:  misses,
+		ArgLimit: int64(count),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, row := range rows {
+		e := entries[row.TsUuid]
+		e.points = append(e.points, DataPoint{
+			Value:     row.Value,
+			Timestamp: row.Ts,
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, id := range misses {
+		e := entries[id]
+		e.complete = len(e.points) < count
+		cache.put(id, e)
+	}
+
+	return series, entries, nil
+}
+
+// refreshLatest reloads the cached data points of the time series after new data is committed.
+// Time series that are not cached are left alone.
+func (svc *TimeseriesService) refreshLatest(ctx context.Context, ids ...uuid.UUID) {
+	cache := latestCacheFor(svc.db)
+
+	cached := make([]uuid.UUID, 0, len(ids))
+	depth := 0
+	for _, id := range ids {
+		if d := cache.depth(id); d > 0 {
+			cached = append(cached, id)
+			if d > depth {
+				depth = d
+			}
+		}
+	}
+
+	if len(cached) == 0 {
+		return
+	}
+
+	// The data is committed already, a failed reload only means that the entries are reloaded on their next use
+	if _, _, err := svc.loadLatest(ctx, cached, depth); err != nil {
+		cache.remove(cached...)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestLatestCache(t *testing.T) {
+	c := latestCacheFor(&sql.DB{})
+	id := uuid.New()
+
+	if _, ok := c.get(id, 0, 1); ok {
+		log.Fatal("Empty cache should not have an entry")
+	}
+
+	c.put(id, &latestEntry{
+		version: 2,
+		points: []DataPoint{
+			{Value: 2, Timestamp: time.Unix(2, 0)},
+			{Value: 1, Timestamp: time.Unix(1, 0)},
+		},
+	})
+
+	if _, ok := c.get(id, 2, 2); ok == false {
+		log.Fatal("Entry at the same version should be used")
+	}
+	if _, ok := c.get(id, 3, 1); ok {
+		log.Fatal("Entry at an older version should not be used")
+	}
+	if _, ok := c.get(id, 2, 3); ok {
+		log.Fatal("Entry with too few points should not be used")
+	}
+
+	// A slower reader must not replace a newer entry
+	c.put(id, &latestEntry{version: 1, complete: true})
+	if e, ok := c.get(id, 2, 1); ok == false || len(e.points) != 2 {
+		log.Fatal("Newer entry was replaced by an older version")
+	}
+
+	// A complete entry covers any count
+	c.put(id, &latestEntry{version: 3, complete: true})
+	if _, ok := c.get(id, 3, 100); ok == false {
+		log.Fatal("Complete entry should cover any count")
+	}
+
+	if c.depth(id) != 0 {
+		log.Fatal("Depth does not match expected")
+	}
+
+	c.remove(id)
+	if _, ok := c.get(id, 3, 1); ok {
+		log.Fatal("Removed entry should not be used")
+	}
+}
+
+// latestValues returns the values of the newest data points of a time series
+func latestValues(svc *TimeseriesService, id uuid.UUID, count int) []float32 {
+	result, err := svc.FindLatestTsData(context.Background(), FindLatestTsDataParams{
+		Uuids: []uuid.UUID{id},
+		Count: count,
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if len(result) != 1 {
+		log.Fatal("Result does not match expected")
+	}
+
+	values := make([]float32, 0, len(result[0].Data))
+	for _, row := range result[0].Data {
+		values = append(values, *row.V)
+	}
+
+	return values
+}
+
+// tsdataVersion returns the version of the data of a time series
+func tsdataVersion(id uuid.UUID) int64 {
+	versions, err := postgres.New(db).GetTsDataVersions(context.Background(), []uuid.UUID{id})
+	if err != nil {
+		log.Fatal(err)
+	} else if len(versions) != 1 {
+		log.Fatal("Time series has no version")
+	}
+
+	return versions[0].Version
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestLatestTsData(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyLatestTimeseries",
+		SiUnit:    "C",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	id := uuid.MustParse(timeseries.Uuid)
+	start := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	if values := latestValues(svc, id, 2); len(values) != 0 {
+		log.Fatal("Time series without data should have no latest values")
+	}
+	if _, err := svc.FindLatestTsData(ctx, FindLatestTsDataParams{Uuids: []uuid.UUID{id}, Count: 0}); err == nil {
+		log.Fatal("Count of zero should fail")
+	}
+
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid: id,
+		Points: []DataPoint{
+			{Value: 1, Timestamp: start},
+			{Value: 2, Timestamp: start.Add(time.Minute)},
+			{Value: 3, Timestamp: start.Add(2 * time.Minute)},
+		},
+		CreatedBy: rootUUID,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if values := latestValues(svc, id, 2); len(values) != 2 || values[0] != 3 || values[1] != 2 {
+		log.Fatalf("Latest values do not match expected: %v", values)
+	}
+	if values := latestValues(svc, id, 10); len(values) != 3 || values[2] != 1 {
+		log.Fatalf("Latest values do not match expected: %v", values)
+	}
+
+	// A write from another instance bypasses the cache of this one, only the version tells of it
+	version := tsdataVersion(id)
+	_, err = postgres.New(db).CreateTsData(ctx, postgres.CreateTsDataParams{
+		TsUuid:    id,
+		Value:     4,
+		Ts:        start.Add(3 * time.Minute),
+		CreatedBy: rootUUID,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if tsdataVersion(id) <= version {
+		log.Fatal("Write did not change the version of the data")
+	}
+
+	if values := latestValues(svc, id, 2); len(values) != 2 || values[0] != 4 || values[1] != 3 {
+		log.Fatalf("Latest values after a write do not match expected: %v", values)
+	}
+
+	// Deletes change the version as well
+	version = tsdataVersion(id)
+	_, err = svc.DeleteTsData(ctx, DeleteTsDataParams{
+		Uuid:  id,
+		Start: start.Add(2 * time.Minute),
+		End:   start.Add(3 * time.Minute),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if tsdataVersion(id) <= version {
+		log.Fatal("Delete did not change the version of the data")
+	}
+
+	if values := latestValues(svc, id, 10); len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		log.Fatalf("Latest values after a delete do not match expected: %v", values)
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, id); err != nil {
+		log.Fatal(err)
+	}
+}
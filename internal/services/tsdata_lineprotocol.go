@@ -0,0 +1,390 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+const (
+	// Prefix of the time series tag that maps a line protocol series key to a time series
+	lineProtocolTagPrefix = "influx:"
+
+	// Longest line accepted in line protocol
+	lineProtocolMaxLine = 1024 * 1024
+)
+
+// LineProtocolPoint is the data point of one field of a line in InfluxDB line protocol
+type LineProtocolPoint struct {
+	// Series key of the field: measurement, tag set sorted by key and field key, for example "cpu,host=a usage_idle"
+	Key   string
+	Point DataPoint
+}
+
+// lineProtocolPrecision returns the unit of the timestamps for a precision
+func lineProtocolPrecision(s string) (time.Duration, error) {
+	switch s {
+	case "", "n", "ns":
+		return time.Nanosecond, nil
+	case "u", "us":
+		return time.Microsecond, nil
+	case "ms":
+		return time.Millisecond, nil
+	case "s":
+		return time.Second, nil
+	case "m":
+		return time.Minute, nil
+	case "h":
+		return time.Hour, nil
+	}
+	return 0, ie.NewBadRequestError(fmt.Errorf("precision must be one of ns, us, ms, s, m or h"))
+}
+
+// lineProtocolTime converts a timestamp in the unit of the precision to a time
+func lineProtocolTime(ts int64, unit time.Duration) time.Time {
+	if unit >= time.Second {
+		return time.Unix(ts*int64(unit/time.Second), 0)
+	}
+	perSecond := int64(time.Second / unit)
+	return time.Unix(ts/perSecond, (ts%perSecond)*int64(unit))
+}
+
+// ParseLineProtocol parses data points written in InfluxDB line protocol.
+// Integer and boolean fields are converted to numbers, fields with string values are skipped.
+// Lines without a timestamp are given the time now.
+func ParseLineProtocol(r io.Reader, precision string, now time.Time) ([]LineProtocolPoint, error) {
+	unit, err := lineProtocolPrecision(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), lineProtocolMaxLine)
+
+	points := make([]LineProtocolPoint, 0)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		points, err = parseLineProtocolLine(points, text, unit, now)
+		if err != nil {
+			return nil, ie.NewBadRequestError(fmt.Errorf("line %d: %v", line, err))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, ie.NewBadRequestError(fmt.Errorf("line %d: %v", line+1, err))
+	}
+
+	return points, nil
+}
+
+// parseLineProtocolLine appends the data points of one line to points
+func parseLineProtocolLine(points []LineProtocolPoint, text string, unit time.Duration, now time.Time) ([]LineProtocolPoint, error) {
+	measurement, i := scanLineProtocolToken(text, 0, ", ")
+	if measurement == "" {
+		return nil, fmt.Errorf("missing measurement")
+	}
+
+	tags := make([][2]string, 0)
+	for i < len(text) && text[i] == ',' {
+		var key, value string
+		key, i = scanLineProtocolToken(text, i+1, "=, ")
+		if i >= len(text) || text[i] != '=' {
+			return nil, fmt.Errorf("missing tag value")
+		}
+		value, i = scanLineProtocolToken(text, i+1, ", ")
+		if key == "" || value == "" {
+			return nil, fmt.Errorf("invalid tag")
+		}
+		tags = append(tags, [2]string{key, value})
+	}
+
+	if i >= len(text) || text[i] != ' ' {
+		return nil, fmt.Errorf("missing fields")
+	}
+
+	sort.SliceStable(tags, func(a, b int) bool {
+		return tags[a][0] < tags[b][0]
+	})
+
+	var series strings.Builder
+	series.WriteString(escapeLineProtocol(measurement))
+	for _, tag := range tags {
+		series.WriteString(",")
+		series.WriteString(escapeLineProtocol(tag[0]))
+		series.WriteString("=")
+		series.WriteString(escapeLineProtocol(tag[1]))
+	}
+	series.WriteString(" ")
+
+	type field struct {
+		key   string
+		value float64
+		ok    bool
+	}
+
+	fields := make([]field, 0)
+	for {
+		var key string
+		key, i = scanLineProtocolToken(text, i+1, "=, ")
+		if key == "" || i >= len(text) || text[i] != '=' {
+			return nil, fmt.Errorf("invalid field")
+		}
+
+		i++
+		f := field{key: key}
+		if i < len(text) && text[i] == '"' {
+			// String values can not be stored in a time series
+			end, err := skipLineProtocolString(text, i)
+			if err != nil {
+				return nil, err
+			}
+			i = end
+		} else {
+			var raw string
+			raw, i = scanLineProtocolToken(text, i, ", ")
+			v, err := parseLineProtocolValue(raw)
+			if err != nil {
+				return nil, fmt.Errorf("field %v: %v", key, err)
+			}
+			f.value = v
+			f.ok = true
+		}
+		fields = append(fields, f)
+
+		if i >= len(text) || text[i] != ',' {
+			break
+		}
+	}
+
+	ts := now
+	if rest := strings.TrimSpace(text[i:]); rest != "" {
+		v, err := strconv.ParseInt(rest, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp %v", rest)
+		}
+		ts = lineProtocolTime(v, unit)
+	}
+
+	for _, f := range fields {
+		if f.ok == false {
+			continue
+		}
+		points = append(points, LineProtocolPoint{
+			Key: series.String() + escapeLineProtocol(f.key),
+			Point: DataPoint{
+				Value:     f.value,
+				Timestamp: ts,
+			},
+		})
+	}
+
+	return points, nil
+}
+
+// scanLineProtocolToken reads from i until one of the unescaped stop characters, and returns the unescaped token
+// together with the position of the stop character
+func scanLineProtocolToken(text string, i int, stops string) (string, int) {
+	var b strings.Builder
+	for i < len(text) {
+		c := text[i]
+		if c == '\\' && i+1 < len(text) && strings.IndexByte(",= \\", text[i+1]) >= 0 {
+			b.WriteByte(text[i+1])
+			i += 2
+			continue
+		}
+		if strings.IndexByte(stops, c) >= 0 {
+			break
+		}
+		b.WriteByte(c)
+		i++
+	}
+	return b.String(), i
+}
+
+// skipLineProtocolString returns the position after the quoted string value starting at i
+func skipLineProtocolString(text string, i int) (int, error) {
+	for j := i + 1; j < len(text); j++ {
+		switch text[j] {
+		case '\\':
+			j++
+		case '"':
+			return j + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated string value")
+}
+
+// parseLineProtocolValue parses a float, integer (123i), unsigned integer (123u) or boolean field value
+func parseLineProtocolValue(raw string) (float64, error) {
+	switch raw {
+	case "t", "T", "true", "True", "TRUE":
+		return 1, nil
+	case "f", "F", "false", "False", "FALSE":
+		return 0, nil
+	}
+
+	if strings.HasSuffix(raw, "i") {
+		v, err := strconv.ParseInt(strings.TrimSuffix(raw, "i"), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid integer %v", raw)
+		}
+		return float64(v), nil
+	}
+
+	if strings.HasSuffix(raw, "u") {
+		v, err := strconv.ParseUint(strings.TrimSuffix(raw, "u"), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid unsigned integer %v", raw)
+		}
+		return float64(v), nil
+	}
+
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %v", raw)
+	}
+	return v, nil
+}
+
+// escapeLineProtocol escapes the characters that separate the parts of a series key
+func escapeLineProtocol(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ",", `\,`, "=", `\=`, " ", `\ `).Replace(s)
+}
+
+type WriteLineProtocolParams struct {
+	Points    []LineProtocolPoint
+	CreatedBy uuid.UUID
+	// Token of the user, who must be allowed to write the data of every time series
+	Token []byte
+	// Only map to the time series of this thing, and create the missing ones under it
+	Thing *uuid.UUID
+	// Unit of created time series
+	Unit *string
+}
+
+// WriteLineProtocol adds the data points to the time series mapped to each series key, in a single transaction.
+// Missing time series are created in the same transaction, so nothing is left behind when the write fails.
+// Data points with a timestamp that already exists are overwritten, as in InfluxDB.
+func (svc *TimeseriesService) WriteLineProtocol(ctx context.Context, p WriteLineProtocolParams) error {
+	keys := make([]string, 0)
+	index := make(map[string]int)
+	params := make([]AddDataToTimeseriesParams, 0)
+	for _, item := range p.Points {
+		i, ok := index[item.Key]
+		if ok == false {
+			i = len(params)
+			index[item.Key] = i
+			keys = append(keys, item.Key)
+			params = append(params, AddDataToTimeseriesParams{
+				Points:     make([]DataPoint, 0),
+				CreatedBy:  p.CreatedBy,
+				OnConflict: ConflictOverwrite,
+			})
+		}
+		params[i].Points = append(params[i].Points, item.Point)
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	mapping, err := mapLineProtocolSeries(ctx, svc.q.WithTx(tx), keys, p)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	uuids := make([]uuid.UUID, len(keys))
+	for i, key := range keys {
+		uuids[i] = mapping[key]
+		params[i].Uuid = uuids[i]
+	}
+
+	if err := svc.addTaggedDataTx(ctx, tx, p.Token, uuids, params); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	svc.refreshLatest(ctx, uuids...)
+
+	return nil
+}
+
+// mapLineProtocolSeries returns the time series of every line protocol series key, by the influx: tag of the time series.
+// A key must map to exactly one time series.
+func mapLineProtocolSeries(ctx context.Context, q *postgres.Queries, keys []string, p WriteLineProtocolParams) (map[string]uuid.UUID, error) {
+	tags := make([]string, len(keys))
+	for i, key := range keys {
+		tags[i] = lineProtocolTagPrefix + key
+	}
+
+	found, missing, err := findTaggedSeries(ctx, q, tags, p.Thing)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(missing) > 0 {
+		if p.Thing == nil {
+			return nil, ie.NewBadRequestError(fmt.Errorf("no timeseries has the tag %v", missing[0]))
+		} else if p.Unit == nil {
+			return nil, ie.NewBadRequestError(fmt.Errorf("unit is required to create timeseries for %v", strings.TrimPrefix(missing[0], lineProtocolTagPrefix)))
+		}
+
+		series := make([]taggedSeries, len(missing))
+		for i, tag := range missing {
+			series[i] = taggedSeries{
+				Tag:    tag,
+				Name:   strings.TrimPrefix(tag, lineProtocolTagPrefix),
+				SiUnit: *p.Unit,
+			}
+		}
+
+		created, err := createTaggedSeries(ctx, q, p.Token, series, p.Thing, p.CreatedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		for tag, id := range created {
+			found[tag] = id
+		}
+	}
+
+	mapping := make(map[string]uuid.UUID, len(keys))
+	for _, key := range keys {
+		mapping[key] = found[lineProtocolTagPrefix+key]
+	}
+
+	return mapping, nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+func TestParseLineProtocol(t *testing.T) {
+	now := time.Date(2021, 11, 20, 10, 0, 0, 0, time.UTC)
+	body := `# comment
+cpu,region=eu,host=server01 usage_idle=92.5,usage_user=3i,up=true,msg="a \"b\", c" 1637402400000000000
+
+weather\ station,location=the\ lake temp=-1.5e1
+`
+
+	points, err := ParseLineProtocol(strings.NewReader(body), "", now)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	expected := []LineProtocolPoint{
+		{"cpu,host=server01,region=eu usage_idle", DataPoint{Value: 92.5, Timestamp: time.Unix(1637402400, 0)}},
+		{"cpu,host=server01,region=eu usage_user", DataPoint{Value: 3, Timestamp: time.Unix(1637402400, 0)}},
+		{"cpu,host=server01,region=eu up", DataPoint{Value: 1, Timestamp: time.Unix(1637402400, 0)}},
+		{`weather\ station,location=the\ lake temp`, DataPoint{Value: -15, Timestamp: now}},
+	}
+
+	if len(points) != len(expected) {
+		log.Fatalf("Expected %d points, got %d", len(expected), len(points))
+	}
+	for i := range expected {
+		if points[i].Key != expected[i].Key || points[i].Point.Value != expected[i].Point.Value || points[i].Point.Timestamp.Equal(expected[i].Point.Timestamp) == false {
+			log.Fatalf("Point %d does not match expected: %v", i, points[i])
+		}
+	}
+}
+
+func TestParseLineProtocolPrecision(t *testing.T) {
+	for precision, ts := range map[string]string{
+		"ns": "1637402400123000000",
+		"us": "1637402400123000",
+		"ms": "1637402400123",
+	} {
+		points, err := ParseLineProtocol(strings.NewReader("m v=1 "+ts), precision, time.Now())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if points[0].Point.Timestamp.Equal(time.Unix(1637402400, 123000000)) == false {
+			log.Fatalf("Timestamp with precision %v does not match expected", precision)
+		}
+	}
+
+	points, err := ParseLineProtocol(strings.NewReader("m v=1 27290040"), "m", time.Now())
+	if err != nil || points[0].Point.Timestamp.Equal(time.Unix(1637402400, 0)) == false {
+		log.Fatal("Timestamp with precision m does not match expected")
+	}
+
+	if _, err := ParseLineProtocol(strings.NewReader("m v=1"), "d", time.Now()); err == nil {
+		log.Fatal("Unknown precision should fail")
+	}
+}
+
+func TestParseLineProtocolInvalid(t *testing.T) {
+	for _, line := range []string{
+		"cpu",
+		"cpu,host usage=1",
+		"cpu usage=",
+		"cpu usage=abc",
+		"cpu usage=1 abc",
+		`cpu msg="open`,
+		",host=a usage=1",
+	} {
+		if _, err := ParseLineProtocol(strings.NewReader(line), "", time.Now()); err == nil {
+			log.Fatalf("Line %v should fail", line)
+		}
+	}
+}
+
+// addUserWithPolicy adds a user allowed action on resource, and returns the secret of a token of the user
+func addUserWithPolicy(ctx context.Context, name, action, resource string) []byte {
+	u := NewUserService(db)
+	user, err := u.AddUser(ctx, name)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	token, err := u.AddTokenToUser(ctx, uuid.MustParse(user.Uuid), "test")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, g := range user.Groups {
+		if g.Name != user.Name {
+			continue
+		}
+		_, err := NewPolicyService(db).Add(ctx, NewPolicyParams{
+			GroupUuid: uuid.MustParse(g.Uuid),
+			Priority:  0,
+			Effect:    "allow",
+			Action:    action,
+			Resource:  resource,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	return []byte(token.Secret)
+}
+
+// findLineProtocolSeries returns the time series mapped to a series key
+func findLineProtocolSeries(ctx context.Context, key string) []*rest.Timeseries {
+	limit := int64(10)
+	list, err := NewTimeseriesService(db).FindByTags(ctx, NewFindByTagsParams([]byte(rootToken), []string{lineProtocolTagPrefix + key}, &limit, nil))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return list
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestWriteLineProtocol(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	now := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+	unit := "C"
+
+	thing, err := NewThingService(db).AddThing(ctx, &AddThingParams{
+		Name:      "MyLineProtocolThing",
+		CreatedBy: &rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	thingUUID := uuid.MustParse(thing.Uuid)
+
+	write := func(token []byte, body string, thing *uuid.UUID) error {
+		points, err := ParseLineProtocol(strings.NewReader(body), "s", now)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return svc.WriteLineProtocol(ctx, WriteLineProtocolParams{
+			Points:    points,
+			CreatedBy: rootUUID,
+			Token:     token,
+			Thing:     thing,
+			Unit:      &unit,
+		})
+	}
+
+	sum := func(id uuid.UUID) float32 {
+		rows, err := svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+			Uuid:      id,
+			Start:     now.Add(-time.Hour),
+			End:       now.Add(time.Hour),
+			Aggregate: "sum",
+			Precision: "1d",
+			Timezone:  "UTC",
+		})
+		if err != nil {
+			log.Fatal(err)
+		} else if len(rows) != 1 || rows[0].V == nil {
+			log.Fatal("Data of the time series does not match expected")
+		}
+		return *rows[0].V
+	}
+
+	// Without a thing, only existing time series are written
+	if err := write([]byte(rootToken), "room,floor=1 temp=20 1619863200", nil); err == nil {
+		log.Fatal("Writing to a missing time series without a thing should fail")
+	}
+
+	// Missing time series are created under the thing
+	if err := write([]byte(rootToken), "room,floor=1 temp=20 1619863200\nroom,floor=1 temp=21 1619863260", &thingUUID); err != nil {
+		log.Fatal(err)
+	}
+
+	list := findLineProtocolSeries(ctx, "room,floor=1 temp")
+	if len(list) != 1 || list[0].ThingUuid == nil || *list[0].ThingUuid != thing.Uuid || list[0].SiUnit != unit {
+		log.Fatalf("Created time series does not match expected: %v", list)
+	}
+	id := uuid.MustParse(list[0].Uuid)
+
+	// Data points at an existing time are overwritten
+	if err := write([]byte(rootToken), "room,floor=1 temp=22 1619863260", nil); err != nil {
+		log.Fatal(err)
+	}
+	if v := sum(id); v != 42 {
+		log.Fatalf("Sum does not match expected: %v", v)
+	}
+
+	// Writing to existing time series requires access to their data only
+	writer := addUserWithPolicy(ctx, "lineprotocolwriter", "create", fmt.Sprintf("timeseries/%v/data", id.String()))
+	if err := write(writer, "room,floor=1 temp=23 1619863320", nil); err != nil {
+		log.Fatal(err)
+	}
+	if v := sum(id); v != 65 {
+		log.Fatalf("Sum does not match expected: %v", v)
+	}
+
+	// Creating a time series requires create access to timeseries, and nothing is written when it is missing
+	if err := write(writer, "room,floor=1 temp=24 1619863380\nroom,floor=2 temp=20 1619863380", &thingUUID); err != ie.ErrorForbidden {
+		log.Fatalf("Creating a time series without access should be forbidden: %v", err)
+	}
+	if len(findLineProtocolSeries(ctx, "room,floor=2 temp")) != 0 {
+		log.Fatal("Forbidden write should not create a time series")
+	}
+	if v := sum(id); v != 65 {
+		log.Fatal("Forbidden write should not write any data")
+	}
+
+	// Writing requires access to the data of every time series
+	other := addUserWithPolicy(ctx, "lineprotocolother", "read", fmt.Sprintf("timeseries/%v/data", id.String()))
+	if err := write(other, "room,floor=1 temp=24 1619863380", nil); err != ie.ErrorForbidden {
+		log.Fatalf("Writing without access to the data should be forbidden: %v", err)
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, id); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := NewThingService(db).DeleteThing(ctx, thingUUID); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+const (
+	// Most range partitions created by a migration, older data is kept in the default partition
+	maxTsDataPartitions = 1000
+
+	// Most hash sub-partitions of a range partition
+	maxTsDataHashPartitions = 256
+)
+
+// tsDataPartition is a range partition of tsdata, from lower up to but not including upper
+type tsDataPartition struct {
+	name  string
+	lower time.Time
+	upper time.Time
+}
+
+// ValidatePartitioning checks the layout of a time-range partitioned tsdata
+func ValidatePartitioning(width string, hashPartitions int) error {
+	switch width {
+	case "day", "week", "month":
+	default:
+		return fmt.Errorf("partition width %v is not one of day, week or month", width)
+	}
+
+	if hashPartitions < 0 || hashPartitions > maxTsDataHashPartitions {
+		return fmt.Errorf("hash partitions must be from 0 to %d", maxTsDataHashPartitions)
+	}
+
+	return nil
+}
+
+// partitionBounds returns the range of the partition holding ts.
+// Partitions start at midnight UTC, weeks on Mondays.
+func partitionBounds(width string, ts time.Time) (time.Time, time.Time) {
+	t := ts.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch width {
+	case "day":
+		return day, day.AddDate(0, 0, 1)
+	case "week":
+		lower := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return lower, lower.AddDate(0, 0, 7)
+	default:
+		lower := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return lower, lower.AddDate(0, 1, 0)
+	}
+}
+
+// partitionName returns the name of the partition starting at lower, for example tsdata_p202111 or tsdata_p20211115
+func partitionName(width string, lower time.Time) string {
+	if width == "month" {
+		return "tsdata_p" + lower.Format("200601")
+	}
+	return "tsdata_p" + lower.Format("20060102")
+}
+
+// partitionsBetween returns the partitions covering start to stop, oldest first.
+// Only the newest max partitions are returned.
+func partitionsBetween(width string, start, stop time.Time, max int) []tsDataPartition {
+	result := make([]tsDataPartition, 0)
+	lower, upper := partitionBounds(width, stop)
+	for len(result) < max {
+		result = append(result, tsDataPartition{
+			name:  partitionName(width, lower),
+			lower: lower,
+			upper: upper,
+		})
+		if lower.After(start) == false {
+			break
+		}
+		lower, upper = partitionBounds(width, lower.Add(-time.Nanosecond))
+	}
+
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result
+}
+
+// MaintainTsDataPartitions creates the partitions from now until ahead, once tsdata is partitioned by time.
+// Returns the names of the created partitions.
+func (svc *TimeseriesService) MaintainTsDataPartitions(ctx context.Context, now time.Time, ahead time.Duration) ([]string, error) {
+	layout, err := svc.q.GetTsDataPartitioning(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return svc.createTsDataPartitions(ctx, partitionsBetween(layout.Width, now, now.Add(ahead), maxTsDataPartitions))
+}
+
+func (svc *TimeseriesService) createTsDataPartitions(ctx context.Context, partitions []tsDataPartition) ([]string, error) {
+	created := make([]string, 0)
+	for _, p := range partitions {
+		ok, err := svc.q.CreateTsDataPartition(ctx, postgres.CreateTsDataPartitionParams{
+			Name:  p.name,
+			Lower: p.lower,
+			Upper: p.upper,
+		})
+		if err != nil {
+			return created, err
+		} else if ok {
+			created = append(created, p.name)
+		}
+	}
+
+	return created, nil
+}
+
+// DropExpiredTsDataPartitions drops the partitions where all data is older than the retention of its time series.
+// Returns the names of the dropped partitions.
+func (svc *TimeseriesService) DropExpiredTsDataPartitions(ctx context.Context, now time.Time) ([]string, error) {
+	layout, err := svc.q.GetTsDataPartitioning(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	} else if layout.Parent != "tsdata" {
+		// Migration in progress
+		return nil, nil
+	}
+
+	partitions, err := svc.q.FindTsDataPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dropped := make([]string, 0)
+	for _, p := range partitions {
+		if p.Upper.After(now) {
+			break
+		}
+
+		ok, err := svc.q.DropTsDataPartition(ctx, postgres.DropTsDataPartitionParams{
+			Name: p.Name,
+			Now:  now,
+		})
+		if err != nil {
+			return dropped, err
+		} else if ok {
+			dropped = append(dropped, p.Name)
+		}
+	}
+
+	return dropped, nil
+}
+
+// BeginTsDataPartitioning starts the migration of tsdata to time-range partitioning.
+// Returns true when resuming a migration already in progress, with the layout it was started with.
+func (svc *TimeseriesService) BeginTsDataPartitioning(ctx context.Context, width string, hashPartitions int) (bool, error) {
+	layout, err := svc.q.GetTsDataPartitioning(ctx)
+	if err == nil {
+		if layout.Parent == "tsdata" {
+			return false, fmt.Errorf("tsdata is already partitioned by time")
+		}
+		return true, nil
+	} else if err != sql.ErrNoRows {
+		return false, err
+	}
+
+	if err := ValidatePartitioning(width, hashPartitions); err != nil {
+		return false, err
+	}
+
+	err = svc.q.BeginTsDataPartitioning(ctx, postgres.BeginTsDataPartitioningParams{
+		Width:          width,
+		HashPartitions: int32(hashPartitions),
+	})
+
+	return false, err
+}
+
+// CopyTsDataToPartitions creates the partitions from the oldest data until ahead of now, and copies all data to them.
+// Each time series is copied in batches of at most batch points. Progress is called after each time series.
+func (svc *TimeseriesService) CopyTsDataToPartitions(ctx context.Context, now time.Time, ahead time.Duration, batch int, progress func(id uuid.UUID, done, total int)) error {
+	layout, err := svc.q.GetTsDataPartitioning(ctx)
+	if err != nil {
+		return err
+	}
+
+	ranges, err := svc.q.FindTsDataTimeRanges(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := now
+	for _, item := range ranges {
+		if item.First.Before(start) {
+			start = item.First
+		}
+	}
+
+	if _, err := svc.createTsDataPartitions(ctx, partitionsBetween(layout.Width, start, now.Add(ahead), maxTsDataPartitions)); err != nil {
+		return err
+	}
+
+	for i, item := range ranges {
+		after := item.First.Add(-time.Microsecond)
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			last, err := svc.q.CopyTsDataToPartitions(ctx, postgres.CopyTsDataToPartitionsParams{
+				TsUuid:   item.TsUuid,
+				After:    after,
+				ArgLimit: int32(batch),
+			})
+			if err != nil {
+				return err
+			} else if last.Valid == false {
+				break
+			}
+			after = last.Time
+		}
+
+		if progress != nil {
+			progress(item.TsUuid, i+1, len(ranges))
+		}
+	}
+
+	return nil
+}
+
+// FinishTsDataPartitioning ends the migration by replacing tsdata with the time-range partitioned table
+func (svc *TimeseriesService) FinishTsDataPartitioning(ctx context.Context) error {
+	return svc.q.FinishTsDataPartitioning(ctx)
+}
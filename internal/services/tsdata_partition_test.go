@@ -0,0 +1,86 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"testing"
+	"time"
+)
+
+func TestPartitionBounds(t *testing.T) {
+	// Wednesday, in a time zone ahead of UTC
+	ts := time.Date(2021, 12, 1, 0, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	for _, c := range []struct {
+		width string
+		lower time.Time
+		upper time.Time
+		name  string
+	}{
+		{"day", time.Date(2021, 11, 30, 0, 0, 0, 0, time.UTC), time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), "tsdata_p20211130"},
+		{"week", time.Date(2021, 11, 29, 0, 0, 0, 0, time.UTC), time.Date(2021, 12, 6, 0, 0, 0, 0, time.UTC), "tsdata_p20211129"},
+		{"month", time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), "tsdata_p202111"},
+	} {
+		lower, upper := partitionBounds(c.width, ts)
+		if lower.Equal(c.lower) == false || upper.Equal(c.upper) == false {
+			log.Fatalf("Bounds of %v partition do not match expected: %v to %v", c.width, lower, upper)
+		}
+		if name := partitionName(c.width, lower); name != c.name {
+			log.Fatalf("Name of %v partition does not match expected: %v", c.width, name)
+		}
+	}
+
+	// A Sunday belongs to the week starting the Monday before
+	lower, _ := partitionBounds("week", time.Date(2021, 12, 5, 23, 0, 0, 0, time.UTC))
+	if lower.Equal(time.Date(2021, 11, 29, 0, 0, 0, 0, time.UTC)) == false {
+		log.Fatalf("Week of Sunday does not match expected: %v", lower)
+	}
+}
+
+func TestPartitionsBetween(t *testing.T) {
+	start := time.Date(2021, 10, 15, 0, 0, 0, 0, time.UTC)
+	stop := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	parts := partitionsBetween("month", start, stop, 100)
+	if len(parts) != 4 {
+		log.Fatalf("Expected 4 partitions, got %v", parts)
+	}
+	if parts[0].name != "tsdata_p202110" || parts[3].name != "tsdata_p202201" {
+		log.Fatalf("Partitions do not match expected: %v", parts)
+	}
+	for i := 1; i < len(parts); i++ {
+		if parts[i].lower.Equal(parts[i-1].upper) == false {
+			log.Fatalf("Partitions %v and %v are not adjacent", parts[i-1].name, parts[i].name)
+		}
+	}
+
+	// Only the newest partitions
+	parts = partitionsBetween("day", start, stop, 3)
+	if len(parts) != 3 || parts[0].name != "tsdata_p20211230" || parts[2].name != "tsdata_p20220101" {
+		log.Fatalf("Partitions do not match expected: %v", parts)
+	}
+
+	// Start and stop in the same partition
+	parts = partitionsBetween("week", stop, stop, 100)
+	if len(parts) != 1 || parts[0].name != "tsdata_p20211227" {
+		log.Fatalf("Partitions do not match expected: %v", parts)
+	}
+}
+
+func TestValidatePartitioning(t *testing.T) {
+	if err := ValidatePartitioning("month", 0); err != nil {
+		log.Fatal(err)
+	}
+	if err := ValidatePartitioning("week", 16); err != nil {
+		log.Fatal(err)
+	}
+	if err := ValidatePartitioning("year", 0); err == nil {
+		log.Fatal("Expected error for unknown width")
+	}
+	if err := ValidatePartitioning("day", -1); err == nil {
+		log.Fatal("Expected error for negative hash partitions")
+	}
+}
@@ -0,0 +1,534 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/prompb"
+	"github.com/self-host/self-host/postgres"
+)
+
+const (
+	// Prefix of the time series tag that maps a Prometheus series to a time series
+	prometheusTagPrefix = "prometheus:"
+
+	// Label holding the metric name of a Prometheus series
+	prometheusNameLabel = "__name__"
+
+	// Most time series a remote read query may match
+	maxPrometheusSeries = 1000
+
+	// Number of time series read at a time, when matching the time series of a remote read query
+	prometheusPageSize = 1000
+
+	// Most samples returned by a remote read request
+	maxPrometheusSamples = 1000000
+)
+
+// Units of the base unit suffixes of the Prometheus naming conventions
+var prometheusUnits = map[string]string{
+	"seconds": "s",
+	"bytes":   "B",
+	"meters":  "m",
+	"celsius": "C",
+	"joules":  "J",
+	"watts":   "W",
+	"grams":   "g",
+	"percent": "%",
+}
+
+// PrometheusSeries holds the samples of one Prometheus series in a remote write request
+type PrometheusSeries struct {
+	// Series key, the metric name followed by the labels sorted by name, for example `up{instance="a",job="node"}`
+	Key    string
+	Labels []prompb.Label
+	Points []DataPoint
+}
+
+// prometheusSeriesKey returns the series key of a set of labels, and the labels sorted by name.
+// Labels with an empty value are dropped, as in Prometheus.
+func prometheusSeriesKey(labels []prompb.Label) (string, []prompb.Label, error) {
+	sorted := make([]prompb.Label, 0, len(labels))
+	name := ""
+	for _, l := range labels {
+		if l.Value == "" {
+			continue
+		}
+		if l.Name == prometheusNameLabel {
+			name = l.Value
+		}
+		sorted = append(sorted, l)
+	}
+
+	if name == "" {
+		return "", nil, fmt.Errorf("series without a metric name")
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteString("{")
+	first := true
+	for i, l := range sorted {
+		if i > 0 && sorted[i-1].Name == l.Name {
+			return "", nil, fmt.Errorf("series %v has the label %v more than once", name, l.Name)
+		}
+		if l.Name == prometheusNameLabel {
+			continue
+		}
+		if first == false {
+			b.WriteString(",")
+		}
+		first = false
+		b.WriteString(l.Name)
+		b.WriteString("=")
+		b.WriteString(strconv.Quote(l.Value))
+	}
+	b.WriteString("}")
+
+	return b.String(), sorted, nil
+}
+
+// parsePrometheusSeriesKey returns the labels of a series key, including the metric name
+func parsePrometheusSeriesKey(key string) (map[string]string, error) {
+	i := strings.IndexByte(key, '{')
+	if i <= 0 || strings.HasSuffix(key, "}") == false {
+		return nil, fmt.Errorf("invalid series key %v", key)
+	}
+
+	labels := map[string]string{
+		prometheusNameLabel: key[:i],
+	}
+
+	rest := key[i+1 : len(key)-1]
+	for rest != "" {
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 || eq+1 >= len(rest) || rest[eq+1] != '"' {
+			return nil, fmt.Errorf("invalid series key %v", key)
+		}
+		name := rest[:eq]
+
+		// Find the closing quote of the value
+		end := -1
+		for j := eq + 2; j < len(rest); j++ {
+			if rest[j] == '\\' {
+				j++
+			} else if rest[j] == '"' {
+				end = j
+				break
+			}
+		}
+		if end < 0 {
+			return nil, fmt.Errorf("invalid series key %v", key)
+		}
+
+		value, err := strconv.Unquote(rest[eq+1 : end+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid series key %v", key)
+		}
+		labels[name] = value
+
+		rest = rest[end+1:]
+		if rest != "" {
+			if rest[0] != ',' {
+				return nil, fmt.Errorf("invalid series key %v", key)
+			}
+			rest = rest[1:]
+		}
+	}
+
+	return labels, nil
+}
+
+// prometheusUnit returns the unit of a metric from the unit suffix of its name, or "1" when it has none
+func prometheusUnit(name string) string {
+	name = strings.TrimSuffix(name, "_total")
+	name = strings.TrimSuffix(name, "_sum")
+
+	i := strings.LastIndexByte(name, '_')
+	if i < 0 {
+		return "1"
+	}
+
+	if unit, ok := prometheusUnits[name[i+1:]]; ok {
+		return unit
+	}
+	return "1"
+}
+
+// ParsePrometheusWrite returns the series of a remote write request, with the samples of a series merged in one.
+// NaN values, which Prometheus uses to mark stale series, and infinite values can not be stored and are skipped.
+func ParsePrometheusWrite(req *prompb.WriteRequest) ([]PrometheusSeries, error) {
+	index := make(map[string]int)
+	series := make([]PrometheusSeries, 0, len(req.Timeseries))
+	for _, ts := range req.Timeseries {
+		key, labels, err := prometheusSeriesKey(ts.Labels)
+		if err != nil {
+			return nil, ie.NewBadRequestError(err)
+		}
+
+		i, ok := index[key]
+		if ok == false {
+			i = len(series)
+			index[key] = i
+			series = append(series, PrometheusSeries{
+				Key:    key,
+				Labels: labels,
+				Points: make([]DataPoint, 0, len(ts.Samples)),
+			})
+		}
+
+		for _, s := range ts.Samples {
+			if math.IsNaN(s.Value) || math.IsInf(s.Value, 0) {
+				continue
+			}
+			series[i].Points = append(series[i].Points, DataPoint{
+				Value:     s.Value,
+				Timestamp: time.Unix(0, s.Timestamp*int64(time.Millisecond)).UTC(),
+			})
+		}
+	}
+
+	return series, nil
+}
+
+type WritePrometheusParams struct {
+	Series    []PrometheusSeries
+	CreatedBy uuid.UUID
+	// Token of the user, who must be allowed to write the data of every time series
+	Token []byte
+	// Only map to the time series of this thing, and create the missing ones under it
+	Thing *uuid.UUID
+}
+
+// WritePrometheus adds the samples of the series to the time series mapped to each of them, in a single transaction.
+// Missing time series are created in the same transaction, so nothing is left behind when the write fails.
+// Samples with a timestamp that already exists are ignored, as Prometheus may resend samples after a failed request.
+func (svc *TimeseriesService) WritePrometheus(ctx context.Context, p WritePrometheusParams) error {
+	if len(p.Series) == 0 {
+		return nil
+	}
+
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	mapping, err := mapPrometheusSeries(ctx, svc.q.WithTx(tx), p.Token, p.Series, p.Thing, p.CreatedBy)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	uuids := make([]uuid.UUID, 0, len(p.Series))
+	index := make(map[uuid.UUID]int)
+	params := make([]AddDataToTimeseriesParams, 0, len(p.Series))
+	for _, item := range p.Series {
+		id := mapping[item.Key]
+		uuids = append(uuids, id)
+
+		if len(item.Points) == 0 {
+			continue
+		}
+
+		i, ok := index[id]
+		if ok == false {
+			i = len(params)
+			index[id] = i
+			params = append(params, AddDataToTimeseriesParams{
+				Uuid:       id,
+				Points:     make([]DataPoint, 0, len(item.Points)),
+				CreatedBy:  p.CreatedBy,
+				OnConflict: ConflictIgnore,
+			})
+		}
+		params[i].Points = append(params[i].Points, item.Points...)
+	}
+
+	if err := svc.addTaggedDataTx(ctx, tx, p.Token, uuids, params); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	svc.refreshLatest(ctx, uuids...)
+
+	return nil
+}
+
+// mapPrometheusSeries returns the time series of every Prometheus series, by the prometheus: tag of the time series.
+// Missing time series are created, in thing if not nil, with the labels as tags of the form name=value.
+func mapPrometheusSeries(ctx context.Context, q *postgres.Queries, token []byte, series []PrometheusSeries, thing *uuid.UUID, createdBy uuid.UUID) (map[string]uuid.UUID, error) {
+	tags := make([]string, len(series))
+	for i, item := range series {
+		tags[i] = prometheusTagPrefix + item.Key
+	}
+
+	found, missing, err := findTaggedSeries(ctx, q, tags, thing)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(missing) > 0 {
+		byTag := make(map[string]PrometheusSeries, len(series))
+		for _, item := range series {
+			byTag[prometheusTagPrefix+item.Key] = item
+		}
+
+		create := make([]taggedSeries, len(missing))
+		for i, tag := range missing {
+			item := byTag[tag]
+			labels := make([]string, len(item.Labels))
+			unit := "1"
+			for j, l := range item.Labels {
+				labels[j] = l.Name + "=" + l.Value
+				if l.Name == prometheusNameLabel {
+					unit = prometheusUnit(l.Value)
+				}
+			}
+			create[i] = taggedSeries{
+				Tag:    tag,
+				Name:   item.Key,
+				SiUnit: unit,
+				Tags:   labels,
+			}
+		}
+
+		created, err := createTaggedSeries(ctx, q, token, create, thing, createdBy)
+		if err != nil {
+			return nil, err
+		}
+
+		for tag, id := range created {
+			found[tag] = id
+		}
+	}
+
+	mapping := make(map[string]uuid.UUID, len(series))
+	for _, item := range series {
+		mapping[item.Key] = found[prometheusTagPrefix+item.Key]
+	}
+
+	return mapping, nil
+}
+
+// prometheusMatcher is a label matcher of a remote read query
+type prometheusMatcher struct {
+	prompb.LabelMatcher
+	re *regexp.Regexp
+}
+
+// newPrometheusMatchers compiles the label matchers of a query. Regular expressions are anchored, as in Prometheus.
+func newPrometheusMatchers(matchers []prompb.LabelMatcher) ([]prometheusMatcher, error) {
+	result := make([]prometheusMatcher, len(matchers))
+	for i, m := range matchers {
+		result[i].LabelMatcher = m
+		switch m.Type {
+		case prompb.MatchEqual, prompb.MatchNotEqual:
+		case prompb.MatchRegexp, prompb.MatchNotRegexp:
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil {
+				return nil, ie.NewBadRequestError(fmt.Errorf("invalid regular expression %v", m.Value))
+			}
+			result[i].re = re
+		default:
+			return nil, ie.NewBadRequestError(fmt.Errorf("unknown matcher type %v", m.Type))
+		}
+	}
+	return result, nil
+}
+
+// matchPrometheusLabels reports whether labels match every matcher. A missing label has the value "".
+func matchPrometheusLabels(matchers []prometheusMatcher, labels map[string]string) bool {
+	for _, m := range matchers {
+		v := labels[m.Name]
+		var ok bool
+		switch m.Type {
+		case prompb.MatchEqual:
+			ok = v == m.Value
+		case prompb.MatchNotEqual:
+			ok = v != m.Value
+		case prompb.MatchRegexp:
+			ok = m.re.MatchString(v)
+		case prompb.MatchNotRegexp:
+			ok = m.re.MatchString(v) == false
+		}
+		if ok == false {
+			return false
+		}
+	}
+	return true
+}
+
+// ReadPrometheus answers the queries of a remote read request with the stored data points of the matching time series.
+// Only time series with read access to their data, created by remote write, are matched.
+func (svc *TimeseriesService) ReadPrometheus(ctx context.Context, token []byte, req *prompb.ReadRequest) (*prompb.ReadResponse, error) {
+	if len(req.AcceptedResponseTypes) > 0 {
+		ok := false
+		for _, t := range req.AcceptedResponseTypes {
+			ok = ok || t == prompb.ResponseSamples
+		}
+		if ok == false {
+			return nil, ie.NewBadRequestError(fmt.Errorf("only the SAMPLES response type is supported"))
+		}
+	}
+
+	resp := &prompb.ReadResponse{
+		Results: make([]prompb.QueryResult, len(req.Queries)),
+	}
+
+	samples := 0
+	for i, query := range req.Queries {
+		result, err := svc.readPrometheusQuery(ctx, token, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ts := range result.Timeseries {
+			samples += len(ts.Samples)
+		}
+		if samples > maxPrometheusSamples {
+			return nil, ie.NewBadRequestError(fmt.Errorf("the request matches more than %d samples", maxPrometheusSamples))
+		}
+
+		resp.Results[i] = result
+	}
+
+	return resp, nil
+}
+
+// matchPrometheusTimeseries returns the series of a time series by its prometheus: tag,
+// false when it has none or the series does not match
+func matchPrometheusTimeseries(matchers []prometheusMatcher, tags []string) (prompb.TimeSeries, bool) {
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, prometheusTagPrefix) == false {
+			continue
+		}
+
+		labels, err := parsePrometheusSeriesKey(strings.TrimPrefix(tag, prometheusTagPrefix))
+		if err != nil || matchPrometheusLabels(matchers, labels) == false {
+			return prompb.TimeSeries{}, false
+		}
+
+		ts := prompb.TimeSeries{
+			Labels:  make([]prompb.Label, 0, len(labels)),
+			Samples: make([]prompb.Sample, 0),
+		}
+		for name, value := range labels {
+			ts.Labels = append(ts.Labels, prompb.Label{Name: name, Value: value})
+		}
+		sort.Slice(ts.Labels, func(i, j int) bool {
+			return ts.Labels[i].Name < ts.Labels[j].Name
+		})
+
+		return ts, true
+	}
+
+	return prompb.TimeSeries{}, false
+}
+
+// readPrometheusQuery answers one query of a remote read request
+func (svc *TimeseriesService) readPrometheusQuery(ctx context.Context, token []byte, query prompb.Query) (prompb.QueryResult, error) {
+	result := prompb.QueryResult{
+		Timeseries: make([]prompb.TimeSeries, 0),
+	}
+
+	matchers, err := newPrometheusMatchers(query.Matchers)
+	if err != nil {
+		return result, err
+	}
+
+	// Narrow down the time series in the database by the equality matchers, the others are applied below
+	allTags := make([]string, 0)
+	for _, m := range matchers {
+		if m.Type == prompb.MatchEqual && m.Value != "" {
+			allTags = append(allTags, m.Name+"="+m.Value)
+		}
+	}
+
+	uuids := make([]uuid.UUID, 0)
+	series := make(map[uuid.UUID]int)
+
+	// The other matchers can only be applied to the series of the time series, which are read a page at a time
+	params := postgres.FindTimeseriesByTagPrefixParams{
+		Token:     token,
+		TagPrefix: prometheusTagPrefix,
+		AllTags:   allTags,
+		ArgLimit:  prometheusPageSize,
+	}
+	for {
+		found, err := svc.q.FindTimeseriesByTagPrefix(ctx, params)
+		if err != nil {
+			return result, err
+		}
+
+		for _, item := range found {
+			ts, ok := matchPrometheusTimeseries(matchers, item.Tags)
+			if ok == false {
+				continue
+			}
+
+			if len(uuids) == maxPrometheusSeries {
+				return result, ie.NewBadRequestError(fmt.Errorf("the query matches more than %d timeseries", maxPrometheusSeries))
+			}
+
+			series[item.Uuid] = len(result.Timeseries)
+			uuids = append(uuids, item.Uuid)
+			result.Timeseries = append(result.Timeseries, ts)
+		}
+
+		if len(found) < prometheusPageSize {
+			break
+		}
+		params.AfterName = found[len(found)-1].Name
+		params.AfterUuid = found[len(found)-1].Uuid
+	}
+
+	if len(uuids) == 0 {
+		return result, nil
+	}
+
+	rows, err := svc.q.GetTsDataRange(ctx, postgres.GetTsDataRangeParams{
+		TsUuids: uuids,
+		Start:   time.Unix(0, query.StartTimestampMs*int64(time.Millisecond)),
+		Stop:    time.Unix(0, query.EndTimestampMs*int64(time.Millisecond)),
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if len(rows) > maxPrometheusSamples {
+		return result, ie.NewBadRequestError(fmt.Errorf("the request matches more than %d samples", maxPrometheusSamples))
+	}
+
+	for _, row := range rows {
+		i := series[row.TsUuid]
+		result.Timeseries[i].Samples = append(result.Timeseries[i].Samples, prompb.Sample{
+			Value:     row.Value,
+			Timestamp: row.Ts.UnixNano() / int64(time.Millisecond),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/self-host/self-host/internal/prompb"
+)
+
+func TestPrometheusSeriesKey(t *testing.T) {
+	key, labels, err := prometheusSeriesKey([]prompb.Label{
+		{Name: "job", Value: "node"},
+		{Name: "__name__", Value: "up"},
+		{Name: "empty", Value: ""},
+		{Name: "instance", Value: `a "b",c`},
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if key != `up{instance="a \"b\",c",job="node"}` {
+		log.Fatalf("Series key does not match expected: %v", key)
+	} else if len(labels) != 3 || labels[0].Name != "__name__" {
+		log.Fatalf("Labels do not match expected: %v", labels)
+	}
+
+	parsed, err := parsePrometheusSeriesKey(key)
+	if err != nil {
+		log.Fatal(err)
+	} else if len(parsed) != 3 || parsed["__name__"] != "up" || parsed["instance"] != `a "b",c` || parsed["job"] != "node" {
+		log.Fatalf("Parsed labels do not match expected: %v", parsed)
+	}
+
+	if _, _, err := prometheusSeriesKey([]prompb.Label{{Name: "job", Value: "node"}}); err == nil {
+		log.Fatal("Expected error for series without a metric name")
+	}
+
+	if _, _, err := prometheusSeriesKey([]prompb.Label{{Name: "__name__", Value: "up"}, {Name: "a", Value: "1"}, {Name: "a", Value: "2"}}); err == nil {
+		log.Fatal("Expected error for duplicate label")
+	}
+
+	if _, err := parsePrometheusSeriesKey(`up{job="node}`); err == nil {
+		log.Fatal("Expected error for unterminated value")
+	}
+}
+
+func TestPrometheusUnit(t *testing.T) {
+	for name, unit := range map[string]string{
+		"node_cpu_seconds_total":              "s",
+		"http_request_duration_seconds_sum":   "s",
+		"http_request_duration_seconds_count": "1",
+		"node_memory_MemFree_bytes":           "B",
+		"room_temperature_celsius":            "C",
+		"up":                                  "1",
+		"http_requests_total":                 "1",
+	} {
+		if u := prometheusUnit(name); u != unit {
+			log.Fatalf("Unit of %v is %v, expected %v", name, u, unit)
+		}
+		if err := validateUnit(prometheusUnit(name)); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func TestParsePrometheusWrite(t *testing.T) {
+	up := []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "node"}}
+	series, err := ParsePrometheusWrite(&prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{Labels: up, Samples: []prompb.Sample{{Value: 1, Timestamp: 1637402400000}, {Value: math.NaN(), Timestamp: 1637402415000}}},
+			{Labels: []prompb.Label{{Name: "__name__", Value: "load"}}, Samples: []prompb.Sample{{Value: 0.5, Timestamp: 1637402400000}}},
+			{Labels: up, Samples: []prompb.Sample{{Value: 0, Timestamp: 1637402430123}}},
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(series) != 2 || series[0].Key != `up{job="node"}` || series[1].Key != "load{}" {
+		log.Fatalf("Series do not match expected: %v", series)
+	}
+
+	points := series[0].Points
+	if len(points) != 2 || points[0].Value != 1 || points[1].Value != 0 {
+		log.Fatalf("Points do not match expected: %v", points)
+	} else if points[1].Timestamp.Equal(time.Unix(1637402430, 123000000)) == false {
+		log.Fatalf("Timestamp does not match expected: %v", points[1].Timestamp)
+	}
+}
+
+func TestPrometheusMatchers(t *testing.T) {
+	labels := map[string]string{
+		"__name__": "up",
+		"job":      "node",
+		"instance": "localhost:9100",
+	}
+
+	for _, c := range []struct {
+		matchers []prompb.LabelMatcher
+		match    bool
+	}{
+		{[]prompb.LabelMatcher{{Type: prompb.MatchEqual, Name: "__name__", Value: "up"}}, true},
+		{[]prompb.LabelMatcher{{Type: prompb.MatchEqual, Name: "__name__", Value: "up"}, {Type: prompb.MatchNotEqual, Name: "job", Value: "node"}}, false},
+		{[]prompb.LabelMatcher{{Type: prompb.MatchRegexp, Name: "instance", Value: "localhost:.*"}}, true},
+		{[]prompb.LabelMatcher{{Type: prompb.MatchRegexp, Name: "instance", Value: "localhost"}}, false},
+		{[]prompb.LabelMatcher{{Type: prompb.MatchNotRegexp, Name: "job", Value: "no.*"}}, false},
+		{[]prompb.LabelMatcher{{Type: prompb.MatchEqual, Name: "missing", Value: ""}}, true},
+	} {
+		matchers, err := newPrometheusMatchers(c.matchers)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if matchPrometheusLabels(matchers, labels) != c.match {
+			log.Fatalf("Matchers %v should match %v", c.matchers, c.match)
+		}
+	}
+
+	if _, err := newPrometheusMatchers([]prompb.LabelMatcher{{Type: prompb.MatchRegexp, Name: "job", Value: "("}}); err == nil {
+		log.Fatal("Expected error for invalid regular expression")
+	}
+}
+
+func TestMatchPrometheusTimeseries(t *testing.T) {
+	matchers, err := newPrometheusMatchers([]prompb.LabelMatcher{{Type: prompb.MatchNotEqual, Name: "job", Value: "db"}})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ts, ok := matchPrometheusTimeseries(matchers, []string{"__name__=up", `prometheus:up{job="node"}`})
+	if ok == false {
+		log.Fatal("Time series should match")
+	} else if len(ts.Labels) != 2 || ts.Labels[0].Name != "__name__" || ts.Labels[1].Value != "node" || len(ts.Samples) != 0 {
+		log.Fatalf("Series does not match expected: %v", ts)
+	}
+
+	if _, ok := matchPrometheusTimeseries(matchers, []string{`prometheus:up{job="db"}`}); ok {
+		log.Fatal("Time series of an excluded series should not match")
+	}
+	if _, ok := matchPrometheusTimeseries(matchers, []string{"job=node"}); ok {
+		log.Fatal("Time series without a prometheus: tag should not match")
+	}
+}
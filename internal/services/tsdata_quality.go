@@ -0,0 +1,94 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+// Quality flags a data point that is not a plain measurement, the zero value is a plain measurement.
+// Qualities are ordered by severity, an aggregate of several data points has the most severe quality among them.
+// The values are stored in the quality column of tsdata, and must not change.
+type Quality int16
+
+const (
+	QualityNone Quality = iota
+	QualityCorrected
+	QualityEstimated
+	QualitySubstituted
+	QualityBad
+)
+
+var qualityNames = map[Quality]rest.TsQuality{
+	QualityCorrected:   rest.TsQualityCorrected,
+	QualityEstimated:   rest.TsQualityEstimated,
+	QualitySubstituted: rest.TsQualitySubstituted,
+	QualityBad:         rest.TsQualityBad,
+}
+
+// ParseQuality parses one of "corrected", "estimated", "substituted" or "bad". An empty string is a plain measurement.
+func ParseQuality(s string) (Quality, error) {
+	if s == "" {
+		return QualityNone, nil
+	}
+
+	for q, name := range qualityNames {
+		if strings.EqualFold(s, string(name)) {
+			return q, nil
+		}
+	}
+
+	return QualityNone, ie.NewBadRequestError(fmt.Errorf("quality %v is not one of corrected, estimated, substituted or bad", s))
+}
+
+// ParseQualities parses a list of qualities, such as the exclude_quality parameter
+func ParseQualities(list []string) ([]Quality, error) {
+	result := make([]Quality, 0, len(list))
+	for _, s := range list {
+		q, err := ParseQuality(s)
+		if err != nil {
+			return nil, err
+		} else if q != QualityNone {
+			result = append(result, q)
+		}
+	}
+
+	return result, nil
+}
+
+// Rest returns the quality as part of a TsRow, nil for a plain measurement
+func (q Quality) Rest() *rest.TsQuality {
+	name, ok := qualityNames[q]
+	if ok == false {
+		return nil
+	}
+	return &name
+}
+
+// nullQuality returns the quality of a column, where NULL is a plain measurement
+func nullQuality(n sql.NullInt32) Quality {
+	if n.Valid == false {
+		return QualityNone
+	}
+	return Quality(n.Int32)
+}
+
+// qualityCodes returns the qualities as the codes taken by the tsdata queries
+func qualityCodes(list []Quality) []int16 {
+	if len(list) == 0 {
+		return nil
+	}
+
+	codes := make([]int16, len(list))
+	for i, q := range list {
+		codes[i] = int16(q)
+	}
+	return codes
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestParseQuality(t *testing.T) {
+	cases := map[string]Quality{
+		"":            QualityNone,
+		"corrected":   QualityCorrected,
+		"Estimated":   QualityEstimated,
+		"substituted": QualitySubstituted,
+		"bad":         QualityBad,
+	}
+
+	for s, expected := range cases {
+		q, err := ParseQuality(s)
+		if err != nil {
+			log.Fatal(err)
+		} else if q != expected {
+			log.Fatalf("Quality of %q does not match expected: %v", s, q)
+		}
+	}
+
+	if _, err := ParseQuality("good"); err == nil {
+		log.Fatal("Expected error for unknown quality")
+	}
+
+	if QualityNone.Rest() != nil {
+		log.Fatal("Expected no quality for a plain measurement")
+	}
+	if q := QualityBad.Rest(); q == nil || *q != rest.TsQualityBad {
+		log.Fatal("Quality bad does not match expected")
+	}
+
+	list, err := ParseQualities([]string{"bad", "", "estimated"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	codes := qualityCodes(list)
+	if len(codes) != 2 || codes[0] != 4 || codes[1] != 2 {
+		log.Fatalf("Quality codes do not match expected: %v", codes)
+	}
+}
+
+func TestQualityExcludesRollups(t *testing.T) {
+	seq, err := newBucketSequence("hour", nil, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	params := postgres.GetTsDataRangeAggParams{
+		Aggregate: "avg",
+		Start:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Stop:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	if _, ok := planQueryRollup(params, seq); ok == false {
+		log.Fatal("Expected a rollup plan")
+	}
+
+	// Rollups include every quality
+	params.ExcludeQuality = qualityCodes([]Quality{QualityBad})
+	if _, ok := planQueryRollup(params, seq); ok {
+		log.Fatal("Expected no rollup plan when excluding a quality")
+	}
+}
+
+func TestQualityOfDerived(t *testing.T) {
+	a := uuid.MustParse("1896048c-bdc9-43c4-af41-4a946b9a341e")
+	b := uuid.MustParse("6e5b6f36-8a4a-4ac6-9ab7-8bb7d84dc4ee")
+	c := uuid.MustParse("a3f2e6a1-0d6b-4c3e-9a57-0c1d3e7c3b11")
+
+	d, err := parseExpression("[" + a.String() + "] + [" + b.String() + "]")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src := &tsSources{
+		stored:  []uuid.UUID{a, b},
+		direct:  map[uuid.UUID]bool{},
+		derived: map[uuid.UUID]*derivedExpression{c: d},
+		order:   []uuid.UUID{c},
+	}
+
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+
+	result := make([]postgres.GetTsDataRangeAggRow, 0)
+	aligner := newDerivedAligner(src, func(row postgres.GetTsDataRangeAggRow) error {
+		result = append(result, row)
+		return nil
+	})
+
+	estimated := sql.NullInt32{Int32: int32(QualityEstimated), Valid: true}
+	bad := sql.NullInt32{Int32: int32(QualityBad), Valid: true}
+
+	rows := []postgres.GetTsDataRangeAggRow{
+		{TsUuid: a, Value: 1, Ts: t0, Quality: estimated},
+		{TsUuid: b, Value: 2, Ts: t0, Quality: bad},
+		{TsUuid: a, Value: 5, Ts: t1},
+		{TsUuid: b, Value: 6, Ts: t1},
+	}
+
+	for _, row := range rows {
+		if err := aligner.Push(row); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := aligner.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	if len(result) != 2 {
+		log.Fatal("Number of rows does not match expected")
+	}
+
+	// The most severe quality of the inputs, and none once the inputs are plain measurements
+	if nullQuality(result[0].Quality) != QualityBad || nullQuality(result[1].Quality) != QualityNone {
+		log.Fatalf("Qualities do not match expected: %v", result)
+	}
+}
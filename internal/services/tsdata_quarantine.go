@@ -0,0 +1,204 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Inserts the replayed data points that do not collide with existing ones, and removes those from the quarantine
+const replayDataFromQuarantine = `
+WITH inserted AS (
+  INSERT INTO tsdata(ts_uuid, value, ts, created_by, quality)
+  SELECT $1::uuid, x.v, x.ts, $2::uuid, x.q
+  FROM
+  json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz, "q" smallint)
+  ON CONFLICT (ts_uuid, ts) DO NOTHING
+  RETURNING ts
+), released AS (
+  DELETE FROM tsdata_quarantine
+  WHERE ts_uuid = $1::uuid
+  AND ts IN (SELECT ts FROM inserted)
+)
+SELECT COUNT(*) FROM inserted;
+`
+
+type FindQuarantineParams struct {
+	PaginationParams
+	Uuid  uuid.UUID
+	Start time.Time
+	End   time.Time
+}
+
+// FindQuarantine lists the quarantined data points of a time series, oldest first
+func (svc *TimeseriesService) FindQuarantine(ctx context.Context, p FindQuarantineParams) ([]*rest.TsQuarantinedPoint, error) {
+	points, err := svc.q.FindTsDataQuarantine(ctx, postgres.FindTsDataQuarantineParams{
+		TsUuid:    p.Uuid,
+		Start:     p.Start,
+		Stop:      p.End,
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rest.TsQuarantinedPoint, len(points))
+	for i, item := range points {
+		result[i] = &rest.TsQuarantinedPoint{
+			V:       item.Value,
+			Ts:      item.Ts,
+			Reason:  rest.TsQuarantinedPointReason(item.Reason),
+			Q:       nullQuality(item.Quality).Rest(),
+			Created: item.Created,
+		}
+	}
+
+	return result, nil
+}
+
+type ReplayQuarantineParams struct {
+	Uuid      uuid.UUID
+	Start     time.Time
+	End       time.Time
+	CreatedBy uuid.UUID
+}
+
+// ReplayQuarantine checks the quarantined data points against the current bounds of the time series.
+// Points within the bounds are added to the time series and removed from the quarantine. Points where the timestamp
+// already exists are counted as duplicates and remain in the quarantine, like the points still outside of the bounds.
+func (svc *TimeseriesService) ReplayQuarantine(ctx context.Context, p ReplayQuarantineParams) (*rest.TsInsertResult, error) {
+	series, err := svc.q.GetTimeseriesByUUID(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Quarantined values are already in the unit of the time series
+	filter, err := newDataPointFilter(series, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	q := svc.q.WithTx(tx)
+	result := newTsInsertResult()
+	hours := make(rollupHourSet)
+
+	// Points that remain in the quarantine are skipped by moving the start past the last point read
+	cursor := p.Start
+	for {
+		items, err := q.FindTsDataQuarantine(ctx, postgres.FindTsDataQuarantineParams{
+			TsUuid:    p.Uuid,
+			Start:     cursor,
+			Stop:      p.End,
+			ArgLimit:  tsdataBatchSize,
+			ArgOffset: 0,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		accepted := make([]DataPoint, 0, len(items))
+		for _, item := range items {
+			point := DataPoint{
+				Value:     item.Value,
+				Timestamp: item.Ts,
+				Quality:   nullQuality(item.Quality),
+			}
+
+			if err := filter.Seed(ctx, q, p.Uuid, point.Timestamp); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+
+			reason, err := filter.Apply(&point)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			} else if reason != "" {
+				reject(result, point, reason)
+				continue
+			}
+
+			accepted = append(accepted, point)
+			hours.Add(point.Timestamp)
+		}
+
+		if len(accepted) > 0 {
+			data, err := json.Marshal(accepted)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+
+			var inserted int64
+			if err := tx.QueryRowContext(ctx, replayDataFromQuarantine, p.Uuid, p.CreatedBy, data).Scan(&inserted); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+
+			result.Accepted += inserted
+			result.Duplicates += int64(len(accepted)) - inserted
+		}
+
+		if len(items) < tsdataBatchSize {
+			break
+		}
+
+		cursor = items[len(items)-1].Ts.Add(time.Microsecond)
+	}
+
+	if err := refreshRollups(ctx, q, p.Uuid, hours); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	svc.refreshLatest(ctx, p.Uuid)
+
+	return result, nil
+}
+
+// lastQuarantinedPerTimestamp removes all but the last rejected point of every timestamp, keeping the order
+func lastQuarantinedPerTimestamp(points []quarantinedPoint) []quarantinedPoint {
+	last := make(map[int64]int, len(points))
+	for i, p := range points {
+		last[p.Timestamp.UnixNano()] = i
+	}
+
+	if len(last) == len(points) {
+		return points
+	}
+
+	result := make([]quarantinedPoint, 0, len(last))
+	for i, p := range points {
+		if last[p.Timestamp.UnixNano()] == i {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestDataPointFilterReason(t *testing.T) {
+	filter, err := newDataPointFilter(postgres.Timeseries{
+		SiUnit:     "C",
+		LowerBound: sql.NullFloat64{Float64: -10, Valid: true},
+		UpperBound: sql.NullFloat64{Float64: 40, Valid: true},
+	}, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	expected := map[float64]rest.TsRejectedPointReason{
+		-20: rest.TsRejectedPointReasonBelowLowerBound,
+		-10: "",
+		20:  "",
+		40:  "",
+		50:  rest.TsRejectedPointReasonAboveUpperBound,
+	}
+
+	for v, e := range expected {
+		reason, err := filter.Apply(&DataPoint{Value: v})
+		if err != nil {
+			log.Fatal(err)
+		}
+		if reason != e {
+			log.Fatalf("Reason for %v does not match expected", v)
+		}
+	}
+}
+
+func TestRejectLimit(t *testing.T) {
+	result := newTsInsertResult()
+	for i := 0; i < maxRejectedPoints+10; i++ {
+		reject(result, DataPoint{Value: 1, Timestamp: time.Unix(int64(i), 0)}, rest.TsRejectedPointReasonAboveUpperBound)
+	}
+
+	if result.Filtered != maxRejectedPoints+10 {
+		log.Fatal("Filtered does not count every rejected point")
+	}
+	if len(result.Rejected) != maxRejectedPoints {
+		log.Fatal("Rejected points are not limited")
+	}
+}
+
+func TestLastQuarantinedPerTimestamp(t *testing.T) {
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	points := []quarantinedPoint{
+		{Value: -20, Timestamp: t0, Reason: rest.TsRejectedPointReasonBelowLowerBound},
+		{Value: 50, Timestamp: t0.Add(time.Minute), Reason: rest.TsRejectedPointReasonAboveUpperBound},
+		{Value: 60, Timestamp: t0, Reason: rest.TsRejectedPointReasonAboveUpperBound},
+	}
+
+	result := lastQuarantinedPerTimestamp(points)
+	if len(result) != 2 {
+		log.Fatal("Duplicate timestamps were not removed")
+	}
+	if result[0].Value != 50 || result[1].Value != 60 || result[1].Reason != rest.TsRejectedPointReasonAboveUpperBound {
+		log.Fatal("The last point of a timestamp was not kept")
+	}
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestQuarantineReplay(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+	createdBy := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:       "MyQuarantinedTimeseries",
+		SiUnit:     "C",
+		CreatedBy:  createdBy,
+		Tags:       []string{},
+		UpperBound: sql.NullFloat64{Float64: 40, Valid: true},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID := uuid.MustParse(timeseries.Uuid)
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	result, err := svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid: tsUUID,
+		Points: []DataPoint{
+			{Value: 20, Timestamp: t0},
+			{Value: 50, Timestamp: t0.Add(time.Minute)},
+			{Value: 60, Timestamp: t0.Add(2 * time.Minute), Quality: QualityEstimated},
+		},
+		CreatedBy:  createdBy,
+		Quarantine: true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if result.Accepted != 1 || result.Filtered != 2 || result.Quarantined != 2 {
+		log.Fatal("Insert result does not match expected")
+	}
+
+	points, err := svc.FindQuarantine(ctx, FindQuarantineParams{
+		PaginationParams: PaginationParams{Limit: PaginationLimit{Value: 20}},
+		Uuid:             tsUUID,
+		Start:            t0,
+		End:              t0.Add(time.Hour),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(points) != 2 || points[0].V != 50 || points[1].Reason != rest.TsQuarantinedPointReasonAboveUpperBound {
+		log.Fatal("Quarantined points does not match expected")
+	} else if points[1].Q == nil || *points[1].Q != rest.TsQualityEstimated {
+		log.Fatal("Quarantined point did not keep its quality")
+	}
+
+	// Store a value at the time of the second quarantined point, and raise the bound
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid:      tsUUID,
+		Points:    []DataPoint{{Value: 30, Timestamp: t0.Add(2 * time.Minute)}},
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	bound := sql.NullFloat64{Float64: 100, Valid: true}
+	_, err = svc.UpdateTimeseries(ctx, UpdateTimeseriesParams{
+		Uuid:       tsUUID,
+		UpperBound: &bound,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result, err = svc.ReplayQuarantine(ctx, ReplayQuarantineParams{
+		Uuid:      tsUUID,
+		Start:     t0,
+		End:       t0.Add(time.Hour),
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if result.Accepted != 1 || result.Duplicates != 1 || result.Filtered != 0 {
+		log.Fatal("Replay result does not match expected")
+	}
+
+	// The point that collided with the stored value remains in the quarantine
+	points, err = svc.FindQuarantine(ctx, FindQuarantineParams{
+		PaginationParams: PaginationParams{Limit: PaginationLimit{Value: 20}},
+		Uuid:             tsUUID,
+		Start:            t0,
+		End:              t0.Add(time.Hour),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(points) != 1 || points[0].V != 60 || points[0].Ts.Equal(t0.Add(2*time.Minute)) == false {
+		log.Fatal("Colliding point was removed from the quarantine")
+	}
+
+	rows, err := svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+		Uuid:      tsUUID,
+		Start:     t0,
+		End:       t0.Add(time.Hour),
+		Aggregate: "sum",
+		Precision: "1h",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0].V == nil || *rows[0].V != 100 {
+		log.Fatal("Replayed data does not match expected")
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, tsUUID); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Widths of the rollups maintained in tsdata_rollup, coarsest first.
+// Must match the widths in the tsdata_rollup queries.
+var rollupWidths = []time.Duration{time.Hour, 5 * time.Minute}
+
+// Aggregates that can be computed from the count, sum, min and max of a rollup
+var rollupAggregates = map[string]bool{
+	"avg":   true,
+	"min":   true,
+	"max":   true,
+	"count": true,
+	"sum":   true,
+}
+
+// rollupHourSet collects the hours touched by a write, rollups are refreshed one hour at a time
+type rollupHourSet map[int64]struct{}
+
+func (s rollupHourSet) Add(t time.Time) {
+	s[t.Truncate(time.Hour).Unix()] = struct{}{}
+}
+
+// List returns the start of every hour, in order
+func (s rollupHourSet) List() []time.Time {
+	hours := make([]time.Time, 0, len(s))
+	for h := range s {
+		hours = append(hours, time.Unix(h, 0).UTC())
+	}
+
+	sort.Slice(hours, func(i, j int) bool {
+		return hours[i].Before(hours[j])
+	})
+
+	return hours
+}
+
+// refreshRollups recomputes the rollups of a time series for every hour in hours.
+// Use within the transaction that wrote the data. The write fails when one of the hours has archived data,
+// as the rollup of the hour would lose the archived data points and the data point could duplicate one of them.
+func refreshRollups(ctx context.Context, q *postgres.Queries, id uuid.UUID, hours rollupHourSet) error {
+	if len(hours) == 0 {
+		return nil
+	}
+
+	// Concurrent writers would otherwise compute the rollups without each others data, the archiver takes the lock as well
+	if err := q.LockTsDataRollup(ctx, id); err != nil {
+		return err
+	}
+
+	list := hours.List()
+
+	archived, err := q.GetTsDataArchivedHour(ctx, postgres.GetTsDataArchivedHourParams{
+		Hours:  list,
+		TsUuid: id,
+	})
+	if err == nil {
+		return ie.NewBadRequestError(fmt.Errorf("timeseries %v has archived data at %v, data can not be written to an archived hour", id, archived.UTC().Format(time.RFC3339)))
+	} else if err != sql.ErrNoRows {
+		return err
+	}
+
+	_, err = q.UpsertTsDataRollupHours(ctx, postgres.UpsertTsDataRollupHoursParams{
+		TsUuid: id,
+		Hours:  list,
+	})
+
+	return err
+}
+
+// invalidateRollups recomputes the rollups of the time series overlapping the range from start to stop,
+// from tsdata and the archives. Use within the transaction that deleted the data.
+func invalidateRollups(ctx context.Context, q *postgres.Queries, ids []uuid.UUID, start, stop time.Time) error {
+	sorted := append([]uuid.UUID{}, ids...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+
+	// Lock in a fixed order to avoid deadlocks between transactions
+	for _, id := range sorted {
+		if err := q.LockTsDataRollup(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	lo := start.Truncate(time.Hour)
+	hi := stop.Truncate(time.Hour).Add(time.Hour)
+
+	_, err := q.DeleteTsDataRollupRange(ctx, postgres.DeleteTsDataRollupRangeParams{
+		TsUuids: ids,
+		Start:   lo,
+		Stop:    hi,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateTsDataRollupRange(ctx, postgres.CreateTsDataRollupRangeParams{
+		TsUuids: ids,
+		Start:   lo,
+		Stop:    hi,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Rollups of archived hours are kept, and so are rebuilt from the archives
+	archives, err := q.FindTsDataArchives(ctx, postgres.FindTsDataArchivesParams{
+		TsUuids: ids,
+		Start:   lo,
+		Stop:    hi.Add(-time.Microsecond),
+	})
+	if err != nil {
+		return err
+	}
+
+	return addArchivedRollups(ctx, q, archives, lo, hi)
+}
+
+// rollupPlan is the rollup used to answer an aggregated query, and the range of the query it answers.
+// Data outside of the range is read from tsdata.
+type rollupPlan struct {
+	width time.Duration
+	start time.Time
+	stop  time.Time
+}
+
+// planRollup selects the coarsest rollup where every rollup bucket falls within a single bucket of seq
+func planRollup(seq *bucketSequence, aggregate string, start, end time.Time) (rollupPlan, bool) {
+	if rollupAggregates[aggregate] == false {
+		return rollupPlan{}, false
+	}
+
+	for _, width := range rollupWidths {
+		if seq.alignedTo(width, start, end) == false {
+			continue
+		}
+
+		// The range of whole rollup buckets within start and end, end is inclusive
+		rs := start.Truncate(width)
+		if rs.Before(start) {
+			rs = rs.Add(width)
+		}
+		re := end.Add(time.Microsecond).Truncate(width)
+
+		if rs.Before(re) {
+			return rollupPlan{
+				width: width,
+				start: rs,
+				stop:  re,
+			}, true
+		}
+	}
+
+	return rollupPlan{}, false
+}
+
+// planQueryRollup plans the rollup of an aggregated query.
+// Rollups include data points of every quality, a query excluding any quality is answered from tsdata only.
+// Rollups know nothing of counter resets, neither does a query of counters compensated for resets.
+func planQueryRollup(params postgres.GetTsDataRangeAggParams, seq *bucketSequence) (rollupPlan, bool) {
+	if len(params.ExcludeQuality) > 0 {
+		return rollupPlan{}, false
+	}
+	if len(params.CounterUuids) > 0 && counterAggregates[params.Aggregate] {
+		return rollupPlan{}, false
+	}
+
+	return planRollup(seq, params.Aggregate, params.Start, params.Stop)
+}
+
+// alignedTo reports if every bucket boundary between start and end is a multiple of width since the Unix epoch
+func (b *bucketSequence) alignedTo(width time.Duration, start, end time.Time) bool {
+	if b.width.Months > 0 {
+		// Calendar buckets start at the time of day of the origin
+		day := b.origin.Sub(b.origin.Truncate(24 * time.Hour))
+		if day%width != 0 {
+			return false
+		}
+	} else {
+		if b.width.Duration%width != 0 {
+			return false
+		}
+		if time.Duration(b.origin.UnixNano()%int64(width)) != 0 {
+			return false
+		}
+	}
+
+	// The offset of the time zone moves every boundary, a daily sample finds every offset in use
+	for t := start; ; t = t.Add(24 * time.Hour) {
+		if t.After(end) {
+			t = end
+		}
+
+		_, offset := t.In(b.loc).Zone()
+		if (time.Duration(offset)*time.Second)%width != 0 {
+			return false
+		}
+
+		if t.Equal(end) {
+			return true
+		}
+	}
+}
+
+// getTsDataRangeAgg answers an aggregated query from the rollups when possible, otherwise from tsdata.
+// Archived data of the range is read as well.
+func (svc *TimeseriesService) getTsDataRangeAgg(ctx context.Context, params postgres.GetTsDataRangeAggParams, seq *bucketSequence) ([]postgres.GetTsDataRangeAggRow, error) {
+	result := make([]postgres.GetTsDataRangeAggRow, 0)
+	err := svc.forEachTsDataRangeAgg(ctx, params, seq, func(row postgres.GetTsDataRangeAggRow) error {
+		result = append(result, row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// forEachTsDataRangeAgg is getTsDataRangeAgg without collecting the result in memory.
+// A query of a range with archived data is answered in Go, unless the rollups answer all but its edges.
+func (svc *TimeseriesService) forEachTsDataRangeAgg(ctx context.Context, params postgres.GetTsDataRangeAggParams, seq *bucketSequence, fn func(postgres.GetTsDataRangeAggRow) error) error {
+	archives, err := svc.q.FindTsDataArchives(ctx, postgres.FindTsDataArchivesParams{
+		TsUuids: params.TsUuids,
+		Start:   params.Start,
+		Stop:    params.Stop,
+	})
+	if err != nil {
+		return err
+	}
+
+	plan, ok := planQueryRollup(params, seq)
+	if ok {
+		rp := rollupParams(params, plan)
+		if err := svc.withArchivedEdges(ctx, &rp, seq, archives); err != nil {
+			return err
+		}
+
+		return svc.q.ForEachTsDataRangeAggRollup(ctx, rp, func(row postgres.GetTsDataRangeAggRollupRow) error {
+			return fn(postgres.GetTsDataRangeAggRow(row))
+		})
+	}
+
+	if len(archives) > 0 {
+		return svc.forEachTsDataRangeAggArchived(ctx, params, seq, archives, fn)
+	}
+
+	return svc.q.ForEachTsDataRangeAgg(ctx, params, fn)
+}
+
+func rollupParams(params postgres.GetTsDataRangeAggParams, plan rollupPlan) postgres.GetTsDataRangeAggRollupParams {
+	return postgres.GetTsDataRangeAggRollupParams{
+		RollupWidth:  int32(plan.width / time.Second),
+		TsUuids:      params.TsUuids,
+		RollupStart:  plan.start,
+		RollupStop:   plan.stop,
+		Start:        params.Start,
+		Stop:         params.Stop,
+		BucketMonths: params.BucketMonths,
+		BucketWidth:  params.BucketWidth,
+		Origin:       params.Origin,
+		Timezone:     params.Timezone,
+		Aggregate:    params.Aggregate,
+	}
+}
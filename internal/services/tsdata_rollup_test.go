@@ -0,0 +1,227 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestPlanRollup(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	if err != nil {
+		log.Fatal(err)
+	}
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	start := time.Date(2021, 1, 1, 0, 0, 30, 0, time.UTC)
+	end := time.Date(2021, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	plan := func(precision string, aggregate string, loc *time.Location) (rollupPlan, bool) {
+		seq, err := newBucketSequence(precision, nil, loc)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return planRollup(seq, aggregate, start, end)
+	}
+
+	// Days in Stockholm use hourly rollups, also across daylight saving time
+	p, ok := plan("day", "avg", stockholm)
+	if ok == false || p.width != time.Hour {
+		log.Fatal("Expected hourly rollup")
+	}
+
+	// The edges of the range are read from tsdata
+	if p.start.Equal(time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC)) == false || p.stop.Equal(time.Date(2021, 12, 31, 23, 0, 0, 0, time.UTC)) == false {
+		log.Fatal("Rollup range does not match expected")
+	}
+
+	// Half hour offset only aligns with 5 minute rollups
+	p, ok = plan("month", "max", kolkata)
+	if ok == false || p.width != 5*time.Minute {
+		log.Fatal("Expected 5 minute rollup")
+	}
+
+	if _, ok := plan("PT7M", "avg", time.UTC); ok {
+		log.Fatal("Expected no rollup for 7 minute buckets")
+	}
+
+	if _, ok := plan("hour", "median", time.UTC); ok {
+		log.Fatal("Expected no rollup for median")
+	}
+
+	if _, ok := plan("microseconds", "avg", time.UTC); ok {
+		log.Fatal("Expected no rollup for raw data")
+	}
+
+	hours := make(rollupHourSet)
+	hours.Add(time.Date(2021, 1, 1, 10, 59, 0, 0, time.UTC))
+	hours.Add(time.Date(2021, 1, 1, 9, 1, 0, 0, time.UTC))
+	hours.Add(time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC))
+
+	list := hours.List()
+	if len(list) != 2 || list[0].Equal(time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC)) == false {
+		log.Fatal("Hours does not match expected")
+	}
+}
+
+// rollupQueryParams returns an aggregated query of a time series in UTC, and its bucket sequence
+func rollupQueryParams(id uuid.UUID, precision, aggregate string, start, end time.Time) (postgres.GetTsDataRangeAggParams, *bucketSequence) {
+	seq, err := newBucketSequence(precision, nil, time.UTC)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return postgres.GetTsDataRangeAggParams{
+		Aggregate:    aggregate,
+		BucketMonths: seq.Months(),
+		BucketWidth:  seq.Microseconds(),
+		Origin:       seq.Origin(),
+		Timezone:     "UTC",
+		TsUuids:      []uuid.UUID{id},
+		Start:        start,
+		Stop:         end,
+	}, seq
+}
+
+// compareRollupQuery checks that an aggregated query answered from the rollups matches the same query of tsdata
+func compareRollupQuery(svc *TimeseriesService, id uuid.UUID, precision, aggregate string, start, end time.Time) {
+	ctx := context.Background()
+	params, seq := rollupQueryParams(id, precision, aggregate, start, end)
+
+	if _, ok := planQueryRollup(params, seq); ok == false {
+		log.Fatalf("Query of %v %v should be answered from the rollups", precision, aggregate)
+	}
+
+	planned, err := svc.getTsDataRangeAgg(ctx, params, seq)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	expected, err := svc.q.GetTsDataRangeAgg(ctx, params)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(planned) != len(expected) {
+		log.Fatalf("Query of %v %v has %v rows, expected %v", precision, aggregate, len(planned), len(expected))
+	}
+	for i := range expected {
+		if planned[i].Ts.Equal(expected[i].Ts) == false || math.Abs(planned[i].Value-expected[i].Value) > 1e-9 {
+			log.Fatalf("Query of %v %v does not match expected: %v != %v", precision, aggregate, planned[i], expected[i])
+		}
+	}
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestRollupQueries(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyRollupTimeseries",
+		SiUnit:    "C",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID := uuid.MustParse(timeseries.Uuid)
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48*time.Hour - time.Microsecond)
+
+	compare := func() {
+		for _, precision := range []string{"5m", "1h", "6h", "1d"} {
+			for _, aggregate := range []string{"avg", "min", "max", "count", "sum"} {
+				compareRollupQuery(svc, tsUUID, precision, aggregate, start, end)
+			}
+		}
+
+		// A range that starts and ends within an hour reads its edges from tsdata
+		compareRollupQuery(svc, tsUUID, "1h", "avg", start.Add(17*time.Minute), end.Add(-23*time.Minute))
+	}
+
+	// A data point every 7 minutes, so that buckets hold differing numbers of points
+	points := make([]DataPoint, 0)
+	for ts := start; ts.Before(end); ts = ts.Add(7 * time.Minute) {
+		points = append(points, DataPoint{
+			Value:     math.Sin(float64(ts.Unix()) / 3600),
+			Timestamp: ts,
+		})
+	}
+
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid:      tsUUID,
+		Points:    points,
+		CreatedBy: rootUUID,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	compare()
+
+	// Writes to hours with rollups update them, also when data points are overwritten
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid: tsUUID,
+		Points: []DataPoint{
+			{Value: 100, Timestamp: start.Add(3 * time.Minute)},
+			{Value: -100, Timestamp: start.Add(7 * time.Minute)},
+		},
+		CreatedBy:  rootUUID,
+		OnConflict: ConflictOverwrite,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	compare()
+
+	params, seq := rollupQueryParams(tsUUID, "1h", "max", start, start.Add(time.Hour-time.Microsecond))
+	rows, err := svc.getTsDataRangeAgg(ctx, params, seq)
+	if err != nil {
+		log.Fatal(err)
+	} else if len(rows) != 1 || rows[0].Value != 100 {
+		log.Fatalf("Rollup was not updated by the write: %v", rows)
+	}
+
+	// Deleting data recomputes the rollups of the range
+	_, err = svc.DeleteTsData(ctx, DeleteTsDataParams{
+		Uuid:  tsUUID,
+		Start: start.Add(90 * time.Minute),
+		End:   start.Add(5 * time.Hour),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	compare()
+
+	lower := float32(0)
+	_, err = svc.DeleteTsData(ctx, DeleteTsDataParams{
+		Uuid:     tsUUID,
+		Start:    start,
+		End:      end,
+		LessOrEq: &lower,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	compare()
+
+	if _, err := svc.DeleteTimeseries(ctx, tsUUID); err != nil {
+		log.Fatal(err)
+	}
+}
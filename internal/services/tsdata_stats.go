@@ -0,0 +1,91 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+// meanInterval returns the average number of seconds between count data points from first to last
+func meanInterval(first, last time.Time, count int64) *float64 {
+	if count < 2 {
+		return nil
+	}
+
+	v := last.Sub(first).Seconds() / float64(count-1)
+	return &v
+}
+
+// FindTsDataStats returns the statistics of the data of every time series.
+// The results are in the same order as the uuids.
+func (svc *TimeseriesService) FindTsDataStats(ctx context.Context, uuids []uuid.UUID) ([]*rest.TsStats, error) {
+	found, err := svc.q.GetTimeseriesByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make(map[uuid.UUID]postgres.Timeseries, len(found))
+	stored := make([]uuid.UUID, 0, len(found))
+	for _, item := range found {
+		series[item.Uuid] = item
+		if item.Expression.Valid == false {
+			stored = append(stored, item.Uuid)
+		}
+	}
+
+	for _, id := range uuids {
+		if _, ok := series[id]; ok == false {
+			return nil, sql.ErrNoRows
+		}
+	}
+
+	rows, err := svc.q.GetTsDataStats(ctx, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[uuid.UUID]postgres.GetTsDataStatsRow, len(rows))
+	for _, row := range rows {
+		stats[row.TsUuid] = row
+	}
+
+	result := make([]*rest.TsStats, len(uuids))
+	for i, id := range uuids {
+		result[i] = &rest.TsStats{
+			Uuid: id.String(),
+			Unit: series[id].SiUnit,
+		}
+
+		if series[id].Expression.Valid {
+			msg := errMsgDerivedNoData
+			result[i].Error = &msg
+			continue
+		}
+
+		row := stats[id]
+		result[i].Count = row.Count
+
+		if row.Min.Valid {
+			result[i].Min = &row.Min.Float64
+		}
+		if row.Max.Valid {
+			result[i].Max = &row.Max.Float64
+		}
+		if row.First.Valid && row.Last.Valid {
+			result[i].First = &row.First.Time
+			result[i].Last = &row.Last.Time
+			result[i].MeanInterval = meanInterval(row.First.Time, row.Last.Time, row.Count)
+		}
+	}
+
+	return result, nil
+}
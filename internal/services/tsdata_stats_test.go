@@ -0,0 +1,24 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"testing"
+	"time"
+)
+
+func TestMeanInterval(t *testing.T) {
+	first := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if meanInterval(first, first, 0) != nil || meanInterval(first, first, 1) != nil {
+		log.Fatal("Mean interval requires at least two data points")
+	}
+
+	v := meanInterval(first, first.Add(24*time.Hour), 25)
+	if v == nil || *v != 3600 {
+		log.Fatal("Mean interval does not match expected")
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+// DataPointReader reads data points one by one from a stream.
+type DataPointReader interface {
+	// ReadPoint returns the next data point, or io.EOF when the stream is exhausted.
+	ReadPoint() (DataPoint, error)
+}
+
+type ndjsonPointReader struct {
+	dec  *json.Decoder
+	line int
+}
+
+// NewNDJSONPointReader reads newline delimited JSON objects of the form {"v": 1.0, "ts": "2021-01-01T00:00:00Z"}, with an optional quality "q".
+func NewNDJSONPointReader(r io.Reader) DataPointReader {
+	return &ndjsonPointReader{
+		dec: json.NewDecoder(r),
+	}
+}
+
+func (pr *ndjsonPointReader) ReadPoint() (DataPoint, error) {
+	var row struct {
+		V  *float64   `json:"v"`
+		Ts *time.Time `json:"ts"`
+		Q  string     `json:"q"`
+	}
+
+	pr.line++
+
+	if err := pr.dec.Decode(&row); err == io.EOF {
+		return DataPoint{}, io.EOF
+	} else if err != nil {
+		return DataPoint{}, ie.NewBadRequestError(fmt.Errorf("record %d: %v", pr.line, err))
+	}
+
+	if row.V == nil || row.Ts == nil {
+		return DataPoint{}, ie.NewBadRequestError(fmt.Errorf("record %d: both v and ts are required", pr.line))
+	}
+
+	q, err := ParseQuality(row.Q)
+	if err != nil {
+		return DataPoint{}, ie.NewBadRequestError(fmt.Errorf("record %d: %v", pr.line, err))
+	}
+
+	return DataPoint{
+		Value:     *row.V,
+		Timestamp: *row.Ts,
+		Quality:   q,
+	}, nil
+}
+
+type csvPointReader struct {
+	r      *csv.Reader
+	tsCol  int
+	vCol   int
+	qCol   int
+	line   int
+	header bool
+}
+
+// NewCSVPointReader reads comma separated rows of the form "ts,v".
+//
+// The first row may be a header naming the "ts" and "v" columns, in which case the columns may appear in any order.
+// Only a header can add the column "q", with the quality of each data point.
+func NewCSVPointReader(r io.Reader) DataPointReader {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	cr.TrimLeadingSpace = true
+
+	return &csvPointReader{
+		r:     cr,
+		tsCol: 0,
+		vCol:  1,
+		qCol:  -1,
+	}
+}
+
+func (pr *csvPointReader) ReadPoint() (DataPoint, error) {
+	pr.line++
+
+	record, err := pr.r.Read()
+	if err == io.EOF {
+		return DataPoint{}, io.EOF
+	} else if err != nil {
+		return DataPoint{}, ie.NewBadRequestError(err)
+	}
+
+	if pr.header == false {
+		pr.header = true
+
+		tsCol, vCol, qCol := -1, -1, -1
+		for i, name := range record {
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "ts":
+				tsCol = i
+			case "v":
+				vCol = i
+			case "q":
+				qCol = i
+			}
+		}
+
+		if tsCol != -1 && vCol != -1 {
+			pr.tsCol = tsCol
+			pr.vCol = vCol
+			pr.qCol = qCol
+			return pr.ReadPoint()
+		}
+	}
+
+	if len(record) <= pr.tsCol || len(record) <= pr.vCol {
+		return DataPoint{}, ie.NewBadRequestError(fmt.Errorf("record %d: expected columns ts and v", pr.line))
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(record[pr.tsCol]))
+	if err != nil {
+		return DataPoint{}, ie.NewBadRequestError(fmt.Errorf("record %d: %v", pr.line, err))
+	}
+
+	v, err := strconv.ParseFloat(strings.TrimSpace(record[pr.vCol]), 64)
+	if err != nil {
+		return DataPoint{}, ie.NewBadRequestError(fmt.Errorf("record %d: %v", pr.line, err))
+	}
+
+	var q Quality
+	if pr.qCol != -1 && pr.qCol < len(record) {
+		q, err = ParseQuality(strings.TrimSpace(record[pr.qCol]))
+		if err != nil {
+			return DataPoint{}, ie.NewBadRequestError(fmt.Errorf("record %d: %v", pr.line, err))
+		}
+	}
+
+	return DataPoint{
+		Value:     v,
+		Timestamp: ts,
+		Quality:   q,
+	}, nil
+}
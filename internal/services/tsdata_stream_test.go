@@ -0,0 +1,177 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+)
+
+func readAllPoints(r DataPointReader) ([]DataPoint, error) {
+	points := make([]DataPoint, 0)
+	for {
+		p, err := r.ReadPoint()
+		if err == io.EOF {
+			return points, nil
+		} else if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+}
+
+func TestNDJSONPointReader(t *testing.T) {
+	body := `{"v": 1.5, "ts": "2021-01-01T00:00:00Z"}
+{"v": -2, "ts": "2021-01-01T00:01:00+01:00", "q": "estimated"}
+`
+	points, err := readAllPoints(NewNDJSONPointReader(strings.NewReader(body)))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(points) != 2 {
+		log.Fatal("Expected two points")
+	}
+
+	if points[1].Value != -2 || points[1].Timestamp.Equal(time.Date(2020, 12, 31, 23, 1, 0, 0, time.UTC)) == false {
+		log.Fatal("Second point does not match expected")
+	}
+
+	if points[0].Quality != QualityNone || points[1].Quality != QualityEstimated {
+		log.Fatal("Qualities do not match expected")
+	}
+
+	_, err = readAllPoints(NewNDJSONPointReader(strings.NewReader(`{"v": 1.5}`)))
+	if err == nil {
+		log.Fatal("Expected error for point without timestamp")
+	}
+
+	_, err = readAllPoints(NewNDJSONPointReader(strings.NewReader(`{"v": 1.5, "ts": "2021-01-01T00:00:00Z", "q": "good"}`)))
+	if err == nil {
+		log.Fatal("Expected error for unknown quality")
+	}
+}
+
+func TestCSVPointReader(t *testing.T) {
+	// Without header
+	points, err := readAllPoints(NewCSVPointReader(strings.NewReader("2021-01-01T00:00:00Z,1.5\n2021-01-01T00:01:00Z,2\n")))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(points) != 2 || points[0].Value != 1.5 {
+		log.Fatal("Points does not match expected")
+	}
+
+	// With header and swapped columns
+	points, err = readAllPoints(NewCSVPointReader(strings.NewReader("v,ts\n3.25,2021-01-01T00:00:00Z\n")))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(points) != 1 || points[0].Value != 3.25 {
+		log.Fatal("Points does not match expected")
+	}
+
+	// With a column of qualities
+	points, err = readAllPoints(NewCSVPointReader(strings.NewReader("ts,v,q\n2021-01-01T00:00:00Z,1,bad\n2021-01-01T00:01:00Z,2,\n")))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(points) != 2 || points[0].Quality != QualityBad || points[1].Quality != QualityNone {
+		log.Fatal("Qualities do not match expected")
+	}
+
+	_, err = readAllPoints(NewCSVPointReader(strings.NewReader("2021-01-01T00:00:00Z,abc\n")))
+	if err == nil {
+		log.Fatal("Expected error for invalid value")
+	}
+}
+
+func TestAddTsInsertResult(t *testing.T) {
+	result := newTsInsertResult()
+	batch := &rest.TsInsertResult{
+		Accepted: 2,
+		Filtered: maxRejectedPoints,
+		Rejected: make([]rest.TsRejectedPoint, maxRejectedPoints),
+	}
+
+	addTsInsertResult(result, batch)
+	addTsInsertResult(result, batch)
+
+	if result.Accepted != 4 || result.Filtered != 2*maxRejectedPoints {
+		log.Fatal("Counts does not match expected")
+	}
+	if len(result.Rejected) != maxRejectedPoints {
+		log.Fatal("Rejected points are not limited")
+	}
+}
+
+// Requires the PostgreSQL data-store, like TestTimeseriesAll
+func TestAddDataStreamPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTimeseriesService(db)
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyStreamedTimeseries",
+		SiUnit:    "C",
+		CreatedBy: uuid.MustParse("00000000-0000-1000-8000-000000000000"), // UUID for Root user
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID := uuid.MustParse(timeseries.Uuid)
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// One full batch, followed by a few points and a malformed line
+	var body strings.Builder
+	for i := 0; i < tsdataBatchSize+10; i++ {
+		fmt.Fprintf(&body, "{\"v\": %v, \"ts\": %q}\n", i, start.Add(time.Duration(i)*time.Second).Format(time.RFC3339))
+	}
+	body.WriteString("{\"v\": 1}\n")
+
+	result, err := svc.AddDataStreamToTimeseries(ctx, AddDataStreamToTimeseriesParams{
+		Uuid:      tsUUID,
+		Reader:    NewNDJSONPointReader(strings.NewReader(body.String())),
+		CreatedBy: uuid.MustParse("00000000-0000-1000-8000-000000000000"),
+	})
+	if err == nil {
+		log.Fatal("Expected error for point without timestamp")
+	} else if result == nil || result.Accepted != tsdataBatchSize {
+		log.Fatal("Result of the committed batch was not returned with the error")
+	}
+
+	rows, err := svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+		Uuid:      tsUUID,
+		Start:     start,
+		End:       start.Add(24 * time.Hour),
+		Aggregate: "count",
+		Precision: "1d",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0].V == nil || int(*rows[0].V) != tsdataBatchSize {
+		log.Fatal("Stored data does not match the result")
+	}
+
+	if _, err := svc.DeleteTimeseries(ctx, tsUUID); err != nil {
+		log.Fatal(err)
+	}
+}
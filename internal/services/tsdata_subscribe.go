@@ -0,0 +1,426 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/stdlib"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+const (
+	// Channel where the tsdata_notify trigger signals new data points
+	tsdataChannel = "tsdata"
+
+	// Number of events a subscription may fall behind before it is closed
+	subscriptionBufferSize = 64
+
+	// Upper limit of data points per event, the data points of a notification are split over several events
+	maxEventPoints = 1000
+
+	// How long the registration of the subscribed time series lasts, and how often it is renewed
+	listenerLease         = time.Minute
+	listenerRenewInterval = 15 * time.Second
+)
+
+var (
+	ErrSubscriptionSlow        = errors.New("subscription closed as the data was not consumed fast enough")
+	ErrSubscriptionInterrupted = errors.New("subscription closed as the connection to the database was lost")
+)
+
+// tsdataNotification is the payload of a notification of the tsdata_notify trigger,
+// the time range written to a subscribed time series
+type tsdataNotification struct {
+	Uuid  uuid.UUID `json:"uuid"`
+	Start time.Time `json:"start"`
+	Stop  time.Time `json:"stop"`
+}
+
+// TsDataEvent holds data points committed to a time series, in the order of time
+type TsDataEvent struct {
+	Uuid uuid.UUID   `json:"uuid"`
+	Unit string      `json:"unit"`
+	Data []DataPoint `json:"data"`
+}
+
+// TsDataSubscription receives the data points committed to a set of time series
+type TsDataSubscription struct {
+	// Closed when the subscription ends, see Err for the reason
+	C <-chan TsDataEvent
+
+	c     chan TsDataEvent
+	hub   *tsdataHub
+	uuids []uuid.UUID
+	err   error
+}
+
+// Err returns the reason the subscription ended, nil when it was closed by Close.
+// Only valid once C is closed.
+func (s *TsDataSubscription) Err() error {
+	return s.err
+}
+
+// Close ends the subscription
+func (s *TsDataSubscription) Close() {
+	s.hub.remove(s, nil)
+}
+
+// tsdataHub dispatches the notifications of new data points in one database to the subscriptions.
+//
+// A single connection per database listens to the channel, while there are subscriptions.
+// As notifications are delivered to every listening connection, this works across every instance using the database.
+// The trigger only signals writes to the time series registered in tsdata_listener, which the hub keeps up to date
+// while it listens. A notification holds a time range, the hub reads the data points of the range itself.
+type tsdataHub struct {
+	db *sql.DB
+	q  *postgres.Queries
+
+	mux    sync.Mutex
+	subs   map[uuid.UUID]map[*TsDataSubscription]bool
+	count  int
+	cancel context.CancelFunc
+	// Id of the registrations in tsdata_listener, new every time the hub starts listening
+	listener uuid.UUID
+}
+
+var (
+	tsdataHubs    = make(map[*sql.DB]*tsdataHub)
+	tsdataHubsMux sync.Mutex
+)
+
+// tsdataHubFor returns the subscription hub of a database
+func tsdataHubFor(db *sql.DB) *tsdataHub {
+	tsdataHubsMux.Lock()
+	defer tsdataHubsMux.Unlock()
+
+	h, ok := tsdataHubs[db]
+	if ok == false {
+		h = &tsdataHub{
+			db:   db,
+			q:    postgres.New(db),
+			subs: make(map[uuid.UUID]map[*TsDataSubscription]bool),
+		}
+		tsdataHubs[db] = h
+	}
+
+	return h
+}
+
+// add registers a subscription, and starts listening if this is the first one
+func (h *tsdataHub) add(ctx context.Context, s *TsDataSubscription) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	if h.cancel == nil {
+		// Listen before the subscription is returned, so that no notification sent after that is missed
+		conn, err := h.db.Conn(ctx)
+		if err != nil {
+			return err
+		}
+
+		if _, err := conn.ExecContext(ctx, "LISTEN "+tsdataChannel); err != nil {
+			conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+			conn.Close()
+			return err
+		}
+
+		lctx, cancel := context.WithCancel(context.Background())
+		h.cancel = cancel
+		h.listener = uuid.New()
+		go h.listen(lctx, conn)
+		go h.renew(lctx, h.listener)
+	}
+
+	for _, id := range s.uuids {
+		if _, ok := h.subs[id]; ok == false {
+			h.subs[id] = make(map[*TsDataSubscription]bool)
+		}
+		h.subs[id][s] = true
+	}
+	h.count++
+
+	return nil
+}
+
+// register registers the time series of a subscription in tsdata_listener, for the trigger to signal their data
+func (h *tsdataHub) register(ctx context.Context, s *TsDataSubscription) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	return h.q.RegisterTsDataListener(ctx, postgres.RegisterTsDataListenerParams{
+		Listener: h.listener,
+		Lease:    int64(listenerLease / time.Second),
+		TsUuids:  s.uuids,
+	})
+}
+
+// renew renews the registration of the subscribed time series until ctx is cancelled, and then removes it
+func (h *tsdataHub) renew(ctx context.Context, listener uuid.UUID) {
+	ticker := time.NewTicker(listenerRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.q.PruneTsDataListeners(context.Background(), postgres.PruneTsDataListenersParams{
+				Listener: listener,
+				TsUuids:  []uuid.UUID{},
+			})
+			return
+
+		case <-ticker.C:
+			if err := h.renewRegistration(ctx, listener); err != nil {
+				// The registration expires, and the subscriptions would miss data
+				h.interrupt(ctx)
+			}
+		}
+	}
+}
+
+// renewRegistration registers the subscribed time series, and removes those no longer subscribed to
+func (h *tsdataHub) renewRegistration(ctx context.Context, listener uuid.UUID) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	uuids := make([]uuid.UUID, 0, len(h.subs))
+	for id := range h.subs {
+		uuids = append(uuids, id)
+	}
+
+	err := h.q.RegisterTsDataListener(ctx, postgres.RegisterTsDataListenerParams{
+		Listener: listener,
+		Lease:    int64(listenerLease / time.Second),
+		TsUuids:  uuids,
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.q.PruneTsDataListeners(ctx, postgres.PruneTsDataListenersParams{
+		Listener: listener,
+		TsUuids:  uuids,
+	})
+}
+
+// remove ends a subscription with the reason err, and stops listening if this was the last one
+func (h *tsdataHub) remove(s *TsDataSubscription, err error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	h.removeLocked(s, err)
+}
+
+func (h *tsdataHub) removeLocked(s *TsDataSubscription, err error) {
+	if _, ok := h.subs[s.uuids[0]][s]; ok == false {
+		// Already removed
+		return
+	}
+
+	for _, id := range s.uuids {
+		delete(h.subs[id], s)
+		if len(h.subs[id]) == 0 {
+			delete(h.subs, id)
+		}
+	}
+	h.count--
+
+	// Events are only sent while holding the lock, the channel can be closed safely
+	s.err = err
+	close(s.c)
+
+	if h.count == 0 && h.cancel != nil {
+		h.cancel()
+		h.cancel = nil
+	}
+}
+
+// listen receives notifications until ctx is cancelled or the connection fails
+func (h *tsdataHub) listen(ctx context.Context, conn *sql.Conn) {
+	defer conn.Close()
+
+	conn.Raw(func(dc interface{}) error {
+		c := dc.(*stdlib.Conn).Conn()
+		for {
+			n, err := c.WaitForNotification(ctx)
+			if err != nil {
+				break
+			}
+			h.dispatch(ctx, n.Payload)
+		}
+
+		// Never return the listening connection to the pool
+		return driver.ErrBadConn
+	})
+
+	if ctx.Err() == nil {
+		// The connection failed while there were subscriptions, which may have missed data
+		h.interrupt(ctx)
+	}
+}
+
+// interrupt ends every subscription that was served by the listener of ctx
+func (h *tsdataHub) interrupt(ctx context.Context) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	// A new listener may have started already, if every subscription ended in the meantime
+	if ctx.Err() != nil {
+		return
+	}
+
+	for _, subs := range h.subs {
+		for s := range subs {
+			h.removeLocked(s, ErrSubscriptionInterrupted)
+		}
+	}
+}
+
+// dispatch reads the data points of a notification, and passes them on to the subscriptions of its time series
+func (h *tsdataHub) dispatch(ctx context.Context, payload string) {
+	var n tsdataNotification
+	if err := json.Unmarshal([]byte(payload), &n); err != nil {
+		return
+	}
+
+	h.mux.Lock()
+	_, ok := h.subs[n.Uuid]
+	h.mux.Unlock()
+
+	if ok == false {
+		// The registration of the time series has yet to be removed
+		return
+	}
+
+	series, err := h.q.GetTimeseriesByUUID(ctx, n.Uuid)
+	if err == sql.ErrNoRows {
+		return
+	} else if err != nil {
+		h.interrupt(ctx)
+		return
+	}
+
+	e := TsDataEvent{
+		Uuid: n.Uuid,
+		Unit: series.SiUnit,
+		Data: make([]DataPoint, 0),
+	}
+
+	err = h.q.ForEachTsDataRange(ctx, postgres.GetTsDataRangeParams{
+		TsUuids: []uuid.UUID{n.Uuid},
+		Start:   n.Start,
+		Stop:    n.Stop,
+	}, func(row postgres.GetTsDataRangeRow) error {
+		e.Data = append(e.Data, DataPoint{
+			Value:     row.Value,
+			Timestamp: row.Ts,
+			Quality:   nullQuality(row.Quality),
+		})
+
+		if len(e.Data) < maxEventPoints {
+			return nil
+		} else if h.send(e) == false {
+			return errNoSubscriptions
+		}
+
+		e.Data = make([]DataPoint, 0)
+		return nil
+	})
+	if err == errNoSubscriptions {
+		return
+	} else if err != nil {
+		// The subscriptions would miss the data
+		h.interrupt(ctx)
+		return
+	}
+
+	if len(e.Data) > 0 {
+		h.send(e)
+	}
+}
+
+// errNoSubscriptions stops reading the data points of a notification that no subscription is left for
+var errNoSubscriptions = errors.New("no subscriptions")
+
+// send passes an event on to the subscriptions of its time series, and returns false when there are none.
+// A subscription that has fallen too far behind is closed, the listener never waits for a subscription.
+func (h *tsdataHub) send(e TsDataEvent) bool {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	for s := range h.subs[e.Uuid] {
+		select {
+		case s.c <- e:
+		default:
+			h.removeLocked(s, ErrSubscriptionSlow)
+		}
+	}
+
+	return len(h.subs[e.Uuid]) > 0
+}
+
+// SubscribeTsData subscribes to the data points committed to the time series from now on, by any instance using the database.
+// Derived time series store no data and can not be subscribed to. The subscription must be closed when done.
+func (svc *TimeseriesService) SubscribeTsData(ctx context.Context, uuids []uuid.UUID) (*TsDataSubscription, error) {
+	found, err := svc.q.GetTimeseriesByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make(map[uuid.UUID]bool, len(found))
+	for _, item := range found {
+		if item.Expression.Valid {
+			return nil, errDerivedTimeseries(item.Uuid)
+		}
+		series[item.Uuid] = true
+	}
+
+	unique := make([]uuid.UUID, 0, len(uuids))
+	for _, id := range uuids {
+		if _, ok := series[id]; ok == false {
+			return nil, sql.ErrNoRows
+		}
+		if series[id] {
+			series[id] = false
+			unique = append(unique, id)
+		}
+	}
+
+	if len(unique) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	c := make(chan TsDataEvent, subscriptionBufferSize)
+	s := &TsDataSubscription{
+		C:     c,
+		c:     c,
+		hub:   tsdataHubFor(svc.db),
+		uuids: unique,
+	}
+
+	if err := s.hub.add(ctx, s); err != nil {
+		return nil, err
+	}
+
+	// Register the time series before the subscription is returned, so that no data committed after that is missed
+	if err := s.hub.register(ctx, s); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// newTestSubscription registers a subscription with a hub that pretends to be listening
+func newTestSubscription(h *tsdataHub, uuids ...uuid.UUID) *TsDataSubscription {
+	c := make(chan TsDataEvent, subscriptionBufferSize)
+	s := &TsDataSubscription{
+		C:     c,
+		c:     c,
+		hub:   h,
+		uuids: uuids,
+	}
+
+	if h.cancel == nil {
+		h.cancel = func() {}
+	}
+
+	if err := h.add(context.Background(), s); err != nil {
+		log.Fatal(err)
+	}
+
+	return s
+}
+
+func TestTsDataHub(t *testing.T) {
+	h := &tsdataHub{
+		subs: make(map[uuid.UUID]map[*TsDataSubscription]bool),
+	}
+
+	a, b := uuid.New(), uuid.New()
+	subA := newTestSubscription(h, a)
+	subAB := newTestSubscription(h, a, b)
+
+	event := TsDataEvent{
+		Uuid: b,
+		Unit: "C",
+		Data: []DataPoint{{Value: 1.5, Timestamp: time.Date(2021, 11, 20, 10, 0, 0, 0, time.UTC)}},
+	}
+	if h.send(event) == false {
+		log.Fatal("Event should have subscriptions")
+	}
+	if len(subA.C) != 0 || len(subAB.C) != 1 {
+		log.Fatal("Event should only reach the subscriptions of its time series")
+	}
+
+	e := <-subAB.C
+	if e.Uuid != b || e.Unit != "C" || len(e.Data) != 1 || e.Data[0].Value != 1.5 || e.Data[0].Timestamp.Hour() != 10 {
+		log.Fatal("Event does not match expected")
+	}
+
+	// Malformed payloads, and notifications of time series no longer subscribed to, are ignored without reading data
+	h.dispatch(context.Background(), "{")
+	h.dispatch(context.Background(), fmt.Sprintf(`{"uuid" : "%v", "start" : "2021-11-20T10:00:00+00:00", "stop" : "2021-11-20T10:00:00+00:00"}`, uuid.New()))
+
+	// A subscription that falls behind is closed, the others are not affected
+	for i := 0; i <= subscriptionBufferSize; i++ {
+		h.send(TsDataEvent{Uuid: b, Unit: "C", Data: []DataPoint{}})
+	}
+	if h.send(TsDataEvent{Uuid: b}) {
+		log.Fatal("Event should have no subscriptions left")
+	}
+	for range subAB.C {
+	}
+	if subAB.Err() != ErrSubscriptionSlow {
+		log.Fatal("Slow subscription should be closed")
+	}
+
+	subAB.Close()
+	if h.count != 1 || len(h.subs[b]) != 0 {
+		log.Fatal("Slow subscription should be removed")
+	}
+
+	subA.Close()
+	subA.Close()
+	if _, ok := <-subA.C; ok || subA.Err() != nil {
+		log.Fatal("Closed subscription should end without error")
+	}
+	if h.count != 0 || len(h.subs) != 0 || h.cancel != nil {
+		log.Fatal("Hub should stop listening without subscriptions")
+	}
+}
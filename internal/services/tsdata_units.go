@@ -0,0 +1,260 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+
+	units "github.com/ganehag/go-units"
+)
+
+// Unit "1" of counts and other quantities without a unit, which the units library does not provide
+var _ = units.NewUnit("dimensionless", "1", units.UnitOptionQuantity("dimensionless"), units.UnitOptionPlural("none"))
+
+// resultUnit is the unit a time series is expressed in within a query result
+type resultUnit struct {
+	// Unit of the values in the result
+	Unit string
+	// Set when the requested unit can not be used for the time series
+	Err error
+
+	convert bool
+	from    units.Unit
+	to      units.Unit
+}
+
+// Convert converts an aggregated value from the unit of the time series to the unit of the result
+func (u *resultUnit) Convert(aggregate string, v float64) (float64, error) {
+	if u.convert == false {
+		return v, nil
+	}
+	return convertAggregate(aggregate, v, u.from, u.to)
+}
+
+// newResultUnit resolves the conversion from the unit stored with a time series to a requested unit, if any
+func newResultUnit(stored string, requested *string) *resultUnit {
+	if requested == nil || *requested == stored {
+		return &resultUnit{Unit: stored}
+	}
+
+	u := &resultUnit{Unit: *requested}
+
+	var err error
+	u.to, err = units.Find(*requested)
+	if err != nil {
+		u.Err = fmt.Errorf("unit %v is not a known unit", *requested)
+		return u
+	}
+
+	u.from, err = units.Find(stored)
+	if err != nil {
+		// This should never error out, as there should be no incompatible units in the DB
+		u.Err = fmt.Errorf("unit %v of the timeseries is not a known unit", stored)
+		return u
+	}
+
+	if _, err := units.NewValue(1, u.from).Convert(u.to); err != nil {
+		u.Err = fmt.Errorf("unit %v is not compatible with the unit %v of the timeseries", *requested, stored)
+		return u
+	}
+
+	u.convert = true
+
+	return u
+}
+
+// resolveResultUnits resolves the unit of every time series in a multi-series query.
+// A unit in requested takes precedence over unit, which applies to all time series.
+func (svc *TimeseriesService) resolveResultUnits(ctx context.Context, uuids []uuid.UUID, unit *string, requested map[uuid.UUID]string) (map[uuid.UUID]*resultUnit, error) {
+	found, err := svc.q.GetTimeseriesByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make(map[uuid.UUID]string, len(found))
+	for _, item := range found {
+		stored[item.Uuid] = item.SiUnit
+	}
+
+	result := make(map[uuid.UUID]*resultUnit, len(uuids))
+	for _, id := range uuids {
+		r := unit
+		if v, ok := requested[id]; ok {
+			r = &v
+		}
+		result[id] = newResultUnit(stored[id], r)
+	}
+
+	return result, nil
+}
+
+// firstUnitError returns the first unit error in the order of uuids, as a bad request
+func firstUnitError(uuids []uuid.UUID, resolved map[uuid.UUID]*resultUnit) error {
+	for _, id := range uuids {
+		if err := resolved[id].Err; err != nil {
+			return ie.NewBadRequestError(fmt.Errorf("timeseries %v: %v", id, err))
+		}
+	}
+	return nil
+}
+
+// validateUnit checks that a unit is known, so that data can be converted to and from it later on
+func validateUnit(s string) error {
+	if _, err := units.Find(s); err != nil {
+		return ie.NewBadRequestError(fmt.Errorf("si_unit %v is not a known unit", s))
+	}
+	return nil
+}
+
+// linearConversion converts a value between two units as value * Scale + Shift
+type linearConversion struct {
+	Scale float64
+	Shift float64
+}
+
+// newLinearConversion resolves the conversion from one unit to another.
+// The stored data of a time series is rewritten in SQL, which requires the conversion to be linear and increasing
+// so that the rollups (min, max, sum) of the time series can be converted as well.
+func newLinearConversion(from, to string) (*linearConversion, error) {
+	fromUnit, err := units.Find(from)
+	if err != nil {
+		return nil, ie.NewBadRequestError(fmt.Errorf("si_unit %v is not a known unit", from))
+	}
+
+	toUnit, err := units.Find(to)
+	if err != nil {
+		return nil, ie.NewBadRequestError(fmt.Errorf("si_unit %v is not a known unit", to))
+	}
+
+	convert := func(v float64) (float64, error) {
+		conv, err := units.NewValue(v, fromUnit).Convert(toUnit)
+		if err != nil {
+			return 0, ie.NewBadRequestError(fmt.Errorf("si_unit %v can not be converted to %v", from, to))
+		}
+		return conv.Float(), nil
+	}
+
+	shift, err := convert(0)
+	if err != nil {
+		return nil, err
+	}
+
+	one, err := convert(1)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &linearConversion{
+		Scale: one - shift,
+		Shift: shift,
+	}
+
+	if c.Scale <= 0 || math.IsInf(c.Scale, 0) || math.IsNaN(c.Scale) {
+		return nil, ie.NewBadRequestError(fmt.Errorf("si_unit %v can not be converted to %v in stored data", from, to))
+	}
+
+	// Verify that the conversion is linear away from zero and one
+	for _, v := range []float64{-1000, 1000} {
+		conv, err := convert(v)
+		if err != nil {
+			return nil, err
+		}
+		if math.Abs(c.Apply(v)-conv) > 1e-9*math.Max(1, math.Abs(conv)) {
+			return nil, ie.NewBadRequestError(fmt.Errorf("si_unit %v can not be converted to %v in stored data", from, to))
+		}
+	}
+
+	return c, nil
+}
+
+// Apply converts a single value
+func (c *linearConversion) Apply(v float64) float64 {
+	return v*c.Scale + c.Shift
+}
+
+// convertStoredData rewrites the data, archives, rollups, quarantine and bounds of a time series from its current unit to the unit to.
+// Bounds that are set by the same update are left as is. Use within the transaction that changes the unit.
+func convertStoredData(ctx context.Context, q *postgres.Queries, p UpdateTimeseriesParams) error {
+	series, err := q.GetTimeseriesByUUID(ctx, p.Uuid)
+	if err != nil {
+		return err
+	}
+
+	// Derived time series store no data
+	if series.SiUnit == *p.SiUnit || series.Expression.Valid {
+		return nil
+	}
+
+	c, err := newLinearConversion(series.SiUnit, *p.SiUnit)
+	if err != nil {
+		return err
+	}
+
+	// Keep writers from refreshing the rollups while the data is converted
+	if err := q.LockTsDataRollup(ctx, p.Uuid); err != nil {
+		return err
+	}
+
+	// Converted data points are not new, keep them out of live subscriptions
+	if err := q.DisableTsDataNotify(ctx); err != nil {
+		return err
+	}
+
+	if _, err := q.ConvertTsDataValues(ctx, postgres.ConvertTsDataValuesParams{
+		Scale:  c.Scale,
+		Shift:  c.Shift,
+		TsUuid: p.Uuid,
+	}); err != nil {
+		return err
+	}
+
+	if err := convertArchivedData(ctx, q, p.Uuid, c); err != nil {
+		return err
+	}
+
+	if _, err := q.ConvertTsDataRollupValues(ctx, postgres.ConvertTsDataRollupValuesParams{
+		Scale:  c.Scale,
+		Shift:  c.Shift,
+		TsUuid: p.Uuid,
+	}); err != nil {
+		return err
+	}
+
+	if _, err := q.ConvertTsDataQuarantineValues(ctx, postgres.ConvertTsDataQuarantineValuesParams{
+		Scale:  c.Scale,
+		Shift:  c.Shift,
+		TsUuid: p.Uuid,
+	}); err != nil {
+		return err
+	}
+
+	if p.LowerBound == nil && series.LowerBound.Valid {
+		if _, err := q.SetTimeseriesLowerBound(ctx, postgres.SetTimeseriesLowerBoundParams{
+			Uuid:       p.Uuid,
+			LowerBound: sql.NullFloat64{Float64: c.Apply(series.LowerBound.Float64), Valid: true},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if p.UpperBound == nil && series.UpperBound.Valid {
+		if _, err := q.SetTimeseriesUpperBound(ctx, postgres.SetTimeseriesUpperBoundParams{
+			Uuid:       p.Uuid,
+			UpperBound: sql.NullFloat64{Float64: c.Apply(series.UpperBound.Float64), Valid: true},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
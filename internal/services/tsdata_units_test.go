@@ -0,0 +1,84 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"log"
+	"math"
+	"testing"
+)
+
+func TestResultUnit(t *testing.T) {
+	same := "C"
+	u := newResultUnit("C", &same)
+	if u.Err != nil || u.Unit != "C" || u.convert {
+		log.Fatal("Same unit should not convert")
+	}
+
+	u = newResultUnit("C", nil)
+	if u.Err != nil || u.Unit != "C" {
+		log.Fatal("No unit should keep the unit of the time series")
+	}
+
+	to := "F"
+	u = newResultUnit("C", &to)
+	if u.Err != nil || u.Unit != "F" {
+		log.Fatal("Compatible unit should convert")
+	}
+	v, err := u.Convert("avg", 10)
+	if err != nil {
+		log.Fatal(err)
+	} else if math.Abs(v-50) > 1e-9 {
+		log.Fatal("Converted value does not match expected")
+	}
+
+	incompatible := "m"
+	if u := newResultUnit("C", &incompatible); u.Err == nil {
+		log.Fatal("Expected error for incompatible unit")
+	}
+
+	unknown := "no-such-unit"
+	if u := newResultUnit("C", &unknown); u.Err == nil {
+		log.Fatal("Expected error for unknown unit")
+	}
+}
+
+func TestValidateUnit(t *testing.T) {
+	if err := validateUnit("kW"); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := validateUnit("1"); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := validateUnit("no-such-unit"); err == nil {
+		log.Fatal("Expected error for unknown unit")
+	}
+
+	if err := validateUnit(""); err == nil {
+		log.Fatal("Expected error for empty unit")
+	}
+}
+
+func TestLinearConversion(t *testing.T) {
+	c, err := newLinearConversion("W", "kW")
+	if err != nil {
+		log.Fatal(err)
+	} else if math.Abs(c.Apply(1500)-1.5) > 1e-9 {
+		log.Fatal("Converted value does not match expected")
+	}
+
+	c, err = newLinearConversion("C", "F")
+	if err != nil {
+		log.Fatal(err)
+	} else if math.Abs(c.Apply(100)-212) > 1e-9 || math.Abs(c.Shift-32) > 1e-9 {
+		log.Fatal("Converted value does not match expected")
+	}
+
+	if _, err := newLinearConversion("C", "m"); err == nil {
+		log.Fatal("Expected error for incompatible unit")
+	}
+}
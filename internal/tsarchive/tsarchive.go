@@ -0,0 +1,202 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// Package tsarchive encodes and decodes the data points of a time series archived as a dataset.
+//
+// An archive starts with the magic bytes "SHTA" and a version byte, followed by a gzip stream of
+// the number of points, the timestamps, the values and then the qualities, each as a column:
+//
+//   - Timestamps are microseconds since the Unix epoch; the first one as a varint, the second as a
+//     varint delta and every later one as a varint delta of deltas.
+//   - Values are float64; the first one as 8 bytes, every later one as a uvarint of its bits XOR
+//     the bits of the value before.
+//   - Qualities are one byte each. Archives of version 1 have no qualities.
+//
+// Regular timestamps and slowly changing values mostly encode as zeros, which gzip compresses well.
+package tsarchive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+)
+
+const (
+	magic   = "SHTA"
+	version = 2
+
+	// Most points in an archive, to bound the memory used to decode one
+	maxPoints = 50000000
+)
+
+var ErrMalformed = errors.New("malformed time series archive")
+
+// Point is a data point of a time series
+type Point struct {
+	// Microseconds since the Unix epoch
+	Ts    int64
+	Value float64
+	// Zero for a plain measurement
+	Quality uint8
+}
+
+// Encode encodes points in ascending timestamp order as an archive
+func Encode(points []Point) ([]byte, error) {
+	if len(points) > maxPoints {
+		return nil, fmt.Errorf("archive of %d points exceeds the limit of %d", len(points), maxPoints)
+	}
+
+	for i := 1; i < len(points); i++ {
+		if points[i].Ts <= points[i-1].Ts {
+			return nil, fmt.Errorf("points are not in ascending timestamp order")
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+	buf.WriteByte(version)
+
+	zw := gzip.NewWriter(&buf)
+	w := bufio.NewWriter(zw)
+	tmp := make([]byte, binary.MaxVarintLen64)
+
+	n := binary.PutUvarint(tmp, uint64(len(points)))
+	w.Write(tmp[:n])
+
+	var prev, delta int64
+	for i, p := range points {
+		switch i {
+		case 0:
+			n = binary.PutVarint(tmp, p.Ts)
+		case 1:
+			delta = p.Ts - prev
+			n = binary.PutVarint(tmp, delta)
+		default:
+			d := p.Ts - prev
+			n = binary.PutVarint(tmp, d-delta)
+			delta = d
+		}
+		w.Write(tmp[:n])
+		prev = p.Ts
+	}
+
+	var bits uint64
+	for i, p := range points {
+		b := math.Float64bits(p.Value)
+		if i == 0 {
+			binary.LittleEndian.PutUint64(tmp, b)
+			w.Write(tmp[:8])
+		} else {
+			n = binary.PutUvarint(tmp, b^bits)
+			w.Write(tmp[:n])
+		}
+		bits = b
+	}
+
+	for _, p := range points {
+		w.WriteByte(p.Quality)
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decode decodes the points of an archive
+func Decode(data []byte) ([]Point, error) {
+	if len(data) < len(magic)+1 || string(data[:len(magic)]) != magic {
+		return nil, ErrMalformed
+	}
+
+	v := data[len(magic)]
+	if v < 1 || v > version {
+		return nil, fmt.Errorf("unsupported time series archive version %d", v)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data[len(magic)+1:]))
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	defer zr.Close()
+	r := bufio.NewReader(zr)
+
+	count, err := binary.ReadUvarint(r)
+	if err != nil || count > maxPoints {
+		return nil, ErrMalformed
+	}
+
+	// Grow with the data read, rather than trust the count up front
+	capacity := count
+	if capacity > 65536 {
+		capacity = 65536
+	}
+	points := make([]Point, 0, capacity)
+
+	var prev, delta int64
+	for i := 0; i < int(count); i++ {
+		v, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, ErrMalformed
+		}
+
+		ts := v
+		switch i {
+		case 0:
+		case 1:
+			delta = v
+			ts = prev + delta
+		default:
+			delta += v
+			ts = prev + delta
+		}
+		points = append(points, Point{Ts: ts})
+		prev = ts
+	}
+
+	var bits uint64
+	for i := range points {
+		if i == 0 {
+			var tmp [8]byte
+			if _, err := io.ReadFull(r, tmp[:]); err != nil {
+				return nil, ErrMalformed
+			}
+			bits = binary.LittleEndian.Uint64(tmp[:])
+		} else {
+			x, err := binary.ReadUvarint(r)
+			if err != nil {
+				return nil, ErrMalformed
+			}
+			bits ^= x
+		}
+		points[i].Value = math.Float64frombits(bits)
+	}
+
+	if v >= 2 {
+		for i := range points {
+			q, err := r.ReadByte()
+			if err != nil {
+				return nil, ErrMalformed
+			}
+			points[i].Quality = q
+		}
+	}
+
+	// Nothing may follow the values
+	if rest, err := ioutil.ReadAll(r); err != nil || len(rest) > 0 {
+		return nil, ErrMalformed
+	}
+
+	return points, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package tsarchive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"log"
+	"math"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	points := []Point{
+		{Ts: 1637402400000000, Value: 21.5},
+		{Ts: 1637402401000000, Value: 21.5},
+		{Ts: 1637402402000000, Value: 21.625, Quality: 2},
+		{Ts: 1637402403000123, Value: -3, Quality: 4},
+		{Ts: 1637402410000000, Value: math.Inf(1)},
+		{Ts: -1000000, Value: 0},
+	}
+	// The last point is out of order
+	if _, err := Encode(points); err == nil {
+		log.Fatal("Expected error for points out of order")
+	}
+	points = points[:5]
+
+	data, err := Encode(points)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	decoded, err := Decode(data)
+	if err != nil {
+		log.Fatal(err)
+	} else if len(decoded) != len(points) {
+		log.Fatalf("Expected %d points, got %d", len(points), len(decoded))
+	}
+	for i := range points {
+		if decoded[i] != points[i] {
+			log.Fatalf("Point %d does not match expected: %v", i, decoded[i])
+		}
+	}
+
+	empty, err := Encode([]Point{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if decoded, err := Decode(empty); err != nil || len(decoded) != 0 {
+		log.Fatalf("Expected no points, got %v %v", decoded, err)
+	}
+}
+
+func TestCompression(t *testing.T) {
+	// A day of data every second, with a slowly changing value
+	points := make([]Point, 86400)
+	for i := range points {
+		points[i] = Point{Ts: 1637366400000000 + int64(i)*1000000, Value: float64(20 + i/3600)}
+	}
+
+	data, err := Encode(points)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(data) > 86400 {
+		log.Fatalf("Expected less than a byte per point, got %d bytes", len(data))
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	data, err := Encode([]Point{{Ts: 1, Value: 1}, {Ts: 2, Value: 2}})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, c := range [][]byte{
+		nil,
+		[]byte("SHTA"),
+		[]byte("XXXX\x01"),
+		data[:len(data)-4],
+	} {
+		if _, err := Decode(c); err == nil {
+			log.Fatalf("Expected error for %q", c)
+		}
+	}
+
+	unsupported := append([]byte{}, data...)
+	unsupported[4] = 3
+	if _, err := Decode(unsupported); err == nil {
+		log.Fatal("Expected error for unsupported version")
+	}
+}
+
+func TestDecodeVersion1(t *testing.T) {
+	// Two points, without the column of qualities
+	var body bytes.Buffer
+	tmp := make([]byte, binary.MaxVarintLen64)
+	body.Write(tmp[:binary.PutUvarint(tmp, 2)])
+	body.Write(tmp[:binary.PutVarint(tmp, 1000000)])
+	body.Write(tmp[:binary.PutVarint(tmp, 1000000)])
+	binary.LittleEndian.PutUint64(tmp, math.Float64bits(1.5))
+	body.Write(tmp[:8])
+	body.Write(tmp[:binary.PutUvarint(tmp, 0)])
+
+	var buf bytes.Buffer
+	buf.WriteString("SHTA\x01")
+	zw := gzip.NewWriter(&buf)
+	zw.Write(body.Bytes())
+	zw.Close()
+
+	decoded, err := Decode(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	expected := []Point{{Ts: 1000000, Value: 1.5}, {Ts: 2000000, Value: 1.5}}
+	if len(decoded) != len(expected) {
+		log.Fatalf("Expected %d points, got %d", len(expected), len(decoded))
+	}
+	for i := range expected {
+		if decoded[i] != expected[i] {
+			log.Fatalf("Point %d does not match expected: %v", i, decoded[i])
+		}
+	}
+}
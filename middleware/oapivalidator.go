@@ -6,6 +6,7 @@ package middleware
 
 import (
 	"context"
+	"mime"
 	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -15,6 +16,22 @@ import (
 	ie "github.com/self-host/self-host/internal/errors"
 )
 
+// Request bodies of these content types are streamed or decoded by the handler and are
+// therefore not read into memory for validation.
+var streamingContentTypes = map[string]bool{
+	"application/x-ndjson":   true,
+	"application/x-protobuf": true,
+	"text/csv":               true,
+}
+
+func isStreamingBody(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return streamingContentTypes[mediaType]
+}
+
 // Validate a request against the OpenAPI specification
 func OapiRequestValidator(swagger *openapi3.T) func(http.HandlerFunc) http.HandlerFunc {
 	return OapiRequestValidatorWithOptions(swagger, nil)
@@ -41,6 +58,7 @@ func OapiRequestValidatorWithOptions(swagger *openapi3.T, options *Options) func
 				PathParams: pathParams,
 				Route:      route,
 				Options: &openapi3filter.Options{
+					ExcludeRequestBody: isStreamingBody(r),
 					AuthenticationFunc: func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 						return nil
 					},
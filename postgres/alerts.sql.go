@@ -312,6 +312,123 @@ func (q *Queries) FindAlerts(ctx context.Context, arg FindAlertsParams) ([]FindA
 	return items, nil
 }
 
+const findAlertsByTimeRange = `-- name: FindAlertsByTimeRange :many
+-- Alerts received within the time range, from the first to the last time they were received
+SELECT uuid, resource, environment, event, severity, previous_severity, status, description, value, origin, created, last_receive_time, timeout, duplicate, service, tags, rawdata
+FROM v_alerts
+WHERE v_alerts.created <= $1::timestamptz
+AND COALESCE(v_alerts.last_receive_time, v_alerts.created) >= $2::timestamptz
+AND (
+	NULLIF($3::TEXT, '') IS NULL
+	OR
+	$3::TEXT = v_alerts.resource
+)
+AND (
+	NULLIF($4::TEXT, '') IS NULL
+	OR
+	$4::TEXT = v_alerts.environment
+)
+AND (
+	NULLIF($5::TEXT, '') IS NULL
+	OR
+	$5::TEXT = v_alerts.event
+)
+AND (
+	NULLIF($6::TEXT, '') IS NULL
+	OR
+	$6::TEXT = v_alerts.origin
+)
+AND (
+	NULLIF($7::TEXT, '') IS NULL
+	OR
+	$7::TEXT = v_alerts.status::TEXT
+)
+AND (
+	NULLIF($8::TEXT, '') IS NULL
+	OR
+	$8::TEXT = v_alerts.severity::TEXT
+)
+AND (
+	NULLIF($9::TEXT[], ARRAY[]::TEXT[]) IS NULL
+	OR
+	$9::TEXT[] && v_alerts.service
+)
+AND (
+	NULLIF($10::TEXT[], ARRAY[]::TEXT[]) IS NULL
+	OR
+	$10::TEXT[] && v_alerts.tags
+)
+ORDER BY v_alerts.created, v_alerts.uuid
+LIMIT $11::BIGINT
+`
+
+type FindAlertsByTimeRangeParams struct {
+	Stop        time.Time
+	Start       time.Time
+	Resource    string
+	Environment string
+	Event       string
+	Origin      string
+	Status      string
+	Severity    string
+	Service     []string
+	Tags        []string
+	ArgLimit    int64
+}
+
+func (q *Queries) FindAlertsByTimeRange(ctx context.Context, arg FindAlertsByTimeRangeParams) ([]VAlert, error) {
+	rows, err := q.query(ctx, q.findAlertsByTimeRangeStmt, findAlertsByTimeRange,
+		arg.Stop,
+		arg.Start,
+		arg.Resource,
+		arg.Environment,
+		arg.Event,
+		arg.Origin,
+		arg.Status,
+		arg.Severity,
+		pq.Array(arg.Service),
+		pq.Array(arg.Tags),
+		arg.ArgLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VAlert{}
+	for rows.Next() {
+		var i VAlert
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.Resource,
+			&i.Environment,
+			&i.Event,
+			&i.Severity,
+			&i.PreviousSeverity,
+			&i.Status,
+			&i.Description,
+			&i.Value,
+			&i.Origin,
+			&i.Created,
+			&i.LastReceiveTime,
+			&i.Timeout,
+			&i.Duplicate,
+			pq.Array(&i.Service),
+			pq.Array(&i.Tags),
+			&i.Rawdata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateAlertIncDuplicate = `-- name: UpdateAlertIncDuplicate :execrows
 UPDATE alerts
 SET duplicate = duplicate + 1
@@ -140,6 +140,13 @@ func (q *Queries) ExistsDataset(ctx context.Context, uuid uuid.UUID) (int64, err
 }
 
 const findDatasetByThing = `-- name: FindDatasetByThing :many
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+)
 SELECT
 	uuid,
 	name,
@@ -153,10 +160,22 @@ SELECT
 	updated_by,
 	tags
 FROM datasets
-WHERE datasets.belongs_to = $1
+WHERE datasets.belongs_to = $2
+-- Archived time series data is only listed with read access to the data of the time series
+AND NOT EXISTS (
+	SELECT 1
+	FROM tsdata_archive
+	WHERE tsdata_archive.dataset_uuid = datasets.uuid
+	AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||tsdata_archive.ts_uuid||'/data') = false
+)
 ORDER BY name
 `
 
+type FindDatasetByThingParams struct {
+	Token     []byte
+	ThingUuid uuid.UUID
+}
+
 type FindDatasetByThingRow struct {
 	Uuid      uuid.UUID
 	Name      string
@@ -171,8 +190,8 @@ type FindDatasetByThingRow struct {
 	Tags      []string
 }
 
-func (q *Queries) FindDatasetByThing(ctx context.Context, thingUuid uuid.UUID) ([]FindDatasetByThingRow, error) {
-	rows, err := q.query(ctx, q.findDatasetByThingStmt, findDatasetByThing, thingUuid)
+func (q *Queries) FindDatasetByThing(ctx context.Context, arg FindDatasetByThingParams) ([]FindDatasetByThingRow, error) {
+	rows, err := q.query(ctx, q.findDatasetByThingStmt, findDatasetByThing, arg.Token, arg.ThingUuid)
 	if err != nil {
 		return nil, err
 	}
@@ -287,6 +306,13 @@ FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+-- Archived time series data is only listed with read access to the data of the time series
+AND NOT EXISTS (
+	SELECT 1
+	FROM tsdata_archive
+	WHERE tsdata_archive.dataset_uuid = datasets.uuid
+	AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||tsdata_archive.ts_uuid||'/data') = false
+)
 EXCEPT
 SELECT
 	uuid,
@@ -394,6 +420,13 @@ FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+-- Archived time series data is only listed with read access to the data of the time series
+AND NOT EXISTS (
+	SELECT 1
+	FROM tsdata_archive
+	WHERE tsdata_archive.dataset_uuid = datasets.uuid
+	AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||tsdata_archive.ts_uuid||'/data') = false
+)
 AND $4 && datasets.tags
 EXCEPT
 SELECT
@@ -22,21 +22,51 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addAnnotationThingsStmt, err = db.PrepareContext(ctx, addAnnotationThings); err != nil {
+		return nil, fmt.Errorf("error preparing query AddAnnotationThings: %w", err)
+	}
+	if q.addAnnotationTimeseriesStmt, err = db.PrepareContext(ctx, addAnnotationTimeseries); err != nil {
+		return nil, fmt.Errorf("error preparing query AddAnnotationTimeseries: %w", err)
+	}
 	if q.addTokenToUserStmt, err = db.PrepareContext(ctx, addTokenToUser); err != nil {
 		return nil, fmt.Errorf("error preparing query AddTokenToUser: %w", err)
 	}
+	if q.addTsDataRollupPartsStmt, err = db.PrepareContext(ctx, addTsDataRollupParts); err != nil {
+		return nil, fmt.Errorf("error preparing query AddTsDataRollupParts: %w", err)
+	}
 	if q.addUserToGroupStmt, err = db.PrepareContext(ctx, addUserToGroup); err != nil {
 		return nil, fmt.Errorf("error preparing query AddUserToGroup: %w", err)
 	}
+	if q.beginTsDataPartitioningStmt, err = db.PrepareContext(ctx, beginTsDataPartitioning); err != nil {
+		return nil, fmt.Errorf("error preparing query BeginTsDataPartitioning: %w", err)
+	}
 	if q.checkUserTokenHasAccessStmt, err = db.PrepareContext(ctx, checkUserTokenHasAccess); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckUserTokenHasAccess: %w", err)
 	}
 	if q.checkUserTokenHasAccessManyStmt, err = db.PrepareContext(ctx, checkUserTokenHasAccessMany); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckUserTokenHasAccessMany: %w", err)
 	}
+	if q.convertTsDataQuarantineValuesStmt, err = db.PrepareContext(ctx, convertTsDataQuarantineValues); err != nil {
+		return nil, fmt.Errorf("error preparing query ConvertTsDataQuarantineValues: %w", err)
+	}
+	if q.convertTsDataRollupValuesStmt, err = db.PrepareContext(ctx, convertTsDataRollupValues); err != nil {
+		return nil, fmt.Errorf("error preparing query ConvertTsDataRollupValues: %w", err)
+	}
+	if q.convertTsDataValuesStmt, err = db.PrepareContext(ctx, convertTsDataValues); err != nil {
+		return nil, fmt.Errorf("error preparing query ConvertTsDataValues: %w", err)
+	}
+	if q.copyTsDataToPartitionsStmt, err = db.PrepareContext(ctx, copyTsDataToPartitions); err != nil {
+		return nil, fmt.Errorf("error preparing query CopyTsDataToPartitions: %w", err)
+	}
+	if q.countTimeseriesUsingInputStmt, err = db.PrepareContext(ctx, countTimeseriesUsingInput); err != nil {
+		return nil, fmt.Errorf("error preparing query CountTimeseriesUsingInput: %w", err)
+	}
 	if q.createAlertStmt, err = db.PrepareContext(ctx, createAlert); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateAlert: %w", err)
 	}
+	if q.createAnnotationStmt, err = db.PrepareContext(ctx, createAnnotation); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAnnotation: %w", err)
+	}
 	if q.createCodeRevisionStmt, err = db.PrepareContext(ctx, createCodeRevision); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCodeRevision: %w", err)
 	}
@@ -61,6 +91,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createTsDataStmt, err = db.PrepareContext(ctx, createTsData); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTsData: %w", err)
 	}
+	if q.createTsDataArchiveStmt, err = db.PrepareContext(ctx, createTsDataArchive); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTsDataArchive: %w", err)
+	}
+	if q.createTsDataPartitionStmt, err = db.PrepareContext(ctx, createTsDataPartition); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTsDataPartition: %w", err)
+	}
+	if q.createTsDataRollupRangeStmt, err = db.PrepareContext(ctx, createTsDataRollupRange); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTsDataRollupRange: %w", err)
+	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
@@ -73,6 +112,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteAllTsDataStmt, err = db.PrepareContext(ctx, deleteAllTsData); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAllTsData: %w", err)
 	}
+	if q.deleteAnnotationStmt, err = db.PrepareContext(ctx, deleteAnnotation); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAnnotation: %w", err)
+	}
+	if q.deleteAnnotationThingsStmt, err = db.PrepareContext(ctx, deleteAnnotationThings); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAnnotationThings: %w", err)
+	}
+	if q.deleteAnnotationTimeseriesStmt, err = db.PrepareContext(ctx, deleteAnnotationTimeseries); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAnnotationTimeseries: %w", err)
+	}
 	if q.deleteDatasetStmt, err = db.PrepareContext(ctx, deleteDataset); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteDataset: %w", err)
 	}
@@ -97,15 +145,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteTokenFromUserStmt, err = db.PrepareContext(ctx, deleteTokenFromUser); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteTokenFromUser: %w", err)
 	}
+	if q.deleteTsDataArchiveStmt, err = db.PrepareContext(ctx, deleteTsDataArchive); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTsDataArchive: %w", err)
+	}
+	if q.deleteTsDataArchivesBeforeStmt, err = db.PrepareContext(ctx, deleteTsDataArchivesBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTsDataArchivesBefore: %w", err)
+	}
 	if q.deleteTsDataRangeStmt, err = db.PrepareContext(ctx, deleteTsDataRange); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteTsDataRange: %w", err)
 	}
+	if q.deleteTsDataRangeReturningStmt, err = db.PrepareContext(ctx, deleteTsDataRangeReturning); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTsDataRangeReturning: %w", err)
+	}
+	if q.deleteTsDataRollupRangeStmt, err = db.PrepareContext(ctx, deleteTsDataRollupRange); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTsDataRollupRange: %w", err)
+	}
 	if q.deleteUserStmt, err = db.PrepareContext(ctx, deleteUser); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteUser: %w", err)
 	}
+	if q.disableTsDataNotifyStmt, err = db.PrepareContext(ctx, disableTsDataNotify); err != nil {
+		return nil, fmt.Errorf("error preparing query DisableTsDataNotify: %w", err)
+	}
+	if q.dropTsDataPartitionStmt, err = db.PrepareContext(ctx, dropTsDataPartition); err != nil {
+		return nil, fmt.Errorf("error preparing query DropTsDataPartition: %w", err)
+	}
 	if q.existsAlertStmt, err = db.PrepareContext(ctx, existsAlert); err != nil {
 		return nil, fmt.Errorf("error preparing query ExistsAlert: %w", err)
 	}
+	if q.existsAnnotationStmt, err = db.PrepareContext(ctx, existsAnnotation); err != nil {
+		return nil, fmt.Errorf("error preparing query ExistsAnnotation: %w", err)
+	}
 	if q.existsDatasetStmt, err = db.PrepareContext(ctx, existsDataset); err != nil {
 		return nil, fmt.Errorf("error preparing query ExistsDataset: %w", err)
 	}
@@ -133,12 +202,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.findAlertsStmt, err = db.PrepareContext(ctx, findAlerts); err != nil {
 		return nil, fmt.Errorf("error preparing query FindAlerts: %w", err)
 	}
+	if q.findAlertsByTimeRangeStmt, err = db.PrepareContext(ctx, findAlertsByTimeRange); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAlertsByTimeRange: %w", err)
+	}
 	if q.findAllModulesStmt, err = db.PrepareContext(ctx, findAllModules); err != nil {
 		return nil, fmt.Errorf("error preparing query FindAllModules: %w", err)
 	}
 	if q.findAllRoutineRevisionsStmt, err = db.PrepareContext(ctx, findAllRoutineRevisions); err != nil {
 		return nil, fmt.Errorf("error preparing query FindAllRoutineRevisions: %w", err)
 	}
+	if q.findAnnotationByUUIDStmt, err = db.PrepareContext(ctx, findAnnotationByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAnnotationByUUID: %w", err)
+	}
+	if q.findAnnotationsStmt, err = db.PrepareContext(ctx, findAnnotations); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAnnotations: %w", err)
+	}
+	if q.findAnnotationsByTimeseriesStmt, err = db.PrepareContext(ctx, findAnnotationsByTimeseries); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAnnotationsByTimeseries: %w", err)
+	}
 	if q.findDatasetByThingStmt, err = db.PrepareContext(ctx, findDatasetByThing); err != nil {
 		return nil, fmt.Errorf("error preparing query FindDatasetByThing: %w", err)
 	}
@@ -196,6 +277,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.findTimeseriesStmt, err = db.PrepareContext(ctx, findTimeseries); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTimeseries: %w", err)
 	}
+	if q.findTimeseriesByEachTagStmt, err = db.PrepareContext(ctx, findTimeseriesByEachTag); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesByEachTag: %w", err)
+	}
+	if q.findTimeseriesBySelectorStmt, err = db.PrepareContext(ctx, findTimeseriesBySelector); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesBySelector: %w", err)
+	}
+	if q.findTimeseriesByTagPrefixStmt, err = db.PrepareContext(ctx, findTimeseriesByTagPrefix); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesByTagPrefix: %w", err)
+	}
 	if q.findTimeseriesByTagsStmt, err = db.PrepareContext(ctx, findTimeseriesByTags); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTimeseriesByTags: %w", err)
 	}
@@ -205,15 +295,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.findTimeseriesByUUIDStmt, err = db.PrepareContext(ctx, findTimeseriesByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTimeseriesByUUID: %w", err)
 	}
+	if q.findTimeseriesWithRetentionStmt, err = db.PrepareContext(ctx, findTimeseriesWithRetention); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesWithRetention: %w", err)
+	}
+	if q.findTimeseriesWithTsDataBeforeStmt, err = db.PrepareContext(ctx, findTimeseriesWithTsDataBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesWithTsDataBefore: %w", err)
+	}
 	if q.findTokensByUserStmt, err = db.PrepareContext(ctx, findTokensByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTokensByUser: %w", err)
 	}
+	if q.findTsDataArchivesStmt, err = db.PrepareContext(ctx, findTsDataArchives); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTsDataArchives: %w", err)
+	}
+	if q.findTsDataArchivesByTimeseriesStmt, err = db.PrepareContext(ctx, findTsDataArchivesByTimeseries); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTsDataArchivesByTimeseries: %w", err)
+	}
+	if q.findTsDataPartitionsStmt, err = db.PrepareContext(ctx, findTsDataPartitions); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTsDataPartitions: %w", err)
+	}
+	if q.findTsDataQuarantineStmt, err = db.PrepareContext(ctx, findTsDataQuarantine); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTsDataQuarantine: %w", err)
+	}
+	if q.findTsDataTimeRangesStmt, err = db.PrepareContext(ctx, findTsDataTimeRanges); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTsDataTimeRanges: %w", err)
+	}
 	if q.findUserByUUIDStmt, err = db.PrepareContext(ctx, findUserByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query FindUserByUUID: %w", err)
 	}
 	if q.findUsersStmt, err = db.PrepareContext(ctx, findUsers); err != nil {
 		return nil, fmt.Errorf("error preparing query FindUsers: %w", err)
 	}
+	if q.finishTsDataPartitioningStmt, err = db.PrepareContext(ctx, finishTsDataPartitioning); err != nil {
+		return nil, fmt.Errorf("error preparing query FinishTsDataPartitioning: %w", err)
+	}
 	if q.getDatasetContentByUUIDStmt, err = db.PrepareContext(ctx, getDatasetContentByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetDatasetContentByUUID: %w", err)
 	}
@@ -235,24 +349,72 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getTimeseriesByUUIDStmt, err = db.PrepareContext(ctx, getTimeseriesByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTimeseriesByUUID: %w", err)
 	}
+	if q.getTimeseriesByUUIDsStmt, err = db.PrepareContext(ctx, getTimeseriesByUUIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTimeseriesByUUIDs: %w", err)
+	}
+	if q.getTsDataArchiveByDatasetStmt, err = db.PrepareContext(ctx, getTsDataArchiveByDataset); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataArchiveByDataset: %w", err)
+	}
+	if q.getTsDataArchiveContentStmt, err = db.PrepareContext(ctx, getTsDataArchiveContent); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataArchiveContent: %w", err)
+	}
+	if q.getTsDataArchivedHourStmt, err = db.PrepareContext(ctx, getTsDataArchivedHour); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataArchivedHour: %w", err)
+	}
+	if q.getTsDataFirstTimestampBeforeStmt, err = db.PrepareContext(ctx, getTsDataFirstTimestampBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataFirstTimestampBefore: %w", err)
+	}
+	if q.getTsDataLatestStmt, err = db.PrepareContext(ctx, getTsDataLatest); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataLatest: %w", err)
+	}
+	if q.getTsDataPartitioningStmt, err = db.PrepareContext(ctx, getTsDataPartitioning); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataPartitioning: %w", err)
+	}
 	if q.getTsDataRangeStmt, err = db.PrepareContext(ctx, getTsDataRange); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTsDataRange: %w", err)
 	}
 	if q.getTsDataRangeAggStmt, err = db.PrepareContext(ctx, getTsDataRangeAgg); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTsDataRangeAgg: %w", err)
 	}
+	if q.getTsDataRangeAggRollupStmt, err = db.PrepareContext(ctx, getTsDataRangeAggRollup); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataRangeAggRollup: %w", err)
+	}
+	if q.getTsDataStatsStmt, err = db.PrepareContext(ctx, getTsDataStats); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataStats: %w", err)
+	}
+	if q.getTsDataValueBeforeStmt, err = db.PrepareContext(ctx, getTsDataValueBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataValueBefore: %w", err)
+	}
+	if q.getTsDataVersionsStmt, err = db.PrepareContext(ctx, getTsDataVersions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataVersions: %w", err)
+	}
 	if q.getUnitFromTimeseriesStmt, err = db.PrepareContext(ctx, getUnitFromTimeseries); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUnitFromTimeseries: %w", err)
 	}
 	if q.getUserUuidFromTokenStmt, err = db.PrepareContext(ctx, getUserUuidFromToken); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserUuidFromToken: %w", err)
 	}
+	if q.lockTimeseriesTagStmt, err = db.PrepareContext(ctx, lockTimeseriesTag); err != nil {
+		return nil, fmt.Errorf("error preparing query LockTimeseriesTag: %w", err)
+	}
+	if q.lockTsDataRollupStmt, err = db.PrepareContext(ctx, lockTsDataRollup); err != nil {
+		return nil, fmt.Errorf("error preparing query LockTsDataRollup: %w", err)
+	}
+	if q.pruneTsDataListenersStmt, err = db.PrepareContext(ctx, pruneTsDataListeners); err != nil {
+		return nil, fmt.Errorf("error preparing query PruneTsDataListeners: %w", err)
+	}
+	if q.registerTsDataListenerStmt, err = db.PrepareContext(ctx, registerTsDataListener); err != nil {
+		return nil, fmt.Errorf("error preparing query RegisterTsDataListener: %w", err)
+	}
 	if q.removeUserFromAllGroupsStmt, err = db.PrepareContext(ctx, removeUserFromAllGroups); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveUserFromAllGroups: %w", err)
 	}
 	if q.removeUserFromGroupsStmt, err = db.PrepareContext(ctx, removeUserFromGroups); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveUserFromGroups: %w", err)
 	}
+	if q.searchTimeseriesStmt, err = db.PrepareContext(ctx, searchTimeseries); err != nil {
+		return nil, fmt.Errorf("error preparing query SearchTimeseries: %w", err)
+	}
 	if q.setDatasetContentByUUIDStmt, err = db.PrepareContext(ctx, setDatasetContentByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetDatasetContentByUUID: %w", err)
 	}
@@ -319,12 +481,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.setThingTypeByUUIDStmt, err = db.PrepareContext(ctx, setThingTypeByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetThingTypeByUUID: %w", err)
 	}
+	if q.setTimeseriesExpressionStmt, err = db.PrepareContext(ctx, setTimeseriesExpression); err != nil {
+		return nil, fmt.Errorf("error preparing query SetTimeseriesExpression: %w", err)
+	}
+	if q.setTimeseriesKindStmt, err = db.PrepareContext(ctx, setTimeseriesKind); err != nil {
+		return nil, fmt.Errorf("error preparing query SetTimeseriesKind: %w", err)
+	}
 	if q.setTimeseriesLowerBoundStmt, err = db.PrepareContext(ctx, setTimeseriesLowerBound); err != nil {
 		return nil, fmt.Errorf("error preparing query SetTimeseriesLowerBound: %w", err)
 	}
 	if q.setTimeseriesNameStmt, err = db.PrepareContext(ctx, setTimeseriesName); err != nil {
 		return nil, fmt.Errorf("error preparing query SetTimeseriesName: %w", err)
 	}
+	if q.setTimeseriesRetentionStmt, err = db.PrepareContext(ctx, setTimeseriesRetention); err != nil {
+		return nil, fmt.Errorf("error preparing query SetTimeseriesRetention: %w", err)
+	}
 	if q.setTimeseriesSiUnitStmt, err = db.PrepareContext(ctx, setTimeseriesSiUnit); err != nil {
 		return nil, fmt.Errorf("error preparing query SetTimeseriesSiUnit: %w", err)
 	}
@@ -385,21 +556,50 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateAlertSetValueStmt, err = db.PrepareContext(ctx, updateAlertSetValue); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateAlertSetValue: %w", err)
 	}
+	if q.updateAnnotationStmt, err = db.PrepareContext(ctx, updateAnnotation); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateAnnotation: %w", err)
+	}
+	if q.updateTsDataArchiveStmt, err = db.PrepareContext(ctx, updateTsDataArchive); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTsDataArchive: %w", err)
+	}
+	if q.upsertTsDataRollupHoursStmt, err = db.PrepareContext(ctx, upsertTsDataRollupHours); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertTsDataRollupHours: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addAnnotationThingsStmt != nil {
+		if cerr := q.addAnnotationThingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addAnnotationThingsStmt: %w", cerr)
+		}
+	}
+	if q.addAnnotationTimeseriesStmt != nil {
+		if cerr := q.addAnnotationTimeseriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addAnnotationTimeseriesStmt: %w", cerr)
+		}
+	}
 	if q.addTokenToUserStmt != nil {
 		if cerr := q.addTokenToUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addTokenToUserStmt: %w", cerr)
 		}
 	}
+	if q.addTsDataRollupPartsStmt != nil {
+		if cerr := q.addTsDataRollupPartsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addTsDataRollupPartsStmt: %w", cerr)
+		}
+	}
 	if q.addUserToGroupStmt != nil {
 		if cerr := q.addUserToGroupStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addUserToGroupStmt: %w", cerr)
 		}
 	}
+	if q.beginTsDataPartitioningStmt != nil {
+		if cerr := q.beginTsDataPartitioningStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing beginTsDataPartitioningStmt: %w", cerr)
+		}
+	}
 	if q.checkUserTokenHasAccessStmt != nil {
 		if cerr := q.checkUserTokenHasAccessStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing checkUserTokenHasAccessStmt: %w", cerr)
@@ -410,11 +610,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing checkUserTokenHasAccessManyStmt: %w", cerr)
 		}
 	}
+	if q.convertTsDataQuarantineValuesStmt != nil {
+		if cerr := q.convertTsDataQuarantineValuesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing convertTsDataQuarantineValuesStmt: %w", cerr)
+		}
+	}
+	if q.convertTsDataRollupValuesStmt != nil {
+		if cerr := q.convertTsDataRollupValuesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing convertTsDataRollupValuesStmt: %w", cerr)
+		}
+	}
+	if q.convertTsDataValuesStmt != nil {
+		if cerr := q.convertTsDataValuesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing convertTsDataValuesStmt: %w", cerr)
+		}
+	}
+	if q.copyTsDataToPartitionsStmt != nil {
+		if cerr := q.copyTsDataToPartitionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing copyTsDataToPartitionsStmt: %w", cerr)
+		}
+	}
+	if q.countTimeseriesUsingInputStmt != nil {
+		if cerr := q.countTimeseriesUsingInputStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countTimeseriesUsingInputStmt: %w", cerr)
+		}
+	}
 	if q.createAlertStmt != nil {
 		if cerr := q.createAlertStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createAlertStmt: %w", cerr)
 		}
 	}
+	if q.createAnnotationStmt != nil {
+		if cerr := q.createAnnotationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAnnotationStmt: %w", cerr)
+		}
+	}
 	if q.createCodeRevisionStmt != nil {
 		if cerr := q.createCodeRevisionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCodeRevisionStmt: %w", cerr)
@@ -455,6 +685,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createTsDataStmt: %w", cerr)
 		}
 	}
+	if q.createTsDataArchiveStmt != nil {
+		if cerr := q.createTsDataArchiveStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTsDataArchiveStmt: %w", cerr)
+		}
+	}
+	if q.createTsDataPartitionStmt != nil {
+		if cerr := q.createTsDataPartitionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTsDataPartitionStmt: %w", cerr)
+		}
+	}
+	if q.createTsDataRollupRangeStmt != nil {
+		if cerr := q.createTsDataRollupRangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTsDataRollupRangeStmt: %w", cerr)
+		}
+	}
 	if q.createUserStmt != nil {
 		if cerr := q.createUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
@@ -475,6 +720,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteAllTsDataStmt: %w", cerr)
 		}
 	}
+	if q.deleteAnnotationStmt != nil {
+		if cerr := q.deleteAnnotationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAnnotationStmt: %w", cerr)
+		}
+	}
+	if q.deleteAnnotationThingsStmt != nil {
+		if cerr := q.deleteAnnotationThingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAnnotationThingsStmt: %w", cerr)
+		}
+	}
+	if q.deleteAnnotationTimeseriesStmt != nil {
+		if cerr := q.deleteAnnotationTimeseriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAnnotationTimeseriesStmt: %w", cerr)
+		}
+	}
 	if q.deleteDatasetStmt != nil {
 		if cerr := q.deleteDatasetStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteDatasetStmt: %w", cerr)
@@ -515,21 +775,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteTokenFromUserStmt: %w", cerr)
 		}
 	}
+	if q.deleteTsDataArchiveStmt != nil {
+		if cerr := q.deleteTsDataArchiveStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTsDataArchiveStmt: %w", cerr)
+		}
+	}
+	if q.deleteTsDataArchivesBeforeStmt != nil {
+		if cerr := q.deleteTsDataArchivesBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTsDataArchivesBeforeStmt: %w", cerr)
+		}
+	}
 	if q.deleteTsDataRangeStmt != nil {
 		if cerr := q.deleteTsDataRangeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteTsDataRangeStmt: %w", cerr)
 		}
 	}
+	if q.deleteTsDataRangeReturningStmt != nil {
+		if cerr := q.deleteTsDataRangeReturningStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTsDataRangeReturningStmt: %w", cerr)
+		}
+	}
+	if q.deleteTsDataRollupRangeStmt != nil {
+		if cerr := q.deleteTsDataRollupRangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTsDataRollupRangeStmt: %w", cerr)
+		}
+	}
 	if q.deleteUserStmt != nil {
 		if cerr := q.deleteUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteUserStmt: %w", cerr)
 		}
 	}
+	if q.disableTsDataNotifyStmt != nil {
+		if cerr := q.disableTsDataNotifyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing disableTsDataNotifyStmt: %w", cerr)
+		}
+	}
+	if q.dropTsDataPartitionStmt != nil {
+		if cerr := q.dropTsDataPartitionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing dropTsDataPartitionStmt: %w", cerr)
+		}
+	}
 	if q.existsAlertStmt != nil {
 		if cerr := q.existsAlertStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing existsAlertStmt: %w", cerr)
 		}
 	}
+	if q.existsAnnotationStmt != nil {
+		if cerr := q.existsAnnotationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing existsAnnotationStmt: %w", cerr)
+		}
+	}
 	if q.existsDatasetStmt != nil {
 		if cerr := q.existsDatasetStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing existsDatasetStmt: %w", cerr)
@@ -575,6 +870,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findAlertsStmt: %w", cerr)
 		}
 	}
+	if q.findAlertsByTimeRangeStmt != nil {
+		if cerr := q.findAlertsByTimeRangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAlertsByTimeRangeStmt: %w", cerr)
+		}
+	}
 	if q.findAllModulesStmt != nil {
 		if cerr := q.findAllModulesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findAllModulesStmt: %w", cerr)
@@ -585,6 +885,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findAllRoutineRevisionsStmt: %w", cerr)
 		}
 	}
+	if q.findAnnotationByUUIDStmt != nil {
+		if cerr := q.findAnnotationByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAnnotationByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findAnnotationsStmt != nil {
+		if cerr := q.findAnnotationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAnnotationsStmt: %w", cerr)
+		}
+	}
+	if q.findAnnotationsByTimeseriesStmt != nil {
+		if cerr := q.findAnnotationsByTimeseriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAnnotationsByTimeseriesStmt: %w", cerr)
+		}
+	}
 	if q.findDatasetByThingStmt != nil {
 		if cerr := q.findDatasetByThingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findDatasetByThingStmt: %w", cerr)
@@ -680,6 +995,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findTimeseriesStmt: %w", cerr)
 		}
 	}
+	if q.findTimeseriesByEachTagStmt != nil {
+		if cerr := q.findTimeseriesByEachTagStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesByEachTagStmt: %w", cerr)
+		}
+	}
+	if q.findTimeseriesBySelectorStmt != nil {
+		if cerr := q.findTimeseriesBySelectorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesBySelectorStmt: %w", cerr)
+		}
+	}
+	if q.findTimeseriesByTagPrefixStmt != nil {
+		if cerr := q.findTimeseriesByTagPrefixStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesByTagPrefixStmt: %w", cerr)
+		}
+	}
 	if q.findTimeseriesByTagsStmt != nil {
 		if cerr := q.findTimeseriesByTagsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findTimeseriesByTagsStmt: %w", cerr)
@@ -695,11 +1025,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findTimeseriesByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.findTimeseriesWithRetentionStmt != nil {
+		if cerr := q.findTimeseriesWithRetentionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesWithRetentionStmt: %w", cerr)
+		}
+	}
+	if q.findTimeseriesWithTsDataBeforeStmt != nil {
+		if cerr := q.findTimeseriesWithTsDataBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesWithTsDataBeforeStmt: %w", cerr)
+		}
+	}
 	if q.findTokensByUserStmt != nil {
 		if cerr := q.findTokensByUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findTokensByUserStmt: %w", cerr)
 		}
 	}
+	if q.findTsDataArchivesStmt != nil {
+		if cerr := q.findTsDataArchivesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTsDataArchivesStmt: %w", cerr)
+		}
+	}
+	if q.findTsDataArchivesByTimeseriesStmt != nil {
+		if cerr := q.findTsDataArchivesByTimeseriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTsDataArchivesByTimeseriesStmt: %w", cerr)
+		}
+	}
+	if q.findTsDataPartitionsStmt != nil {
+		if cerr := q.findTsDataPartitionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTsDataPartitionsStmt: %w", cerr)
+		}
+	}
+	if q.findTsDataQuarantineStmt != nil {
+		if cerr := q.findTsDataQuarantineStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTsDataQuarantineStmt: %w", cerr)
+		}
+	}
+	if q.findTsDataTimeRangesStmt != nil {
+		if cerr := q.findTsDataTimeRangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTsDataTimeRangesStmt: %w", cerr)
+		}
+	}
 	if q.findUserByUUIDStmt != nil {
 		if cerr := q.findUserByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findUserByUUIDStmt: %w", cerr)
@@ -710,6 +1075,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findUsersStmt: %w", cerr)
 		}
 	}
+	if q.finishTsDataPartitioningStmt != nil {
+		if cerr := q.finishTsDataPartitioningStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing finishTsDataPartitioningStmt: %w", cerr)
+		}
+	}
 	if q.getDatasetContentByUUIDStmt != nil {
 		if cerr := q.getDatasetContentByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getDatasetContentByUUIDStmt: %w", cerr)
@@ -745,6 +1115,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTimeseriesByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.getTimeseriesByUUIDsStmt != nil {
+		if cerr := q.getTimeseriesByUUIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTimeseriesByUUIDsStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataArchiveByDatasetStmt != nil {
+		if cerr := q.getTsDataArchiveByDatasetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataArchiveByDatasetStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataArchiveContentStmt != nil {
+		if cerr := q.getTsDataArchiveContentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataArchiveContentStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataArchivedHourStmt != nil {
+		if cerr := q.getTsDataArchivedHourStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataArchivedHourStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataFirstTimestampBeforeStmt != nil {
+		if cerr := q.getTsDataFirstTimestampBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataFirstTimestampBeforeStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataLatestStmt != nil {
+		if cerr := q.getTsDataLatestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataLatestStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataPartitioningStmt != nil {
+		if cerr := q.getTsDataPartitioningStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataPartitioningStmt: %w", cerr)
+		}
+	}
 	if q.getTsDataRangeStmt != nil {
 		if cerr := q.getTsDataRangeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTsDataRangeStmt: %w", cerr)
@@ -755,6 +1160,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTsDataRangeAggStmt: %w", cerr)
 		}
 	}
+	if q.getTsDataRangeAggRollupStmt != nil {
+		if cerr := q.getTsDataRangeAggRollupStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataRangeAggRollupStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataStatsStmt != nil {
+		if cerr := q.getTsDataStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataStatsStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataValueBeforeStmt != nil {
+		if cerr := q.getTsDataValueBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataValueBeforeStmt: %w", cerr)
+		}
+	}
+	if q.getTsDataVersionsStmt != nil {
+		if cerr := q.getTsDataVersionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataVersionsStmt: %w", cerr)
+		}
+	}
 	if q.getUnitFromTimeseriesStmt != nil {
 		if cerr := q.getUnitFromTimeseriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUnitFromTimeseriesStmt: %w", cerr)
@@ -765,6 +1190,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserUuidFromTokenStmt: %w", cerr)
 		}
 	}
+	if q.lockTimeseriesTagStmt != nil {
+		if cerr := q.lockTimeseriesTagStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockTimeseriesTagStmt: %w", cerr)
+		}
+	}
+	if q.lockTsDataRollupStmt != nil {
+		if cerr := q.lockTsDataRollupStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockTsDataRollupStmt: %w", cerr)
+		}
+	}
+	if q.pruneTsDataListenersStmt != nil {
+		if cerr := q.pruneTsDataListenersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing pruneTsDataListenersStmt: %w", cerr)
+		}
+	}
+	if q.registerTsDataListenerStmt != nil {
+		if cerr := q.registerTsDataListenerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing registerTsDataListenerStmt: %w", cerr)
+		}
+	}
 	if q.removeUserFromAllGroupsStmt != nil {
 		if cerr := q.removeUserFromAllGroupsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeUserFromAllGroupsStmt: %w", cerr)
@@ -775,6 +1220,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing removeUserFromGroupsStmt: %w", cerr)
 		}
 	}
+	if q.searchTimeseriesStmt != nil {
+		if cerr := q.searchTimeseriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing searchTimeseriesStmt: %w", cerr)
+		}
+	}
 	if q.setDatasetContentByUUIDStmt != nil {
 		if cerr := q.setDatasetContentByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setDatasetContentByUUIDStmt: %w", cerr)
@@ -885,6 +1335,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setThingTypeByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.setTimeseriesExpressionStmt != nil {
+		if cerr := q.setTimeseriesExpressionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setTimeseriesExpressionStmt: %w", cerr)
+		}
+	}
+	if q.setTimeseriesKindStmt != nil {
+		if cerr := q.setTimeseriesKindStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setTimeseriesKindStmt: %w", cerr)
+		}
+	}
 	if q.setTimeseriesLowerBoundStmt != nil {
 		if cerr := q.setTimeseriesLowerBoundStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setTimeseriesLowerBoundStmt: %w", cerr)
@@ -895,6 +1355,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setTimeseriesNameStmt: %w", cerr)
 		}
 	}
+	if q.setTimeseriesRetentionStmt != nil {
+		if cerr := q.setTimeseriesRetentionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setTimeseriesRetentionStmt: %w", cerr)
+		}
+	}
 	if q.setTimeseriesSiUnitStmt != nil {
 		if cerr := q.setTimeseriesSiUnitStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setTimeseriesSiUnitStmt: %w", cerr)
@@ -995,6 +1460,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateAlertSetValueStmt: %w", cerr)
 		}
 	}
+	if q.updateAnnotationStmt != nil {
+		if cerr := q.updateAnnotationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateAnnotationStmt: %w", cerr)
+		}
+	}
+	if q.updateTsDataArchiveStmt != nil {
+		if cerr := q.updateTsDataArchiveStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTsDataArchiveStmt: %w", cerr)
+		}
+	}
+	if q.upsertTsDataRollupHoursStmt != nil {
+		if cerr := q.upsertTsDataRollupHoursStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertTsDataRollupHoursStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -1034,11 +1514,21 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                                 DBTX
 	tx                                 *sql.Tx
+	addAnnotationThingsStmt            *sql.Stmt
+	addAnnotationTimeseriesStmt        *sql.Stmt
 	addTokenToUserStmt                 *sql.Stmt
+	addTsDataRollupPartsStmt           *sql.Stmt
 	addUserToGroupStmt                 *sql.Stmt
+	beginTsDataPartitioningStmt        *sql.Stmt
 	checkUserTokenHasAccessStmt        *sql.Stmt
 	checkUserTokenHasAccessManyStmt    *sql.Stmt
+	convertTsDataQuarantineValuesStmt  *sql.Stmt
+	convertTsDataRollupValuesStmt      *sql.Stmt
+	convertTsDataValuesStmt            *sql.Stmt
+	copyTsDataToPartitionsStmt         *sql.Stmt
+	countTimeseriesUsingInputStmt      *sql.Stmt
 	createAlertStmt                    *sql.Stmt
+	createAnnotationStmt               *sql.Stmt
 	createCodeRevisionStmt             *sql.Stmt
 	createDatasetStmt                  *sql.Stmt
 	createGroupStmt                    *sql.Stmt
@@ -1047,10 +1537,16 @@ type Queries struct {
 	createThingStmt                    *sql.Stmt
 	createTimeseriesStmt               *sql.Stmt
 	createTsDataStmt                   *sql.Stmt
+	createTsDataArchiveStmt            *sql.Stmt
+	createTsDataPartitionStmt          *sql.Stmt
+	createTsDataRollupRangeStmt        *sql.Stmt
 	createUserStmt                     *sql.Stmt
 	createUserTokenStmt                *sql.Stmt
 	deleteAlertStmt                    *sql.Stmt
 	deleteAllTsDataStmt                *sql.Stmt
+	deleteAnnotationStmt               *sql.Stmt
+	deleteAnnotationThingsStmt         *sql.Stmt
+	deleteAnnotationTimeseriesStmt     *sql.Stmt
 	deleteDatasetStmt                  *sql.Stmt
 	deleteGroupStmt                    *sql.Stmt
 	deletePolicyByUUIDStmt             *sql.Stmt
@@ -1059,9 +1555,16 @@ type Queries struct {
 	deleteThingStmt                    *sql.Stmt
 	deleteTimeseriesStmt               *sql.Stmt
 	deleteTokenFromUserStmt            *sql.Stmt
+	deleteTsDataArchiveStmt            *sql.Stmt
+	deleteTsDataArchivesBeforeStmt     *sql.Stmt
 	deleteTsDataRangeStmt              *sql.Stmt
+	deleteTsDataRangeReturningStmt     *sql.Stmt
+	deleteTsDataRollupRangeStmt        *sql.Stmt
 	deleteUserStmt                     *sql.Stmt
+	disableTsDataNotifyStmt            *sql.Stmt
+	dropTsDataPartitionStmt            *sql.Stmt
 	existsAlertStmt                    *sql.Stmt
+	existsAnnotationStmt               *sql.Stmt
 	existsDatasetStmt                  *sql.Stmt
 	existsGroupStmt                    *sql.Stmt
 	existsPolicyStmt                   *sql.Stmt
@@ -1071,8 +1574,12 @@ type Queries struct {
 	existsUserStmt                     *sql.Stmt
 	findAlertByUUIDStmt                *sql.Stmt
 	findAlertsStmt                     *sql.Stmt
+	findAlertsByTimeRangeStmt          *sql.Stmt
 	findAllModulesStmt                 *sql.Stmt
 	findAllRoutineRevisionsStmt        *sql.Stmt
+	findAnnotationByUUIDStmt           *sql.Stmt
+	findAnnotationsStmt                *sql.Stmt
+	findAnnotationsByTimeseriesStmt    *sql.Stmt
 	findDatasetByThingStmt             *sql.Stmt
 	findDatasetByUUIDStmt              *sql.Stmt
 	findDatasetsStmt                   *sql.Stmt
@@ -1092,12 +1599,23 @@ type Queries struct {
 	findThingsStmt                     *sql.Stmt
 	findThingsByTagsStmt               *sql.Stmt
 	findTimeseriesStmt                 *sql.Stmt
+	findTimeseriesByEachTagStmt        *sql.Stmt
+	findTimeseriesBySelectorStmt       *sql.Stmt
+	findTimeseriesByTagPrefixStmt      *sql.Stmt
 	findTimeseriesByTagsStmt           *sql.Stmt
 	findTimeseriesByThingStmt          *sql.Stmt
 	findTimeseriesByUUIDStmt           *sql.Stmt
+	findTimeseriesWithRetentionStmt    *sql.Stmt
+	findTimeseriesWithTsDataBeforeStmt *sql.Stmt
 	findTokensByUserStmt               *sql.Stmt
+	findTsDataArchivesStmt             *sql.Stmt
+	findTsDataArchivesByTimeseriesStmt *sql.Stmt
+	findTsDataPartitionsStmt           *sql.Stmt
+	findTsDataQuarantineStmt           *sql.Stmt
+	findTsDataTimeRangesStmt           *sql.Stmt
 	findUserByUUIDStmt                 *sql.Stmt
 	findUsersStmt                      *sql.Stmt
+	finishTsDataPartitioningStmt       *sql.Stmt
 	getDatasetContentByUUIDStmt        *sql.Stmt
 	getNamedModuleCodeAtHeadStmt       *sql.Stmt
 	getNamedModuleCodeAtRevisionStmt   *sql.Stmt
@@ -1105,12 +1623,28 @@ type Queries struct {
 	getProgramCodeAtRevisionStmt       *sql.Stmt
 	getSignedProgramCodeAtHeadStmt     *sql.Stmt
 	getTimeseriesByUUIDStmt            *sql.Stmt
+	getTimeseriesByUUIDsStmt           *sql.Stmt
+	getTsDataArchiveByDatasetStmt      *sql.Stmt
+	getTsDataArchiveContentStmt        *sql.Stmt
+	getTsDataArchivedHourStmt          *sql.Stmt
+	getTsDataFirstTimestampBeforeStmt  *sql.Stmt
+	getTsDataLatestStmt                *sql.Stmt
+	getTsDataPartitioningStmt          *sql.Stmt
 	getTsDataRangeStmt                 *sql.Stmt
 	getTsDataRangeAggStmt              *sql.Stmt
+	getTsDataRangeAggRollupStmt        *sql.Stmt
+	getTsDataStatsStmt                 *sql.Stmt
+	getTsDataValueBeforeStmt           *sql.Stmt
+	getTsDataVersionsStmt              *sql.Stmt
 	getUnitFromTimeseriesStmt          *sql.Stmt
 	getUserUuidFromTokenStmt           *sql.Stmt
+	lockTimeseriesTagStmt              *sql.Stmt
+	lockTsDataRollupStmt               *sql.Stmt
+	pruneTsDataListenersStmt           *sql.Stmt
+	registerTsDataListenerStmt         *sql.Stmt
 	removeUserFromAllGroupsStmt        *sql.Stmt
 	removeUserFromGroupsStmt           *sql.Stmt
+	searchTimeseriesStmt               *sql.Stmt
 	setDatasetContentByUUIDStmt        *sql.Stmt
 	setDatasetFormatByUUIDStmt         *sql.Stmt
 	setDatasetNameByUUIDStmt           *sql.Stmt
@@ -1133,8 +1667,11 @@ type Queries struct {
 	setThingStateByUUIDStmt            *sql.Stmt
 	setThingTagsStmt                   *sql.Stmt
 	setThingTypeByUUIDStmt             *sql.Stmt
+	setTimeseriesExpressionStmt        *sql.Stmt
+	setTimeseriesKindStmt              *sql.Stmt
 	setTimeseriesLowerBoundStmt        *sql.Stmt
 	setTimeseriesNameStmt              *sql.Stmt
+	setTimeseriesRetentionStmt         *sql.Stmt
 	setTimeseriesSiUnitStmt            *sql.Stmt
 	setTimeseriesTagsStmt              *sql.Stmt
 	setTimeseriesThingStmt             *sql.Stmt
@@ -1155,17 +1692,30 @@ type Queries struct {
 	updateAlertSetTagsStmt             *sql.Stmt
 	updateAlertSetTimeoutStmt          *sql.Stmt
 	updateAlertSetValueStmt            *sql.Stmt
+	updateAnnotationStmt               *sql.Stmt
+	updateTsDataArchiveStmt            *sql.Stmt
+	upsertTsDataRollupHoursStmt        *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
 		db:                                 tx,
 		tx:                                 tx,
+		addAnnotationThingsStmt:            q.addAnnotationThingsStmt,
+		addAnnotationTimeseriesStmt:        q.addAnnotationTimeseriesStmt,
 		addTokenToUserStmt:                 q.addTokenToUserStmt,
+		addTsDataRollupPartsStmt:           q.addTsDataRollupPartsStmt,
 		addUserToGroupStmt:                 q.addUserToGroupStmt,
+		beginTsDataPartitioningStmt:        q.beginTsDataPartitioningStmt,
 		checkUserTokenHasAccessStmt:        q.checkUserTokenHasAccessStmt,
 		checkUserTokenHasAccessManyStmt:    q.checkUserTokenHasAccessManyStmt,
+		convertTsDataQuarantineValuesStmt:  q.convertTsDataQuarantineValuesStmt,
+		convertTsDataRollupValuesStmt:      q.convertTsDataRollupValuesStmt,
+		convertTsDataValuesStmt:            q.convertTsDataValuesStmt,
+		copyTsDataToPartitionsStmt:         q.copyTsDataToPartitionsStmt,
+		countTimeseriesUsingInputStmt:      q.countTimeseriesUsingInputStmt,
 		createAlertStmt:                    q.createAlertStmt,
+		createAnnotationStmt:               q.createAnnotationStmt,
 		createCodeRevisionStmt:             q.createCodeRevisionStmt,
 		createDatasetStmt:                  q.createDatasetStmt,
 		createGroupStmt:                    q.createGroupStmt,
@@ -1174,10 +1724,16 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		createThingStmt:                    q.createThingStmt,
 		createTimeseriesStmt:               q.createTimeseriesStmt,
 		createTsDataStmt:                   q.createTsDataStmt,
+		createTsDataArchiveStmt:            q.createTsDataArchiveStmt,
+		createTsDataPartitionStmt:          q.createTsDataPartitionStmt,
+		createTsDataRollupRangeStmt:        q.createTsDataRollupRangeStmt,
 		createUserStmt:                     q.createUserStmt,
 		createUserTokenStmt:                q.createUserTokenStmt,
 		deleteAlertStmt:                    q.deleteAlertStmt,
 		deleteAllTsDataStmt:                q.deleteAllTsDataStmt,
+		deleteAnnotationStmt:               q.deleteAnnotationStmt,
+		deleteAnnotationThingsStmt:         q.deleteAnnotationThingsStmt,
+		deleteAnnotationTimeseriesStmt:     q.deleteAnnotationTimeseriesStmt,
 		deleteDatasetStmt:                  q.deleteDatasetStmt,
 		deleteGroupStmt:                    q.deleteGroupStmt,
 		deletePolicyByUUIDStmt:             q.deletePolicyByUUIDStmt,
@@ -1186,9 +1742,16 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		deleteThingStmt:                    q.deleteThingStmt,
 		deleteTimeseriesStmt:               q.deleteTimeseriesStmt,
 		deleteTokenFromUserStmt:            q.deleteTokenFromUserStmt,
+		deleteTsDataArchiveStmt:            q.deleteTsDataArchiveStmt,
+		deleteTsDataArchivesBeforeStmt:     q.deleteTsDataArchivesBeforeStmt,
 		deleteTsDataRangeStmt:              q.deleteTsDataRangeStmt,
+		deleteTsDataRangeReturningStmt:     q.deleteTsDataRangeReturningStmt,
+		deleteTsDataRollupRangeStmt:        q.deleteTsDataRollupRangeStmt,
 		deleteUserStmt:                     q.deleteUserStmt,
+		disableTsDataNotifyStmt:            q.disableTsDataNotifyStmt,
+		dropTsDataPartitionStmt:            q.dropTsDataPartitionStmt,
 		existsAlertStmt:                    q.existsAlertStmt,
+		existsAnnotationStmt:               q.existsAnnotationStmt,
 		existsDatasetStmt:                  q.existsDatasetStmt,
 		existsGroupStmt:                    q.existsGroupStmt,
 		existsPolicyStmt:                   q.existsPolicyStmt,
@@ -1198,8 +1761,12 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		existsUserStmt:                     q.existsUserStmt,
 		findAlertByUUIDStmt:                q.findAlertByUUIDStmt,
 		findAlertsStmt:                     q.findAlertsStmt,
+		findAlertsByTimeRangeStmt:          q.findAlertsByTimeRangeStmt,
 		findAllModulesStmt:                 q.findAllModulesStmt,
 		findAllRoutineRevisionsStmt:        q.findAllRoutineRevisionsStmt,
+		findAnnotationByUUIDStmt:           q.findAnnotationByUUIDStmt,
+		findAnnotationsStmt:                q.findAnnotationsStmt,
+		findAnnotationsByTimeseriesStmt:    q.findAnnotationsByTimeseriesStmt,
 		findDatasetByThingStmt:             q.findDatasetByThingStmt,
 		findDatasetByUUIDStmt:              q.findDatasetByUUIDStmt,
 		findDatasetsStmt:                   q.findDatasetsStmt,
@@ -1219,12 +1786,23 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		findThingsStmt:                     q.findThingsStmt,
 		findThingsByTagsStmt:               q.findThingsByTagsStmt,
 		findTimeseriesStmt:                 q.findTimeseriesStmt,
+		findTimeseriesByEachTagStmt:        q.findTimeseriesByEachTagStmt,
+		findTimeseriesBySelectorStmt:       q.findTimeseriesBySelectorStmt,
+		findTimeseriesByTagPrefixStmt:      q.findTimeseriesByTagPrefixStmt,
 		findTimeseriesByTagsStmt:           q.findTimeseriesByTagsStmt,
 		findTimeseriesByThingStmt:          q.findTimeseriesByThingStmt,
 		findTimeseriesByUUIDStmt:           q.findTimeseriesByUUIDStmt,
+		findTimeseriesWithRetentionStmt:    q.findTimeseriesWithRetentionStmt,
+		findTimeseriesWithTsDataBeforeStmt: q.findTimeseriesWithTsDataBeforeStmt,
 		findTokensByUserStmt:               q.findTokensByUserStmt,
+		findTsDataArchivesStmt:             q.findTsDataArchivesStmt,
+		findTsDataArchivesByTimeseriesStmt: q.findTsDataArchivesByTimeseriesStmt,
+		findTsDataPartitionsStmt:           q.findTsDataPartitionsStmt,
+		findTsDataQuarantineStmt:           q.findTsDataQuarantineStmt,
+		findTsDataTimeRangesStmt:           q.findTsDataTimeRangesStmt,
 		findUserByUUIDStmt:                 q.findUserByUUIDStmt,
 		findUsersStmt:                      q.findUsersStmt,
+		finishTsDataPartitioningStmt:       q.finishTsDataPartitioningStmt,
 		getDatasetContentByUUIDStmt:        q.getDatasetContentByUUIDStmt,
 		getNamedModuleCodeAtHeadStmt:       q.getNamedModuleCodeAtHeadStmt,
 		getNamedModuleCodeAtRevisionStmt:   q.getNamedModuleCodeAtRevisionStmt,
@@ -1232,12 +1810,28 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getProgramCodeAtRevisionStmt:       q.getProgramCodeAtRevisionStmt,
 		getSignedProgramCodeAtHeadStmt:     q.getSignedProgramCodeAtHeadStmt,
 		getTimeseriesByUUIDStmt:            q.getTimeseriesByUUIDStmt,
+		getTimeseriesByUUIDsStmt:           q.getTimeseriesByUUIDsStmt,
+		getTsDataArchiveByDatasetStmt:      q.getTsDataArchiveByDatasetStmt,
+		getTsDataArchiveContentStmt:        q.getTsDataArchiveContentStmt,
+		getTsDataArchivedHourStmt:          q.getTsDataArchivedHourStmt,
+		getTsDataFirstTimestampBeforeStmt:  q.getTsDataFirstTimestampBeforeStmt,
+		getTsDataLatestStmt:                q.getTsDataLatestStmt,
+		getTsDataPartitioningStmt:          q.getTsDataPartitioningStmt,
 		getTsDataRangeStmt:                 q.getTsDataRangeStmt,
 		getTsDataRangeAggStmt:              q.getTsDataRangeAggStmt,
+		getTsDataRangeAggRollupStmt:        q.getTsDataRangeAggRollupStmt,
+		getTsDataStatsStmt:                 q.getTsDataStatsStmt,
+		getTsDataValueBeforeStmt:           q.getTsDataValueBeforeStmt,
+		getTsDataVersionsStmt:              q.getTsDataVersionsStmt,
 		getUnitFromTimeseriesStmt:          q.getUnitFromTimeseriesStmt,
 		getUserUuidFromTokenStmt:           q.getUserUuidFromTokenStmt,
+		lockTimeseriesTagStmt:              q.lockTimeseriesTagStmt,
+		lockTsDataRollupStmt:               q.lockTsDataRollupStmt,
+		pruneTsDataListenersStmt:           q.pruneTsDataListenersStmt,
+		registerTsDataListenerStmt:         q.registerTsDataListenerStmt,
 		removeUserFromAllGroupsStmt:        q.removeUserFromAllGroupsStmt,
 		removeUserFromGroupsStmt:           q.removeUserFromGroupsStmt,
+		searchTimeseriesStmt:               q.searchTimeseriesStmt,
 		setDatasetContentByUUIDStmt:        q.setDatasetContentByUUIDStmt,
 		setDatasetFormatByUUIDStmt:         q.setDatasetFormatByUUIDStmt,
 		setDatasetNameByUUIDStmt:           q.setDatasetNameByUUIDStmt,
@@ -1260,8 +1854,11 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		setThingStateByUUIDStmt:            q.setThingStateByUUIDStmt,
 		setThingTagsStmt:                   q.setThingTagsStmt,
 		setThingTypeByUUIDStmt:             q.setThingTypeByUUIDStmt,
+		setTimeseriesExpressionStmt:        q.setTimeseriesExpressionStmt,
+		setTimeseriesKindStmt:              q.setTimeseriesKindStmt,
 		setTimeseriesLowerBoundStmt:        q.setTimeseriesLowerBoundStmt,
 		setTimeseriesNameStmt:              q.setTimeseriesNameStmt,
+		setTimeseriesRetentionStmt:         q.setTimeseriesRetentionStmt,
 		setTimeseriesSiUnitStmt:            q.setTimeseriesSiUnitStmt,
 		setTimeseriesTagsStmt:              q.setTimeseriesTagsStmt,
 		setTimeseriesThingStmt:             q.setTimeseriesThingStmt,
@@ -1282,5 +1879,8 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		updateAlertSetTagsStmt:             q.updateAlertSetTagsStmt,
 		updateAlertSetTimeoutStmt:          q.updateAlertSetTimeoutStmt,
 		updateAlertSetValueStmt:            q.updateAlertSetValueStmt,
+		updateAnnotationStmt:               q.updateAnnotationStmt,
+		updateTsDataArchiveStmt:            q.updateTsDataArchiveStmt,
+		upsertTsDataRollupHoursStmt:        q.upsertTsDataRollupHoursStmt,
 	}
 }
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP FUNCTION tsdata_integral(DOUBLE PRECISION[], TIMESTAMPTZ[]);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+-- Time-weighted area under the curve, using the trapezoidal rule.
+-- The result is in value * seconds.
+CREATE FUNCTION tsdata_integral(DOUBLE PRECISION[], TIMESTAMPTZ[]) RETURNS DOUBLE PRECISION AS $BODY$
+  SELECT COALESCE(SUM((x.v + x.pv) / 2 * EXTRACT(EPOCH FROM x.t - x.pt)), 0)
+  FROM (
+    SELECT u.v, u.t, lag(u.v) OVER (ORDER BY u.i) AS pv, lag(u.t) OVER (ORDER BY u.i) AS pt
+    FROM unnest($1, $2) WITH ORDINALITY AS u(v, t, i)
+  ) AS x;
+$BODY$ LANGUAGE SQL IMMUTABLE;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP FUNCTION tsdata_bucket(TIMESTAMPTZ, INTEGER, BIGINT, TIMESTAMPTZ, TEXT);
+
+COMMIT;
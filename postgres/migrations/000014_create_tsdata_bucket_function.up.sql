@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Start of the bucket containing ts.
+-- Buckets are either a number of calendar months or a fixed width in microseconds,
+-- counted from origin in the wall clock time of the time zone tz.
+CREATE FUNCTION tsdata_bucket(ts TIMESTAMPTZ, months INTEGER, width BIGINT, origin TIMESTAMPTZ, tz TEXT) RETURNS TIMESTAMPTZ AS $BODY$
+  SELECT (CASE
+    WHEN months > 0 THEN
+      CASE
+        WHEN l.o + c.k * months * interval '1 month' > l.t THEN l.o + (c.k - 1) * months * interval '1 month'
+        ELSE l.o + c.k * months * interval '1 month'
+      END
+    ELSE
+      l.o + (floor(round(EXTRACT(EPOCH FROM l.t - l.o) * 1000000)::numeric / GREATEST(width, 1)) * GREATEST(width, 1))::DOUBLE PRECISION * interval '1 microsecond'
+  END) AT TIME ZONE tz
+  FROM (SELECT ts AT TIME ZONE tz AS t, origin AT TIME ZONE tz AS o) AS l,
+  LATERAL (
+    SELECT floor(((date_part('year', l.t) - date_part('year', l.o)) * 12 + date_part('month', l.t) - date_part('month', l.o)) / GREATEST(months, 1)) AS k
+  ) AS c;
+$BODY$ LANGUAGE SQL IMMUTABLE;
+
+COMMIT;
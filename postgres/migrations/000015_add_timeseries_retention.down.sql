@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX timeseries_retention_idx;
+
+ALTER TABLE timeseries DROP COLUMN retention;
+
+COMMIT;
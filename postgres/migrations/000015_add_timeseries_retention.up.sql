@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Seconds to keep data in tsdata, NULL keeps data forever
+ALTER TABLE timeseries ADD COLUMN retention BIGINT CHECK (retention > 0);
+
+CREATE INDEX timeseries_retention_idx ON timeseries(retention) WHERE retention IS NOT NULL;
+
+COMMIT;
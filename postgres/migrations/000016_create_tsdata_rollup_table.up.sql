@@ -0,0 +1,29 @@
+BEGIN;
+
+-- Count, sum, min and max of tsdata in buckets of 5 minutes and 1 hour.
+-- Buckets start at multiples of width seconds since the Unix epoch.
+CREATE TABLE tsdata_rollup (
+  ts_uuid UUID REFERENCES timeseries(uuid) ON DELETE CASCADE NOT NULL,
+  width INTEGER NOT NULL,
+  ts TIMESTAMPTZ NOT NULL,
+  count BIGINT NOT NULL,
+  sum DOUBLE PRECISION NOT NULL,
+  min DOUBLE PRECISION NOT NULL,
+  max DOUBLE PRECISION NOT NULL,
+
+  PRIMARY KEY(ts_uuid, width, ts)
+);
+
+INSERT INTO tsdata_rollup(ts_uuid, width, ts, count, sum, min, max)
+SELECT
+  tsdata.ts_uuid,
+  w.width,
+  to_timestamp(floor(EXTRACT(EPOCH FROM tsdata.ts) / w.width) * w.width),
+  COUNT(*),
+  SUM(tsdata.value),
+  MIN(tsdata.value),
+  MAX(tsdata.value)
+FROM tsdata, unnest(ARRAY[300, 3600]) AS w(width)
+GROUP BY 1, 2, 3;
+
+COMMIT;
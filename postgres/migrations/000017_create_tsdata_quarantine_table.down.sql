@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE tsdata_quarantine;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- Data points rejected by the lower or upper bound of a time series
+CREATE TABLE tsdata_quarantine (
+  ts_uuid UUID REFERENCES timeseries(uuid) ON DELETE CASCADE NOT NULL,
+  value DOUBLE PRECISION NOT NULL,
+  ts TIMESTAMPTZ NOT NULL,
+  reason TEXT NOT NULL,
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY(ts_uuid, ts)
+);
+
+CREATE INDEX tsdata_quarantine_created_by_idx ON tsdata_quarantine(created_by);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE timeseries DROP COLUMN expression;
+
+COMMIT;
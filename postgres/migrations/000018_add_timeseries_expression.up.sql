@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Expression of a derived time series, computed at query time from other time series.
+-- NULL for time series that store their own data.
+ALTER TABLE timeseries ADD COLUMN expression TEXT;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TRIGGER tsdata_delete_version ON tsdata;
+DROP TRIGGER tsdata_update_version ON tsdata;
+DROP TRIGGER tsdata_insert_version ON tsdata;
+DROP FUNCTION tsdata_bump_version();
+DROP TABLE tsdata_version;
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+-- The version of the data of a time series is increased by every statement that changes tsdata.
+-- Caches of tsdata compare versions to find out if they are still valid, across every instance using the database.
+-- A time series without a row has version 0.
+CREATE TABLE tsdata_version (
+  ts_uuid UUID REFERENCES timeseries(uuid) ON DELETE CASCADE NOT NULL,
+  version BIGINT NOT NULL,
+
+  PRIMARY KEY(ts_uuid)
+);
+
+CREATE FUNCTION tsdata_bump_version() RETURNS TRIGGER AS $BODY$
+BEGIN
+  -- Update in a fixed order to avoid deadlocks between statements changing several time series
+  INSERT INTO tsdata_version(ts_uuid, version)
+  SELECT DISTINCT ts_uuid, 1 FROM changed_rows ORDER BY ts_uuid
+  ON CONFLICT (ts_uuid) DO UPDATE
+  SET version = tsdata_version.version + 1;
+
+  RETURN NULL;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tsdata_insert_version AFTER INSERT ON tsdata
+REFERENCING NEW TABLE AS changed_rows
+FOR EACH STATEMENT EXECUTE FUNCTION tsdata_bump_version();
+
+CREATE TRIGGER tsdata_update_version AFTER UPDATE ON tsdata
+REFERENCING NEW TABLE AS changed_rows
+FOR EACH STATEMENT EXECUTE FUNCTION tsdata_bump_version();
+
+CREATE TRIGGER tsdata_delete_version AFTER DELETE ON tsdata
+REFERENCING OLD TABLE AS changed_rows
+FOR EACH STATEMENT EXECUTE FUNCTION tsdata_bump_version();
+
+COMMIT;
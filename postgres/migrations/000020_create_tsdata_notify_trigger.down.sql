@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TRIGGER tsdata_update_notify ON tsdata;
+DROP TRIGGER tsdata_insert_notify ON tsdata;
+DROP FUNCTION tsdata_notify();
+DROP TABLE tsdata_listener;
+
+COMMIT;
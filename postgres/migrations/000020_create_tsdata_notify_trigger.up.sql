@@ -0,0 +1,55 @@
+BEGIN;
+
+-- Time series with live subscriptions. Every instance of the server registers the time series it has subscriptions for,
+-- under an id of its own, and renews the registration while they last. A registration that is not renewed expires.
+CREATE TABLE tsdata_listener (
+  listener UUID NOT NULL,
+  ts_uuid UUID REFERENCES timeseries(uuid) ON DELETE CASCADE NOT NULL,
+  expires TIMESTAMPTZ NOT NULL,
+
+  PRIMARY KEY (listener, ts_uuid)
+);
+
+CREATE INDEX tsdata_listener_ts_uuid_idx ON tsdata_listener(ts_uuid);
+
+-- New and overwritten data points of subscribed time series are signalled on the channel tsdata, for live subscriptions.
+-- A notification holds the time series and the time range written by the statement: {"uuid": ..., "start": ..., "stop": ...}
+-- and the listener reads the data points itself. Nothing is sent while no time series is subscribed to.
+-- Statements that rewrite stored data, such as a unit conversion, turn the notifications off for their transaction
+-- by setting selfhost.tsdata_notify to off.
+CREATE FUNCTION tsdata_notify() RETURNS TRIGGER AS $BODY$
+DECLARE
+  changed RECORD;
+BEGIN
+  IF current_setting('selfhost.tsdata_notify', true) = 'off' THEN
+    RETURN NULL;
+  END IF;
+
+  IF NOT EXISTS (SELECT 1 FROM tsdata_listener WHERE expires > now()) THEN
+    RETURN NULL;
+  END IF;
+
+  FOR changed IN
+    SELECT changed_rows.ts_uuid, MIN(changed_rows.ts) AS start, MAX(changed_rows.ts) AS stop
+    FROM changed_rows
+    WHERE changed_rows.ts_uuid IN (
+      SELECT ts_uuid FROM tsdata_listener WHERE expires > now()
+    )
+    GROUP BY changed_rows.ts_uuid
+  LOOP
+    PERFORM pg_notify('tsdata', json_build_object('uuid', changed.ts_uuid, 'start', changed.start, 'stop', changed.stop)::text);
+  END LOOP;
+
+  RETURN NULL;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tsdata_insert_notify AFTER INSERT ON tsdata
+REFERENCING NEW TABLE AS changed_rows
+FOR EACH STATEMENT EXECUTE FUNCTION tsdata_notify();
+
+CREATE TRIGGER tsdata_update_notify AFTER UPDATE ON tsdata
+REFERENCING NEW TABLE AS changed_rows
+FOR EACH STATEMENT EXECUTE FUNCTION tsdata_notify();
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+-- A migrated tsdata stays partitioned by time
+DROP FUNCTION tsdata_drop_partition(TEXT, TIMESTAMPTZ);
+DROP FUNCTION tsdata_create_partition(TEXT, TIMESTAMPTZ, TIMESTAMPTZ);
+DROP FUNCTION tsdata_partition_finish();
+DROP FUNCTION tsdata_partition_copy(UUID, TIMESTAMPTZ, INTEGER);
+DROP FUNCTION tsdata_partition_begin(TEXT, INTEGER);
+DROP FUNCTION tsdata_mirror() CASCADE;
+
+DROP TABLE tsdata_partition;
+DROP TABLE tsdata_partitioning;
+
+COMMIT;
@@ -0,0 +1,269 @@
+BEGIN;
+
+-- Time-range partitioning of tsdata.
+-- tsdata is created hash partitioned on ts_uuid. "selfctl db partition" migrates it online to a table
+-- partitioned by range on ts, with partitions of a day, a week or a month, optionally hash sub-partitioned on ts_uuid.
+-- The layout is the single row of tsdata_partitioning, and every range partition is listed in tsdata_partition.
+-- Data outside of all range partitions is kept in the partition tsdata_default.
+CREATE TABLE tsdata_partitioning (
+  id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+  width TEXT NOT NULL CHECK (width IN ('day', 'week', 'month')),
+  hash_partitions INTEGER NOT NULL CHECK (hash_partitions >= 0 AND hash_partitions <= 256),
+  -- tsdata_range while the migration is in progress, tsdata once done
+  parent TEXT NOT NULL CHECK (parent IN ('tsdata_range', 'tsdata'))
+);
+
+CREATE TABLE tsdata_partition (
+  name TEXT PRIMARY KEY,
+  lower TIMESTAMPTZ NOT NULL,
+  upper TIMESTAMPTZ NOT NULL,
+
+  CHECK (lower < upper)
+);
+
+-- Repeats the changes of a statement on tsdata in tsdata_range, while the migration is in progress
+CREATE FUNCTION tsdata_mirror() RETURNS TRIGGER AS $BODY$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    DELETE FROM tsdata_range
+    USING old_rows
+    WHERE tsdata_range.ts_uuid = old_rows.ts_uuid
+    AND tsdata_range.ts = old_rows.ts;
+  END IF;
+
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    INSERT INTO tsdata_range(ts_uuid, value, ts, created_by)
+    SELECT ts_uuid, value, ts, created_by FROM new_rows
+    ON CONFLICT (ts_uuid, ts) DO UPDATE
+    SET value = EXCLUDED.value,
+      created_by = EXCLUDED.created_by;
+  END IF;
+
+  RETURN NULL;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+-- Starts the migration to time-range partitioning.
+-- Creates the table tsdata_range, and triggers on tsdata which repeat every change in tsdata_range.
+CREATE FUNCTION tsdata_partition_begin(p_width TEXT, p_hash_partitions INTEGER) RETURNS VOID AS $BODY$
+BEGIN
+  INSERT INTO tsdata_partitioning(width, hash_partitions, parent)
+  VALUES (p_width, p_hash_partitions, 'tsdata_range');
+
+  CREATE TABLE tsdata_range (
+    ts_uuid UUID REFERENCES timeseries(uuid) NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    ts TIMESTAMPTZ NOT NULL,
+    created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+
+    UNIQUE(ts_uuid, ts)
+  ) PARTITION BY RANGE(ts);
+
+  CREATE INDEX tsdata_range_created_by_idx ON tsdata_range(created_by);
+
+  CREATE TABLE tsdata_default PARTITION OF tsdata_range DEFAULT;
+
+  CREATE TRIGGER tsdata_insert_mirror AFTER INSERT ON tsdata
+  REFERENCING NEW TABLE AS new_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_mirror();
+
+  CREATE TRIGGER tsdata_update_mirror AFTER UPDATE ON tsdata
+  REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_mirror();
+
+  CREATE TRIGGER tsdata_delete_mirror AFTER DELETE ON tsdata
+  REFERENCING OLD TABLE AS old_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_mirror();
+END;
+$BODY$ LANGUAGE plpgsql;
+
+-- Copies up to p_limit points of a time series after p_after from tsdata to tsdata_range, and returns the timestamp of the last one.
+-- tsdata is not locked, the triggers repeat the changes made during the copy. Only the final swap locks tsdata.
+CREATE FUNCTION tsdata_partition_copy(p_ts_uuid UUID, p_after TIMESTAMPTZ, p_limit INTEGER) RETURNS TIMESTAMPTZ AS $BODY$
+DECLARE
+  last TIMESTAMPTZ;
+BEGIN
+  SELECT MAX(page.ts) INTO last
+  FROM (
+    SELECT ts FROM tsdata
+    WHERE ts_uuid = p_ts_uuid
+    AND ts > p_after
+    ORDER BY ts
+    LIMIT p_limit
+  ) AS page;
+
+  IF last IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  -- Points already repeated by the triggers are skipped. The copied points are locked, so that a concurrent update
+  -- or delete of them waits for the copy and is then repeated by the triggers, while other writes go on.
+  WITH page AS (
+    SELECT ts_uuid, value, ts, created_by
+    FROM tsdata
+    WHERE ts_uuid = p_ts_uuid
+    AND ts > p_after
+    AND ts <= last
+    FOR SHARE
+  )
+  INSERT INTO tsdata_range(ts_uuid, value, ts, created_by)
+  SELECT ts_uuid, value, ts, created_by FROM page
+  ON CONFLICT (ts_uuid, ts) DO NOTHING;
+
+  RETURN last;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+-- Ends the migration by swapping tsdata_range in place of tsdata.
+-- The hash partitioned table is kept as tsdata_hash, to be dropped by hand.
+CREATE FUNCTION tsdata_partition_finish() RETURNS VOID AS $BODY$
+BEGIN
+  LOCK TABLE tsdata IN ACCESS EXCLUSIVE MODE;
+
+  DROP TRIGGER tsdata_insert_mirror ON tsdata;
+  DROP TRIGGER tsdata_update_mirror ON tsdata;
+  DROP TRIGGER tsdata_delete_mirror ON tsdata;
+  DROP TRIGGER tsdata_insert_version ON tsdata;
+  DROP TRIGGER tsdata_update_version ON tsdata;
+  DROP TRIGGER tsdata_delete_version ON tsdata;
+  DROP TRIGGER tsdata_insert_notify ON tsdata;
+  DROP TRIGGER tsdata_update_notify ON tsdata;
+
+  ALTER TABLE tsdata RENAME TO tsdata_hash;
+  ALTER TABLE tsdata_hash RENAME CONSTRAINT tsdata_ts_uuid_ts_key TO tsdata_hash_ts_uuid_ts_key;
+  ALTER INDEX tsdata_created_by_idx RENAME TO tsdata_hash_created_by_idx;
+
+  ALTER TABLE tsdata_range RENAME TO tsdata;
+  ALTER TABLE tsdata RENAME CONSTRAINT tsdata_range_ts_uuid_ts_key TO tsdata_ts_uuid_ts_key;
+  ALTER TABLE tsdata RENAME CONSTRAINT tsdata_range_ts_uuid_fkey TO tsdata_ts_uuid_fkey;
+  ALTER TABLE tsdata RENAME CONSTRAINT tsdata_range_created_by_fkey TO tsdata_created_by_fkey;
+  ALTER INDEX tsdata_range_created_by_idx RENAME TO tsdata_created_by_idx;
+
+  CREATE TRIGGER tsdata_insert_version AFTER INSERT ON tsdata
+  REFERENCING NEW TABLE AS changed_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_bump_version();
+
+  CREATE TRIGGER tsdata_update_version AFTER UPDATE ON tsdata
+  REFERENCING NEW TABLE AS changed_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_bump_version();
+
+  CREATE TRIGGER tsdata_delete_version AFTER DELETE ON tsdata
+  REFERENCING OLD TABLE AS changed_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_bump_version();
+
+  CREATE TRIGGER tsdata_insert_notify AFTER INSERT ON tsdata
+  REFERENCING NEW TABLE AS changed_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_notify();
+
+  CREATE TRIGGER tsdata_update_notify AFTER UPDATE ON tsdata
+  REFERENCING NEW TABLE AS changed_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_notify();
+
+  UPDATE tsdata_partitioning SET parent = 'tsdata';
+END;
+$BODY$ LANGUAGE plpgsql;
+
+-- Creates a range partition from p_lower to p_upper, returns false if it already exists.
+-- Points of the range in tsdata_default are moved to the new partition.
+CREATE FUNCTION tsdata_create_partition(p_name TEXT, p_lower TIMESTAMPTZ, p_upper TIMESTAMPTZ) RETURNS BOOLEAN AS $BODY$
+DECLARE
+  layout tsdata_partitioning%ROWTYPE;
+  moving BOOLEAN;
+BEGIN
+  SELECT * INTO layout FROM tsdata_partitioning;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'tsdata is not partitioned by time';
+  END IF;
+
+  -- Serializes changes of the partitions
+  PERFORM pg_advisory_xact_lock(hashtext('tsdata_partition'));
+
+  IF EXISTS (SELECT 1 FROM tsdata_partition WHERE name = p_name) THEN
+    RETURN FALSE;
+  END IF;
+
+  SELECT EXISTS (SELECT 1 FROM tsdata_default WHERE ts >= p_lower AND ts < p_upper) INTO moving;
+  IF moving THEN
+    EXECUTE format('ALTER TABLE %I DETACH PARTITION tsdata_default', layout.parent);
+  END IF;
+
+  IF layout.hash_partitions > 0 THEN
+    EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L) PARTITION BY HASH(ts_uuid)',
+      p_name, layout.parent, p_lower, p_upper);
+    FOR i IN 0..layout.hash_partitions - 1 LOOP
+      EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES WITH (MODULUS %s, REMAINDER %s)',
+        p_name || '_' || i, p_name, layout.hash_partitions, i);
+    END LOOP;
+  ELSE
+    EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+      p_name, layout.parent, p_lower, p_upper);
+  END IF;
+
+  -- Moving points between partitions changes no data, so no triggers are fired
+  IF moving THEN
+    EXECUTE format('WITH moved AS (DELETE FROM tsdata_default WHERE ts >= $1 AND ts < $2 RETURNING ts_uuid, value, ts, created_by) '
+      'INSERT INTO %I(ts_uuid, value, ts, created_by) SELECT * FROM moved', p_name)
+    USING p_lower, p_upper;
+    EXECUTE format('ALTER TABLE %I ATTACH PARTITION tsdata_default DEFAULT', layout.parent);
+  END IF;
+
+  INSERT INTO tsdata_partition(name, lower, upper) VALUES (p_name, p_lower, p_upper);
+
+  RETURN TRUE;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+-- Drops a range partition if all of its data is older than the retention of its time series, returns false if it is kept.
+-- The dropped data is handled as if deleted, the versions of its time series are bumped and their rollups deleted.
+CREATE FUNCTION tsdata_drop_partition(p_name TEXT, p_now TIMESTAMPTZ) RETURNS BOOLEAN AS $BODY$
+DECLARE
+  part tsdata_partition%ROWTYPE;
+  keep BOOLEAN;
+  dropped UUID[];
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM tsdata_partitioning WHERE parent = 'tsdata') THEN
+    RETURN FALSE;
+  END IF;
+
+  PERFORM pg_advisory_xact_lock(hashtext('tsdata_partition'));
+
+  SELECT * INTO part FROM tsdata_partition WHERE name = p_name;
+  IF NOT FOUND THEN
+    RETURN FALSE;
+  END IF;
+
+  EXECUTE format('SELECT EXISTS (SELECT 1 FROM timeseries '
+    'WHERE (retention IS NULL OR $1 > $2 - retention * INTERVAL ''1 second'') '
+    'AND EXISTS (SELECT 1 FROM %I WHERE ts_uuid = timeseries.uuid))', p_name)
+  INTO keep
+  USING part.upper, p_now;
+
+  IF keep THEN
+    RETURN FALSE;
+  END IF;
+
+  EXECUTE format('SELECT ARRAY(SELECT uuid FROM timeseries '
+    'WHERE EXISTS (SELECT 1 FROM %I WHERE ts_uuid = timeseries.uuid) ORDER BY uuid)', p_name)
+  INTO dropped;
+
+  -- Fail rather than block all access to tsdata while waiting for long running statements
+  PERFORM set_config('lock_timeout', '10s', true);
+  EXECUTE format('DROP TABLE %I', p_name);
+
+  INSERT INTO tsdata_version(ts_uuid, version)
+  SELECT ts_uuid, 1 FROM unnest(dropped) AS ts_uuid ORDER BY ts_uuid
+  ON CONFLICT (ts_uuid) DO UPDATE
+  SET version = tsdata_version.version + 1;
+
+  DELETE FROM tsdata_rollup
+  WHERE ts_uuid = ANY(dropped)
+  AND ts >= part.lower
+  AND ts < part.upper;
+
+  DELETE FROM tsdata_partition WHERE name = p_name;
+
+  RETURN TRUE;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE tsdata_archive;
+DROP FUNCTION tsdata_archive_delete_dataset();
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN;
+
+-- Ranges of tsdata archived as datasets of the format tsarchive, from the first to the last archived point.
+-- Deleting either the dataset or the entry deletes both.
+CREATE TABLE tsdata_archive (
+  dataset_uuid UUID REFERENCES datasets(uuid) ON DELETE CASCADE NOT NULL,
+  ts_uuid UUID REFERENCES timeseries(uuid) ON DELETE CASCADE NOT NULL,
+  start TIMESTAMPTZ NOT NULL,
+  stop TIMESTAMPTZ NOT NULL,
+  count BIGINT NOT NULL,
+
+  PRIMARY KEY(dataset_uuid),
+  CHECK (start <= stop)
+);
+
+CREATE INDEX tsdata_archive_ts_uuid_idx ON tsdata_archive(ts_uuid, start);
+
+CREATE FUNCTION tsdata_archive_delete_dataset() RETURNS TRIGGER AS $BODY$
+BEGIN
+  DELETE FROM datasets WHERE uuid = OLD.dataset_uuid;
+  RETURN NULL;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tsdata_archive_delete AFTER DELETE ON tsdata_archive
+FOR EACH ROW EXECUTE FUNCTION tsdata_archive_delete_dataset();
+
+COMMIT;
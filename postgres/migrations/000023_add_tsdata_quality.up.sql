@@ -0,0 +1,157 @@
+BEGIN;
+
+-- Quality of a data point, NULL for a plain measurement.
+-- The codes are ordered by severity; 1 corrected, 2 estimated, 3 substituted and 4 bad.
+-- A rollup has the most severe quality of its data points.
+ALTER TABLE tsdata ADD COLUMN quality SMALLINT;
+ALTER TABLE tsdata_quarantine ADD COLUMN quality SMALLINT;
+ALTER TABLE tsdata_rollup ADD COLUMN quality SMALLINT;
+
+-- A migration to time-range partitioning may be in progress
+DO $BODY$
+BEGIN
+  IF to_regclass('tsdata_range') IS NOT NULL THEN
+    ALTER TABLE tsdata_range ADD COLUMN quality SMALLINT;
+  END IF;
+END;
+$BODY$;
+
+CREATE OR REPLACE FUNCTION tsdata_mirror() RETURNS TRIGGER AS $BODY$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    DELETE FROM tsdata_range
+    USING old_rows
+    WHERE tsdata_range.ts_uuid = old_rows.ts_uuid
+    AND tsdata_range.ts = old_rows.ts;
+  END IF;
+
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    INSERT INTO tsdata_range(ts_uuid, value, ts, created_by, quality)
+    SELECT ts_uuid, value, ts, created_by, quality FROM new_rows
+    ON CONFLICT (ts_uuid, ts) DO UPDATE
+    SET value = EXCLUDED.value,
+      created_by = EXCLUDED.created_by,
+      quality = EXCLUDED.quality;
+  END IF;
+
+  RETURN NULL;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION tsdata_partition_begin(p_width TEXT, p_hash_partitions INTEGER) RETURNS VOID AS $BODY$
+BEGIN
+  INSERT INTO tsdata_partitioning(width, hash_partitions, parent)
+  VALUES (p_width, p_hash_partitions, 'tsdata_range');
+
+  CREATE TABLE tsdata_range (
+    ts_uuid UUID REFERENCES timeseries(uuid) NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    ts TIMESTAMPTZ NOT NULL,
+    created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+    quality SMALLINT,
+
+    UNIQUE(ts_uuid, ts)
+  ) PARTITION BY RANGE(ts);
+
+  CREATE INDEX tsdata_range_created_by_idx ON tsdata_range(created_by);
+
+  CREATE TABLE tsdata_default PARTITION OF tsdata_range DEFAULT;
+
+  CREATE TRIGGER tsdata_insert_mirror AFTER INSERT ON tsdata
+  REFERENCING NEW TABLE AS new_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_mirror();
+
+  CREATE TRIGGER tsdata_update_mirror AFTER UPDATE ON tsdata
+  REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_mirror();
+
+  CREATE TRIGGER tsdata_delete_mirror AFTER DELETE ON tsdata
+  REFERENCING OLD TABLE AS old_rows
+  FOR EACH STATEMENT EXECUTE FUNCTION tsdata_mirror();
+END;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION tsdata_partition_copy(p_ts_uuid UUID, p_after TIMESTAMPTZ, p_limit INTEGER) RETURNS TIMESTAMPTZ AS $BODY$
+DECLARE
+  last TIMESTAMPTZ;
+BEGIN
+  SELECT MAX(page.ts) INTO last
+  FROM (
+    SELECT ts FROM tsdata
+    WHERE ts_uuid = p_ts_uuid
+    AND ts > p_after
+    ORDER BY ts
+    LIMIT p_limit
+  ) AS page;
+
+  IF last IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  -- Points already repeated by the triggers are skipped. The copied points are locked, so that a concurrent update
+  -- or delete of them waits for the copy and is then repeated by the triggers, while other writes go on.
+  WITH page AS (
+    SELECT ts_uuid, value, ts, created_by, quality
+    FROM tsdata
+    WHERE ts_uuid = p_ts_uuid
+    AND ts > p_after
+    AND ts <= last
+    FOR SHARE
+  )
+  INSERT INTO tsdata_range(ts_uuid, value, ts, created_by, quality)
+  SELECT ts_uuid, value, ts, created_by, quality FROM page
+  ON CONFLICT (ts_uuid, ts) DO NOTHING;
+
+  RETURN last;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION tsdata_create_partition(p_name TEXT, p_lower TIMESTAMPTZ, p_upper TIMESTAMPTZ) RETURNS BOOLEAN AS $BODY$
+DECLARE
+  layout tsdata_partitioning%ROWTYPE;
+  moving BOOLEAN;
+BEGIN
+  SELECT * INTO layout FROM tsdata_partitioning;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'tsdata is not partitioned by time';
+  END IF;
+
+  -- Serializes changes of the partitions
+  PERFORM pg_advisory_xact_lock(hashtext('tsdata_partition'));
+
+  IF EXISTS (SELECT 1 FROM tsdata_partition WHERE name = p_name) THEN
+    RETURN FALSE;
+  END IF;
+
+  SELECT EXISTS (SELECT 1 FROM tsdata_default WHERE ts >= p_lower AND ts < p_upper) INTO moving;
+  IF moving THEN
+    EXECUTE format('ALTER TABLE %I DETACH PARTITION tsdata_default', layout.parent);
+  END IF;
+
+  IF layout.hash_partitions > 0 THEN
+    EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L) PARTITION BY HASH(ts_uuid)',
+      p_name, layout.parent, p_lower, p_upper);
+    FOR i IN 0..layout.hash_partitions - 1 LOOP
+      EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES WITH (MODULUS %s, REMAINDER %s)',
+        p_name || '_' || i, p_name, layout.hash_partitions, i);
+    END LOOP;
+  ELSE
+    EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+      p_name, layout.parent, p_lower, p_upper);
+  END IF;
+
+  -- Moving points between partitions changes no data, so no triggers are fired
+  IF moving THEN
+    EXECUTE format('WITH moved AS (DELETE FROM tsdata_default WHERE ts >= $1 AND ts < $2 RETURNING ts_uuid, value, ts, created_by, quality) '
+      'INSERT INTO %I(ts_uuid, value, ts, created_by, quality) SELECT * FROM moved', p_name)
+    USING p_lower, p_upper;
+    EXECUTE format('ALTER TABLE %I ATTACH PARTITION tsdata_default DEFAULT', layout.parent);
+  END IF;
+
+  INSERT INTO tsdata_partition(name, lower, upper) VALUES (p_name, p_lower, p_upper);
+
+  RETURN TRUE;
+END;
+$BODY$ LANGUAGE plpgsql;
+
+COMMIT;
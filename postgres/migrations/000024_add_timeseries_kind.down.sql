@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP FUNCTION tsdata_counter_increase(DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION);
+
+ALTER TABLE timeseries DROP CONSTRAINT timeseries_rollover_check_kind;
+ALTER TABLE timeseries DROP COLUMN rollover;
+ALTER TABLE timeseries DROP COLUMN kind;
+
+COMMIT;
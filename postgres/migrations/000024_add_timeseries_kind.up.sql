@@ -0,0 +1,23 @@
+BEGIN;
+
+-- A gauge is a measurement at a point in time. A counter is a total that only increases,
+-- until it is reset or wraps around at its rollover value.
+ALTER TABLE timeseries ADD COLUMN kind TEXT NOT NULL DEFAULT 'gauge' CHECK (kind IN ('gauge', 'counter'));
+
+-- Value where a counter wraps around to zero, NULL for a counter that only resets
+ALTER TABLE timeseries ADD COLUMN rollover DOUBLE PRECISION CHECK (rollover > 0);
+
+ALTER TABLE timeseries ADD CONSTRAINT timeseries_rollover_check_kind CHECK (rollover IS NULL OR kind = 'counter');
+
+-- Increase of a counter from prev to value. A decrease is a wrap around at the rollover value
+-- when there is one, otherwise a reset where the counter restarted from zero.
+CREATE FUNCTION tsdata_counter_increase(prev DOUBLE PRECISION, value DOUBLE PRECISION, rollover DOUBLE PRECISION) RETURNS DOUBLE PRECISION AS $BODY$
+  SELECT CASE
+    WHEN prev IS NULL THEN NULL
+    WHEN value >= prev THEN value - prev
+    WHEN rollover IS NOT NULL THEN rollover - prev + value
+    ELSE value
+  END;
+$BODY$ LANGUAGE SQL IMMUTABLE;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DELETE FROM group_policies
+WHERE group_uuid IN ('00000000-0000-1000-8000-000000000001', '00000000-0000-1000-8000-000000000002')
+AND resource = 'annotations';
+
+DROP VIEW v_annotations;
+DROP TABLE annotation_things;
+DROP TABLE annotation_timeseries;
+DROP TABLE annotations;
+
+COMMIT;
@@ -0,0 +1,73 @@
+BEGIN;
+
+-- An event on one or more time series or things over a time range, such as a replaced meter or a calibration
+CREATE TABLE annotations (
+  uuid UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  start TIMESTAMPTZ NOT NULL,
+  stop TIMESTAMPTZ NOT NULL,
+  text TEXT NOT NULL,
+  tags TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+  created TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+  CHECK (start <= stop)
+);
+
+CREATE INDEX annotations_start_stop_idx ON annotations(start, stop);
+CREATE INDEX annotations_created_by_idx ON annotations(created_by);
+CREATE INDEX annotations_tags_idx ON annotations USING GIN("tags") WITH (fastupdate = false);
+
+CREATE TABLE annotation_timeseries (
+  annotation_uuid UUID NOT NULL REFERENCES annotations(uuid) ON DELETE CASCADE,
+  ts_uuid UUID NOT NULL REFERENCES timeseries(uuid) ON DELETE CASCADE,
+  PRIMARY KEY(annotation_uuid, ts_uuid)
+);
+
+CREATE INDEX annotation_timeseries_ts_uuid_idx ON annotation_timeseries(ts_uuid);
+
+CREATE TABLE annotation_things (
+  annotation_uuid UUID NOT NULL REFERENCES annotations(uuid) ON DELETE CASCADE,
+  thing_uuid UUID NOT NULL REFERENCES things(uuid) ON DELETE CASCADE,
+  PRIMARY KEY(annotation_uuid, thing_uuid)
+);
+
+CREATE INDEX annotation_things_thing_uuid_idx ON annotation_things(thing_uuid);
+
+CREATE VIEW v_annotations
+AS
+ SELECT
+    annotations.uuid,
+    ARRAY(
+      SELECT annotation_timeseries.ts_uuid
+      FROM annotation_timeseries
+      WHERE annotation_timeseries.annotation_uuid = annotations.uuid
+      ORDER BY annotation_timeseries.ts_uuid
+    )::UUID[] AS timeseries,
+    ARRAY(
+      SELECT annotation_things.thing_uuid
+      FROM annotation_things
+      WHERE annotation_things.annotation_uuid = annotations.uuid
+      ORDER BY annotation_things.thing_uuid
+    )::UUID[] AS things,
+    annotations.start,
+    annotations.stop,
+    annotations.text,
+    annotations.tags,
+    annotations.created,
+    annotations.created_by
+ FROM annotations;
+
+-- Access to an annotation is decided by the time series and things it is linked to
+INSERT INTO group_policies(group_uuid, priority, effect, action, resource)
+VALUES
+  ('00000000-0000-1000-8000-000000000001', 0, 'allow', 'create', 'annotations'),
+  ('00000000-0000-1000-8000-000000000001', 0, 'allow', 'read', 'annotations'),
+  ('00000000-0000-1000-8000-000000000001', 0, 'allow', 'update', 'annotations'),
+  ('00000000-0000-1000-8000-000000000001', 0, 'allow', 'delete', 'annotations'),
+
+  ('00000000-0000-1000-8000-000000000002', 0, 'allow', 'create', 'annotations'),
+  ('00000000-0000-1000-8000-000000000002', 0, 'allow', 'read', 'annotations'),
+  ('00000000-0000-1000-8000-000000000002', 0, 'allow', 'update', 'annotations'),
+  ('00000000-0000-1000-8000-000000000002', 0, 'allow', 'delete', 'annotations')
+;
+
+COMMIT;
@@ -160,6 +160,26 @@ type Alert struct {
 	LastReceiveTime  sql.NullTime
 }
 
+type Annotation struct {
+	Uuid      uuid.UUID
+	Start     time.Time
+	Stop      time.Time
+	Text      string
+	Tags      []string
+	Created   time.Time
+	CreatedBy uuid.UUID
+}
+
+type AnnotationThing struct {
+	AnnotationUuid uuid.UUID
+	ThingUuid      uuid.UUID
+}
+
+type AnnotationTimeseries struct {
+	AnnotationUuid uuid.UUID
+	TsUuid         uuid.UUID
+}
+
 type Dataset struct {
 	Uuid      uuid.UUID
 	Name      string
@@ -234,6 +254,10 @@ type Timeseries struct {
 	UpperBound sql.NullFloat64
 	CreatedBy  uuid.UUID
 	Tags       []string
+	Retention  sql.NullInt64
+	Expression sql.NullString
+	Kind       string
+	Rollover   sql.NullFloat64
 }
 
 type Tsdata0 struct {
@@ -1004,11 +1028,60 @@ type Tsdata98 struct {
 type Tsdata99 struct {
 }
 
+type TsdataArchive struct {
+	DatasetUuid uuid.UUID
+	TsUuid      uuid.UUID
+	Start       time.Time
+	Stop        time.Time
+	Count       int64
+}
+
+type TsdataListener struct {
+	Listener uuid.UUID
+	TsUuid   uuid.UUID
+	Expires  time.Time
+}
+
+type TsdataPartition struct {
+	Name  string
+	Lower time.Time
+	Upper time.Time
+}
+
+type TsdataPartitioning struct {
+	ID             bool
+	Width          string
+	HashPartitions int32
+	Parent         string
+}
+
+type TsdataQuarantine struct {
+	TsUuid    uuid.UUID
+	Value     float64
+	Ts        time.Time
+	Reason    string
+	CreatedBy uuid.UUID
+	Created   time.Time
+	Quality   sql.NullInt32
+}
+
+type TsdataRollup struct {
+	TsUuid  uuid.UUID
+	Width   int32
+	Ts      time.Time
+	Count   int64
+	Sum     float64
+	Min     float64
+	Max     float64
+	Quality sql.NullInt32
+}
+
 type Tsdatum struct {
 	TsUuid    uuid.UUID
 	Value     float64
 	Ts        time.Time
 	CreatedBy uuid.UUID
+	Quality   sql.NullInt32
 }
 
 type User struct {
@@ -1049,3 +1122,15 @@ type VAlert struct {
 	Tags             []string
 	Rawdata          []byte
 }
+
+type VAnnotation struct {
+	Uuid       uuid.UUID
+	Timeseries []uuid.UUID
+	Things     []uuid.UUID
+	Start      time.Time
+	Stop       time.Time
+	Text       string
+	Tags       []string
+	Created    time.Time
+	CreatedBy  uuid.UUID
+}
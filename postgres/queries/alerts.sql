@@ -200,6 +200,56 @@ LIMIT sqlc.arg(arg_limit)::BIGINT
 OFFSET sqlc.arg(arg_offset)::BIGINT
 ;
 
+-- name: FindAlertsByTimeRange :many
+-- Alerts received within the time range, from the first to the last time they were received
+SELECT *
+FROM v_alerts
+WHERE v_alerts.created <= sqlc.arg(stop)::timestamptz
+AND COALESCE(v_alerts.last_receive_time, v_alerts.created) >= sqlc.arg(start)::timestamptz
+AND (
+	NULLIF(sqlc.arg(resource)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(resource)::TEXT = v_alerts.resource
+)
+AND (
+	NULLIF(sqlc.arg(environment)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(environment)::TEXT = v_alerts.environment
+)
+AND (
+	NULLIF(sqlc.arg(event)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(event)::TEXT = v_alerts.event
+)
+AND (
+	NULLIF(sqlc.arg(origin)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(origin)::TEXT = v_alerts.origin
+)
+AND (
+	NULLIF(sqlc.arg(status)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(status)::TEXT = v_alerts.status::TEXT
+)
+AND (
+	NULLIF(sqlc.arg(severity)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(severity)::TEXT = v_alerts.severity::TEXT
+)
+AND (
+	NULLIF(sqlc.arg(service)::TEXT[], ARRAY[]::TEXT[]) IS NULL
+	OR
+	sqlc.arg(service)::TEXT[] && v_alerts.service
+)
+AND (
+	NULLIF(sqlc.arg(tags)::TEXT[], ARRAY[]::TEXT[]) IS NULL
+	OR
+	sqlc.arg(tags)::TEXT[] && v_alerts.tags
+)
+ORDER BY v_alerts.created, v_alerts.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+;
+
 -- name: DeleteAlert :execrows
 DELETE FROM alerts
 WHERE alerts.uuid = sqlc.arg(uuid);
@@ -0,0 +1,101 @@
+-- name: ExistsAnnotation :one
+SELECT COUNT(*) AS count
+FROM annotations
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: CreateAnnotation :one
+INSERT INTO annotations(start, stop, text, tags, created_by)
+VALUES (
+	sqlc.arg(start)::timestamptz,
+	sqlc.arg(stop)::timestamptz,
+	sqlc.arg(text)::text,
+	sqlc.arg(tags)::text[],
+	sqlc.arg(created_by)::uuid
+)
+RETURNING uuid;
+
+-- name: AddAnnotationTimeseries :execrows
+INSERT INTO annotation_timeseries(annotation_uuid, ts_uuid)
+SELECT sqlc.arg(annotation_uuid)::uuid, unnest(sqlc.arg(ts_uuids)::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: AddAnnotationThings :execrows
+INSERT INTO annotation_things(annotation_uuid, thing_uuid)
+SELECT sqlc.arg(annotation_uuid)::uuid, unnest(sqlc.arg(thing_uuids)::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteAnnotationTimeseries :execrows
+DELETE FROM annotation_timeseries
+WHERE annotation_uuid = sqlc.arg(annotation_uuid);
+
+-- name: DeleteAnnotationThings :execrows
+DELETE FROM annotation_things
+WHERE annotation_uuid = sqlc.arg(annotation_uuid);
+
+-- name: FindAnnotationByUUID :one
+SELECT *
+FROM v_annotations
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: FindAnnotations :many
+-- Annotations of any of the time series or things that overlap the time range
+SELECT *
+FROM v_annotations
+WHERE v_annotations.start <= sqlc.arg(stop)::timestamptz
+AND v_annotations.stop >= sqlc.arg(start)::timestamptz
+AND (
+	EXISTS (
+		SELECT 1
+		FROM annotation_timeseries
+		WHERE annotation_timeseries.annotation_uuid = v_annotations.uuid
+		AND annotation_timeseries.ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+	)
+	OR
+	EXISTS (
+		SELECT 1
+		FROM annotation_things
+		WHERE annotation_things.annotation_uuid = v_annotations.uuid
+		AND annotation_things.thing_uuid = ANY(sqlc.arg(thing_uuids)::uuid[])
+	)
+)
+AND (
+	NULLIF(sqlc.arg(tags)::TEXT[], ARRAY[]::TEXT[]) IS NULL
+	OR
+	sqlc.arg(tags)::TEXT[] && v_annotations.tags
+)
+ORDER BY v_annotations.start, v_annotations.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT
+;
+
+-- name: FindAnnotationsByTimeseries :many
+-- Annotations linked to each of the time series that overlap the time range, once per time series
+SELECT
+	annotation_timeseries.ts_uuid,
+	v_annotations.uuid,
+	v_annotations.timeseries,
+	v_annotations.things,
+	v_annotations.start,
+	v_annotations.stop,
+	v_annotations.text,
+	v_annotations.tags,
+	v_annotations.created,
+	v_annotations.created_by
+FROM annotation_timeseries, v_annotations
+WHERE annotation_timeseries.annotation_uuid = v_annotations.uuid
+AND annotation_timeseries.ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+AND v_annotations.start <= sqlc.arg(stop)::timestamptz
+AND v_annotations.stop >= sqlc.arg(start)::timestamptz
+ORDER BY annotation_timeseries.ts_uuid, v_annotations.start, v_annotations.uuid;
+
+-- name: UpdateAnnotation :execrows
+UPDATE annotations
+SET start = sqlc.arg(start),
+	stop = sqlc.arg(stop),
+	text = sqlc.arg(text),
+	tags = sqlc.arg(tags)
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: DeleteAnnotation :execrows
+DELETE FROM annotations
+WHERE annotations.uuid = sqlc.arg(uuid);
@@ -85,6 +85,13 @@ FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+-- Archived time series data is only listed with read access to the data of the time series
+AND NOT EXISTS (
+	SELECT 1
+	FROM tsdata_archive
+	WHERE tsdata_archive.dataset_uuid = datasets.uuid
+	AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||tsdata_archive.ts_uuid||'/data') = false
+)
 EXCEPT
 SELECT
 	uuid,
@@ -137,6 +144,13 @@ FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+-- Archived time series data is only listed with read access to the data of the time series
+AND NOT EXISTS (
+	SELECT 1
+	FROM tsdata_archive
+	WHERE tsdata_archive.dataset_uuid = datasets.uuid
+	AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||tsdata_archive.ts_uuid||'/data') = false
+)
 AND sqlc.arg(tags) && datasets.tags
 EXCEPT
 SELECT
@@ -179,6 +193,13 @@ WHERE datasets.uuid = sqlc.arg(uuid)
 LIMIT 1;
 
 -- name: FindDatasetByThing :many
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+)
 SELECT
 	uuid,
 	name,
@@ -193,6 +214,13 @@ SELECT
 	tags
 FROM datasets
 WHERE datasets.belongs_to = sqlc.arg(thing_uuid)
+-- Archived time series data is only listed with read access to the data of the time series
+AND NOT EXISTS (
+	SELECT 1
+	FROM tsdata_archive
+	WHERE tsdata_archive.dataset_uuid = datasets.uuid
+	AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||tsdata_archive.ts_uuid||'/data') = false
+)
 ORDER BY name
 ;
 
@@ -12,7 +12,11 @@ WITH t AS (
 		lower_bound,
 		upper_bound,
 		created_by,
-		tags
+		tags,
+		retention,
+		expression,
+		kind,
+		rollover
 	) VALUES (
 		NULLIF(sqlc.arg(thing_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
 		sqlc.arg(name),
@@ -20,7 +24,11 @@ WITH t AS (
 		sqlc.arg(lower_bound),
 		sqlc.arg(upper_bound),
 		sqlc.arg(created_by),
-		sqlc.arg(tags)
+		sqlc.arg(tags),
+		sqlc.arg(retention),
+		sqlc.arg(expression),
+		sqlc.arg(kind),
+		sqlc.arg(rollover)
 	) RETURNING *
 ), grp AS (
 	SELECT groups.uuid
@@ -53,6 +61,11 @@ SELECT * FROM timeseries
 WHERE uuid = sqlc.arg(uuid)
 LIMIT 1;
 
+-- name: GetTimeseriesByUUIDs :many
+SELECT * FROM timeseries
+WHERE uuid = ANY(sqlc.arg(uuids)::uuid[])
+ORDER BY uuid;
+
 -- name: GetUnitFromTimeseries :one
 SELECT si_unit FROM timeseries
 WHERE uuid = sqlc.arg(uuid)
@@ -88,6 +101,29 @@ LIMIT sqlc.arg(arg_limit)::BIGINT
 OFFSET sqlc.arg(arg_offset)::BIGINT
 ;
 
+-- name: FindTimeseriesByTagPrefix :many
+-- Time series with a tag starting with the prefix and every given tag, where the user has read access to the data.
+-- A page of time series, in the order of name and uuid, after the time series given by after_name and after_uuid.
+-- The prefix must not contain the wildcards of LIKE.
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+)
+SELECT timeseries.*
+FROM timeseries, usr
+WHERE EXISTS (
+	SELECT 1 FROM unnest(timeseries.tags) AS tag WHERE tag LIKE sqlc.arg(tag_prefix)::TEXT || '%'
+)
+AND timeseries.tags @> sqlc.arg(all_tags)::TEXT[]
+AND (timeseries.name, timeseries.uuid) > (sqlc.arg(after_name)::TEXT, sqlc.arg(after_uuid)::uuid)
+AND user_has_access(usr.uuid, 'read', 'timeseries/'||timeseries.uuid||'/data')
+ORDER BY timeseries.name, timeseries.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+;
+
 -- name: FindTimeseriesByTags :many
 WITH usr AS (
 	SELECT users.uuid
@@ -120,6 +156,61 @@ LIMIT sqlc.arg(arg_limit)::BIGINT
 OFFSET sqlc.arg(arg_offset)::BIGINT
 ;
 
+-- name: FindTimeseriesBySelector :many
+-- Time series matching every given selector, where the user has read access to the data
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+)
+SELECT timeseries.*
+FROM timeseries, usr
+WHERE (cardinality(sqlc.arg(any_tags)::TEXT[]) = 0 OR timeseries.tags && sqlc.arg(any_tags)::TEXT[])
+AND timeseries.tags @> sqlc.arg(all_tags)::TEXT[]
+AND (sqlc.arg(thing_null)::boolean = true OR timeseries.thing_uuid = sqlc.arg(thing_uuid)::uuid)
+AND user_has_access(usr.uuid, 'read', 'timeseries/'||timeseries.uuid||'/data')
+ORDER BY timeseries.name, timeseries.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+;
+
+-- name: SearchTimeseries :many
+-- Time series where the name or a tag contains the text, where the user has read access to the data
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+)
+SELECT timeseries.*
+FROM timeseries, usr
+WHERE (
+	strpos(lower(timeseries.name), lower(sqlc.arg(text)::TEXT)) > 0
+	OR EXISTS (
+		SELECT 1
+		FROM unnest(timeseries.tags) AS tag
+		WHERE strpos(lower(tag), lower(sqlc.arg(text)::TEXT)) > 0
+	)
+)
+AND user_has_access(usr.uuid, 'read', 'timeseries/'||timeseries.uuid||'/data')
+ORDER BY timeseries.name, timeseries.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+;
+
+-- name: FindTimeseriesByEachTag :many
+-- Time series carrying each of the tags, optionally only the time series of one thing
+SELECT tag::TEXT AS tag, timeseries.uuid
+FROM timeseries, unnest(sqlc.arg(tags)::TEXT[]) AS tag
+WHERE timeseries.tags @> ARRAY[tag]
+AND (sqlc.arg(thing_null)::boolean = true OR timeseries.thing_uuid = sqlc.arg(thing_uuid)::uuid)
+ORDER BY tag, timeseries.uuid;
+
+-- name: LockTimeseriesTag :exec
+-- Serializes the creation of time series carrying a tag until the end of the transaction
+SELECT pg_advisory_xact_lock(hashtext('timeseries_tag'), hashtext(sqlc.arg(tag)::text));
+
 -- name: FindTimeseriesByThing :many
 SELECT * FROM timeseries
 WHERE sqlc.arg(thing_uuid) = timeseries.thing_uuid
@@ -161,6 +252,35 @@ UPDATE timeseries
 SET tags = sqlc.arg(tags)
 WHERE timeseries.uuid = sqlc.arg(uuid);
 
+-- name: SetTimeseriesExpression :execrows
+UPDATE timeseries
+SET expression = sqlc.arg(expression)
+WHERE timeseries.uuid = sqlc.arg(uuid);
+
+-- name: SetTimeseriesKind :execrows
+UPDATE timeseries
+SET kind = sqlc.arg(kind),
+	rollover = sqlc.arg(rollover)
+WHERE timeseries.uuid = sqlc.arg(uuid);
+
+-- name: CountTimeseriesUsingInput :one
+SELECT COUNT(*) AS count
+FROM timeseries
+WHERE expression IS NOT NULL
+AND position(sqlc.arg(input)::text IN expression) > 0;
+
+-- name: SetTimeseriesRetention :execrows
+UPDATE timeseries
+SET retention = sqlc.arg(retention)
+WHERE timeseries.uuid = sqlc.arg(uuid);
+
+-- name: FindTimeseriesWithRetention :many
+SELECT uuid, retention::BIGINT AS retention
+FROM timeseries
+WHERE retention IS NOT NULL
+ORDER BY uuid
+;
+
 -- name: DeleteTimeseries :execrows
 DELETE FROM timeseries
 WHERE uuid = sqlc.arg(uuid);
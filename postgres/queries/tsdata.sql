@@ -1,48 +1,103 @@
 -- name: GetTsDataRange :many
 SELECT	ts_uuid,
 	value,
-	ts
+	ts,
+	quality
 FROM tsdata
 WHERE ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
 AND ts BETWEEN sqlc.arg(start) AND sqlc.arg(stop)
+-- Data points of an excluded quality are left out, plain measurements never are
+AND (quality IS NULL OR quality <> ALL(COALESCE(sqlc.arg(exclude_quality)::SMALLINT[], '{}')))
 ORDER BY ts ASC;
 
 -- name: GetTsDataRangeAgg :many
-WITH tsdata_trunc AS (
+WITH tsdata_points AS (
 	SELECT
-       	ts_uuid,
-       	value,
-	CASE
-		WHEN sqlc.arg(truncate)::text = 'minute5' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 5 * interval '5 min') AT time zone sqlc.arg(timezone)::text
-		WHEN sqlc.arg(truncate)::text = 'minute10' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 10 * interval '10 min') AT time zone sqlc.arg(timezone)::text
-		WHEN sqlc.arg(truncate)::text = 'minute15' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 15 * interval '15 min') AT time zone sqlc.arg(timezone)::text
-		WHEN sqlc.arg(truncate)::text = 'minute20' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 20 * interval '20 min') AT time zone sqlc.arg(timezone)::text
-		WHEN sqlc.arg(truncate)::text = 'minute30' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 30 * interval '30 min') AT time zone sqlc.arg(timezone)::text
-		ELSE
-		  date_trunc(sqlc.arg(truncate)::text, ts AT time zone sqlc.arg(timezone)::text) AT time zone sqlc.arg(timezone)::text
-	END AS ts
-	FROM tsdata
-	WHERE ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
-	AND ts BETWEEN (sqlc.arg(start)::timestamptz AT time zone sqlc.arg(timezone)::text)
-		AND (sqlc.arg(stop)::timestamptz AT time zone sqlc.arg(timezone)::text)
+	points.ts_uuid,
+	points.value,
+	points.quality,
+	points.ts AS ts_raw,
+	tsdata_bucket(points.ts, sqlc.arg(bucket_months)::int, sqlc.arg(bucket_width)::bigint, sqlc.arg(origin)::timestamptz, sqlc.arg(timezone)::text) AS ts,
+	counters.ts_uuid IS NOT NULL AS counter,
+	counters.rollover
+	FROM tsdata AS points
+	-- Time series of the kind counter, with 0 for no rollover value
+	LEFT JOIN unnest(sqlc.arg(counter_uuids)::uuid[], sqlc.arg(counter_rollovers)::DOUBLE PRECISION[]) AS counters(ts_uuid, rollover)
+	ON counters.ts_uuid = points.ts_uuid
+	WHERE points.ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+	AND points.ts BETWEEN sqlc.arg(start)::timestamptz AND sqlc.arg(stop)::timestamptz
+	-- Data points of an excluded quality are left out, plain measurements never are
+	AND (points.quality IS NULL OR points.quality <> ALL(COALESCE(sqlc.arg(exclude_quality)::SMALLINT[], '{}')))
+), tsdata_trunc AS (
+	SELECT
+	ts_uuid,
+	value,
+	quality,
+	ts_raw,
+	ts,
+	counter,
+	-- Increase of a counter since the data point before, compensated for resets and rollovers
+	(CASE WHEN counter THEN tsdata_counter_increase(lag(value) OVER w, value, NULLIF(rollover, 0)) END) AS increase,
+	-- The data point before is in the same bucket
+	COALESCE(lag(ts) OVER w = ts, false) AS continued
+	FROM tsdata_points
+	WINDOW w AS (PARTITION BY ts_uuid ORDER BY ts_raw)
+), tsdata_agg AS (
+	-- Every aggregate in the select list is computed, FILTER keeps the costly ones idle unless requested
+	SELECT
+		ts_uuid,
+		(CASE
+			WHEN sqlc.arg(aggregate)::text = 'avg'::text THEN AVG(value)
+			WHEN sqlc.arg(aggregate)::text = 'min'::text THEN MIN(value)
+			WHEN sqlc.arg(aggregate)::text = 'max'::text THEN MAX(value)
+			WHEN sqlc.arg(aggregate)::text = 'count'::text THEN COUNT(value)
+			-- The sum of a counter is its increase since the data point before the bucket
+			WHEN sqlc.arg(aggregate)::text = 'sum'::text AND counter THEN SUM(increase)
+			WHEN sqlc.arg(aggregate)::text = 'sum'::text THEN SUM(value)
+			WHEN sqlc.arg(aggregate)::text = 'first'::text THEN
+				(array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE sqlc.arg(aggregate)::text = 'first'::text))[1]
+			WHEN sqlc.arg(aggregate)::text = 'last'::text THEN
+				(array_agg(value ORDER BY ts_raw DESC) FILTER (WHERE sqlc.arg(aggregate)::text = 'last'::text))[1]
+			-- The delta and rate of a counter are its increase from the first to the last data point of the bucket
+			WHEN sqlc.arg(aggregate)::text = 'delta'::text AND counter THEN
+				COALESCE(SUM(increase) FILTER (WHERE continued), 0)
+			WHEN sqlc.arg(aggregate)::text = 'delta'::text THEN
+				(array_agg(value ORDER BY ts_raw DESC) FILTER (WHERE sqlc.arg(aggregate)::text IN ('delta', 'rate')))[1]
+				- (array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE sqlc.arg(aggregate)::text IN ('delta', 'rate')))[1]
+			WHEN sqlc.arg(aggregate)::text = 'rate'::text AND counter THEN
+				COALESCE(SUM(increase) FILTER (WHERE continued), 0)
+				/ NULLIF(EXTRACT(EPOCH FROM MAX(ts_raw) - MIN(ts_raw)), 0)
+			WHEN sqlc.arg(aggregate)::text = 'rate'::text THEN
+				((array_agg(value ORDER BY ts_raw DESC) FILTER (WHERE sqlc.arg(aggregate)::text IN ('delta', 'rate')))[1]
+				- (array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE sqlc.arg(aggregate)::text IN ('delta', 'rate')))[1])
+				/ NULLIF(EXTRACT(EPOCH FROM MAX(ts_raw) - MIN(ts_raw)), 0)
+			WHEN sqlc.arg(aggregate)::text = 'integral'::text THEN
+				tsdata_integral(
+					array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE sqlc.arg(aggregate)::text = 'integral'::text),
+					array_agg(ts_raw ORDER BY ts_raw ASC) FILTER (WHERE sqlc.arg(aggregate)::text = 'integral'::text)
+				) / 3600
+			WHEN sqlc.arg(aggregate)::text = 'median'::text THEN
+				percentile_cont(0.5) WITHIN GROUP (ORDER BY value) FILTER (WHERE sqlc.arg(aggregate)::text = 'median'::text)
+			WHEN sqlc.arg(aggregate)::text = 'p95'::text THEN
+				percentile_cont(0.95) WITHIN GROUP (ORDER BY value) FILTER (WHERE sqlc.arg(aggregate)::text = 'p95'::text)
+			WHEN sqlc.arg(aggregate)::text = 'p99'::text THEN
+				percentile_cont(0.99) WITHIN GROUP (ORDER BY value) FILTER (WHERE sqlc.arg(aggregate)::text = 'p99'::text)
+			WHEN sqlc.arg(aggregate)::text = 'stddev'::text THEN STDDEV_SAMP(value)
+		END)::DOUBLE PRECISION AS value,
+		-- The most severe quality of the bucket
+		MAX(quality) AS quality,
+		ts
+	FROM tsdata_trunc
+	GROUP BY ts_uuid, ts, counter
 )
 SELECT
         ts_uuid::uuid,
-	(CASE
-		WHEN sqlc.arg(aggregate)::text = 'avg'::text THEN AVG(value)
-		WHEN sqlc.arg(aggregate)::text = 'min'::text THEN MIN(value)
-		WHEN sqlc.arg(aggregate)::text = 'max'::text THEN MAX(value)
-		WHEN sqlc.arg(aggregate)::text = 'count'::text THEN COUNT(value)
-		WHEN sqlc.arg(aggregate)::text = 'sum'::text THEN SUM(value)
-	END)::DOUBLE PRECISION AS value,
-        ts::timestamptz
-FROM tsdata_trunc
-GROUP BY ts_uuid, ts
+	value::DOUBLE PRECISION,
+        ts::timestamptz,
+	quality
+FROM tsdata_agg
+-- Skip buckets where the aggregate is undefined, such as rate or stddev of a single value
+WHERE value IS NOT NULL
 ORDER BY ts ASC;
 
 -- name: CreateTsData :execrows
@@ -58,6 +113,22 @@ VALUES (
 DELETE FROM tsdata
 WHERE ts_uuid = ANY(sqlc.arg(ts_uuid));
 
+-- name: GetTsDataFirstTimestampBefore :one
+SELECT ts
+FROM tsdata
+WHERE ts_uuid = sqlc.arg(ts_uuid)
+AND ts < sqlc.arg(before)
+ORDER BY ts ASC
+LIMIT 1;
+
+-- name: GetTsDataValueBefore :one
+SELECT value
+FROM tsdata
+WHERE ts_uuid = sqlc.arg(ts_uuid)
+AND ts < sqlc.arg(before)
+ORDER BY ts DESC
+LIMIT 1;
+
 -- name: DeleteTsDataRange :execrows
 DELETE FROM tsdata
 WHERE ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
@@ -65,3 +136,12 @@ AND ts BETWEEN sqlc.arg(start) AND sqlc.arg(stop)
 AND (sqlc.arg(ge_null)::boolean = true OR tsdata.value >= sqlc.arg(ge))
 AND (sqlc.arg(le_null)::boolean = true OR tsdata.value <= sqlc.arg(le))
 ;
+
+-- name: ConvertTsDataValues :execrows
+UPDATE tsdata
+SET value = value * sqlc.arg(scale)::DOUBLE PRECISION + sqlc.arg(shift)::DOUBLE PRECISION
+WHERE ts_uuid = sqlc.arg(ts_uuid);
+
+-- name: DisableTsDataNotify :exec
+-- Turns off the notifications of changed data points until the end of the transaction
+SELECT set_config('selfhost.tsdata_notify', 'off', true);
@@ -0,0 +1,103 @@
+-- name: FindTimeseriesWithTsDataBefore :many
+SELECT uuid, name
+FROM timeseries
+WHERE EXISTS (
+	SELECT 1
+	FROM tsdata
+	WHERE tsdata.ts_uuid = timeseries.uuid
+	AND tsdata.ts < sqlc.arg(before)::timestamptz
+)
+ORDER BY uuid;
+
+-- name: DeleteTsDataRangeReturning :many
+DELETE FROM tsdata
+WHERE ts_uuid = sqlc.arg(ts_uuid)::uuid
+AND ts BETWEEN sqlc.arg(start)::timestamptz AND sqlc.arg(stop)::timestamptz
+RETURNING value, ts, quality;
+
+-- name: CreateTsDataArchive :one
+-- The dataset belongs to the thing of the time series
+WITH ds AS (
+	INSERT INTO datasets (name, format, content, checksum, size, belongs_to, created_by, updated_by, tags)
+	SELECT
+		sqlc.arg(name)::text,
+		'tsarchive',
+		sqlc.arg(content)::bytea,
+		sha256(sqlc.arg(content)::bytea),
+		length(sqlc.arg(content))::integer,
+		timeseries.thing_uuid,
+		timeseries.created_by,
+		timeseries.created_by,
+		ARRAY['tsarchive']::TEXT[]
+	FROM timeseries
+	WHERE timeseries.uuid = sqlc.arg(ts_uuid)::uuid
+	RETURNING uuid
+)
+INSERT INTO tsdata_archive(dataset_uuid, ts_uuid, start, stop, count)
+SELECT ds.uuid, sqlc.arg(ts_uuid)::uuid, sqlc.arg(start)::timestamptz, sqlc.arg(stop)::timestamptz, sqlc.arg(count)::bigint
+FROM ds
+RETURNING dataset_uuid;
+
+-- name: FindTsDataArchives :many
+SELECT dataset_uuid, ts_uuid, start, stop, count
+FROM tsdata_archive
+WHERE ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+AND start <= sqlc.arg(stop)::timestamptz
+AND stop >= sqlc.arg(start)::timestamptz
+ORDER BY ts_uuid, start;
+
+-- name: FindTsDataArchivesByTimeseries :many
+SELECT dataset_uuid, ts_uuid, start, stop, count
+FROM tsdata_archive
+WHERE ts_uuid = sqlc.arg(ts_uuid)::uuid
+ORDER BY start;
+
+-- name: GetTsDataArchiveByDataset :one
+SELECT dataset_uuid, ts_uuid, start, stop, count
+FROM tsdata_archive
+WHERE dataset_uuid = sqlc.arg(dataset_uuid)::uuid;
+
+-- name: GetTsDataArchiveContent :one
+SELECT content
+FROM datasets
+WHERE uuid = sqlc.arg(dataset_uuid)::uuid;
+
+-- name: DeleteTsDataArchive :execrows
+-- Deletes the archive together with its dataset
+DELETE FROM tsdata_archive
+WHERE dataset_uuid = sqlc.arg(dataset_uuid)::uuid;
+
+-- name: DeleteTsDataArchivesBefore :many
+-- Deletes the archives where all data is before the cutoff, together with their datasets
+DELETE FROM tsdata_archive
+WHERE ts_uuid = sqlc.arg(ts_uuid)::uuid
+AND stop < sqlc.arg(before)::timestamptz
+RETURNING start, stop, count;
+
+-- name: GetTsDataArchivedHour :one
+-- The first of the hours where the time series has archived data
+SELECT h.start::timestamptz
+FROM unnest(sqlc.arg(hours)::timestamptz[]) AS h(start)
+WHERE EXISTS (
+	SELECT 1
+	FROM tsdata_archive
+	WHERE tsdata_archive.ts_uuid = sqlc.arg(ts_uuid)::uuid
+	AND tsdata_archive.start < h.start + interval '1 hour'
+	AND tsdata_archive.stop >= h.start
+)
+ORDER BY h.start
+LIMIT 1;
+
+-- name: UpdateTsDataArchive :execrows
+-- Replaces the content of an archive, the range and count are those of the new content
+WITH ds AS (
+	UPDATE datasets
+	SET content = sqlc.arg(content)::bytea,
+		checksum = sha256(sqlc.arg(content)::bytea)
+	WHERE uuid = sqlc.arg(dataset_uuid)::uuid
+)
+UPDATE tsdata_archive
+SET start = sqlc.arg(start)::timestamptz,
+	stop = sqlc.arg(stop)::timestamptz,
+	count = sqlc.arg(count)::bigint
+WHERE dataset_uuid = sqlc.arg(dataset_uuid)::uuid;
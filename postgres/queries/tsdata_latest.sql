@@ -0,0 +1,26 @@
+-- name: GetTsDataVersions :many
+SELECT
+	timeseries.uuid,
+	timeseries.si_unit,
+	(timeseries.expression IS NOT NULL)::boolean AS derived,
+	COALESCE(tsdata_version.version, 0)::BIGINT AS version
+FROM timeseries
+LEFT JOIN tsdata_version ON tsdata_version.ts_uuid = timeseries.uuid
+WHERE timeseries.uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+ORDER BY timeseries.uuid;
+
+-- name: GetTsDataLatest :many
+-- The newest arg_limit data points of every time series, newest first
+SELECT
+	s.uuid::uuid AS ts_uuid,
+	d.value,
+	d.ts
+FROM unnest(sqlc.arg(ts_uuids)::uuid[]) AS s(uuid)
+CROSS JOIN LATERAL (
+	SELECT value, ts
+	FROM tsdata
+	WHERE tsdata.ts_uuid = s.uuid
+	ORDER BY ts DESC
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+) AS d
+ORDER BY 1, d.ts DESC;
@@ -0,0 +1,14 @@
+-- name: RegisterTsDataListener :exec
+-- Registers or renews the subscribed time series of a listener, for lease seconds
+INSERT INTO tsdata_listener(listener, ts_uuid, expires)
+SELECT sqlc.arg(listener)::uuid, timeseries.uuid, NOW() + sqlc.arg(lease)::BIGINT * INTERVAL '1 second'
+FROM timeseries
+WHERE timeseries.uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+ON CONFLICT (listener, ts_uuid) DO UPDATE
+SET expires = EXCLUDED.expires;
+
+-- name: PruneTsDataListeners :exec
+-- Removes the time series no longer subscribed to by a listener, and every expired registration
+DELETE FROM tsdata_listener
+WHERE (listener = sqlc.arg(listener)::uuid AND ts_uuid <> ALL(sqlc.arg(ts_uuids)::uuid[]))
+OR expires < NOW();
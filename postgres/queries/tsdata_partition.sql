@@ -0,0 +1,46 @@
+-- name: GetTsDataPartitioning :one
+SELECT width, hash_partitions, parent
+FROM tsdata_partitioning;
+
+-- name: FindTsDataPartitions :many
+SELECT name, lower, upper
+FROM tsdata_partition
+ORDER BY lower ASC;
+
+-- name: CreateTsDataPartition :one
+SELECT tsdata_create_partition(sqlc.arg(name)::TEXT, sqlc.arg(lower)::TIMESTAMPTZ, sqlc.arg(upper)::TIMESTAMPTZ)::BOOLEAN AS created;
+
+-- name: DropTsDataPartition :one
+SELECT tsdata_drop_partition(sqlc.arg(name)::TEXT, sqlc.arg(now)::TIMESTAMPTZ)::BOOLEAN AS dropped;
+
+-- name: BeginTsDataPartitioning :exec
+SELECT tsdata_partition_begin(sqlc.arg(width)::TEXT, sqlc.arg(hash_partitions)::INTEGER);
+
+-- name: FindTsDataTimeRanges :many
+-- First and last timestamp of every time series with data, from the index on tsdata
+SELECT
+	timeseries.uuid AS ts_uuid,
+	f.ts::timestamptz AS first,
+	l.ts::timestamptz AS last
+FROM timeseries
+INNER JOIN LATERAL (
+	SELECT ts
+	FROM tsdata
+	WHERE tsdata.ts_uuid = timeseries.uuid
+	ORDER BY ts ASC
+	LIMIT 1
+) AS f ON true
+INNER JOIN LATERAL (
+	SELECT ts
+	FROM tsdata
+	WHERE tsdata.ts_uuid = timeseries.uuid
+	ORDER BY ts DESC
+	LIMIT 1
+) AS l ON true
+ORDER BY timeseries.uuid;
+
+-- name: CopyTsDataToPartitions :one
+SELECT tsdata_partition_copy(sqlc.arg(ts_uuid)::uuid, sqlc.arg(after)::TIMESTAMPTZ, sqlc.arg(arg_limit)::INTEGER) AS last;
+
+-- name: FinishTsDataPartitioning :exec
+SELECT tsdata_partition_finish();
@@ -0,0 +1,14 @@
+-- name: FindTsDataQuarantine :many
+SELECT ts_uuid, value, ts, reason, created_by, created, quality
+FROM tsdata_quarantine
+WHERE ts_uuid = sqlc.arg(ts_uuid)
+AND ts BETWEEN sqlc.arg(start)::timestamptz AND sqlc.arg(stop)::timestamptz
+ORDER BY ts ASC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT
+;
+
+-- name: ConvertTsDataQuarantineValues :execrows
+UPDATE tsdata_quarantine
+SET value = value * sqlc.arg(scale)::DOUBLE PRECISION + sqlc.arg(shift)::DOUBLE PRECISION
+WHERE ts_uuid = sqlc.arg(ts_uuid);
@@ -0,0 +1,120 @@
+-- name: LockTsDataRollup :exec
+-- Serializes rollup updates of a time series until the end of the transaction
+SELECT pg_advisory_xact_lock(hashtext('tsdata_rollup'), hashtext(sqlc.arg(ts_uuid)::text));
+
+-- name: UpsertTsDataRollupHours :execrows
+INSERT INTO tsdata_rollup(ts_uuid, width, ts, count, sum, min, max, quality)
+SELECT
+	tsdata.ts_uuid,
+	w.width,
+	to_timestamp(floor(EXTRACT(EPOCH FROM tsdata.ts) / w.width) * w.width),
+	COUNT(*),
+	SUM(tsdata.value),
+	MIN(tsdata.value),
+	MAX(tsdata.value),
+	MAX(tsdata.quality)
+FROM unnest(sqlc.arg(hours)::timestamptz[]) AS h(start)
+JOIN tsdata ON tsdata.ts >= h.start AND tsdata.ts < h.start + interval '1 hour',
+unnest(ARRAY[300, 3600]) AS w(width)
+WHERE tsdata.ts_uuid = sqlc.arg(ts_uuid)
+GROUP BY 1, 2, 3
+ON CONFLICT (ts_uuid, width, ts) DO UPDATE
+SET count = EXCLUDED.count,
+	sum = EXCLUDED.sum,
+	min = EXCLUDED.min,
+	max = EXCLUDED.max,
+	quality = EXCLUDED.quality;
+
+-- name: DeleteTsDataRollupRange :execrows
+DELETE FROM tsdata_rollup
+WHERE ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+AND ts >= sqlc.arg(start)::timestamptz
+AND ts < sqlc.arg(stop)::timestamptz;
+
+-- name: CreateTsDataRollupRange :execrows
+INSERT INTO tsdata_rollup(ts_uuid, width, ts, count, sum, min, max, quality)
+SELECT
+	tsdata.ts_uuid,
+	w.width,
+	to_timestamp(floor(EXTRACT(EPOCH FROM tsdata.ts) / w.width) * w.width),
+	COUNT(*),
+	SUM(tsdata.value),
+	MIN(tsdata.value),
+	MAX(tsdata.value),
+	MAX(tsdata.quality)
+FROM tsdata, unnest(ARRAY[300, 3600]) AS w(width)
+WHERE tsdata.ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+AND tsdata.ts >= sqlc.arg(start)::timestamptz
+AND tsdata.ts < sqlc.arg(stop)::timestamptz
+GROUP BY 1, 2, 3
+ON CONFLICT (ts_uuid, width, ts) DO UPDATE
+SET count = EXCLUDED.count,
+	sum = EXCLUDED.sum,
+	min = EXCLUDED.min,
+	max = EXCLUDED.max,
+	quality = EXCLUDED.quality;
+
+-- name: AddTsDataRollupParts :execrows
+-- Adds aggregates of archived data to the rollups, on top of those of the data in tsdata
+INSERT INTO tsdata_rollup(ts_uuid, width, ts, count, sum, min, max, quality)
+SELECT sqlc.arg(ts_uuid)::uuid, p.width, p.ts, p.count, p.sum, p.min, p.max, NULLIF(p.quality, 0)
+FROM unnest(sqlc.arg(widths)::int[], sqlc.arg(ts)::timestamptz[], sqlc.arg(counts)::BIGINT[], sqlc.arg(sums)::DOUBLE PRECISION[], sqlc.arg(mins)::DOUBLE PRECISION[], sqlc.arg(maxs)::DOUBLE PRECISION[], sqlc.arg(qualities)::SMALLINT[]) AS p(width, ts, count, sum, min, max, quality)
+ON CONFLICT (ts_uuid, width, ts) DO UPDATE
+SET count = tsdata_rollup.count + EXCLUDED.count,
+	sum = tsdata_rollup.sum + EXCLUDED.sum,
+	min = LEAST(tsdata_rollup.min, EXCLUDED.min),
+	max = GREATEST(tsdata_rollup.max, EXCLUDED.max),
+	quality = GREATEST(tsdata_rollup.quality, EXCLUDED.quality);
+
+-- name: GetTsDataRangeAggRollup :many
+-- Rollups answer the range from rollup_start to rollup_stop, raw data the edges of the range
+WITH parts AS (
+	SELECT ts_uuid, ts, count, sum, min, max, quality
+	FROM tsdata_rollup
+	WHERE width = sqlc.arg(rollup_width)::int
+	AND ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+	AND ts >= sqlc.arg(rollup_start)::timestamptz
+	AND ts < sqlc.arg(rollup_stop)::timestamptz
+	UNION ALL
+	SELECT ts_uuid, ts, 1, value, value, value, quality
+	FROM tsdata
+	WHERE ts_uuid = ANY(sqlc.arg(ts_uuids)::uuid[])
+	AND ts BETWEEN sqlc.arg(start)::timestamptz AND sqlc.arg(stop)::timestamptz
+	AND (ts < sqlc.arg(rollup_start)::timestamptz OR ts >= sqlc.arg(rollup_stop)::timestamptz)
+	UNION ALL
+	-- Archived data points of the edges, aggregated per bucket, with 0 for no quality
+	SELECT a.ts_uuid, a.ts, a.count, a.sum, a.min, a.max, NULLIF(a.quality, 0)
+	FROM unnest(sqlc.arg(archived_uuids)::uuid[], sqlc.arg(archived_ts)::timestamptz[], sqlc.arg(archived_count)::BIGINT[], sqlc.arg(archived_sum)::DOUBLE PRECISION[], sqlc.arg(archived_min)::DOUBLE PRECISION[], sqlc.arg(archived_max)::DOUBLE PRECISION[], sqlc.arg(archived_quality)::SMALLINT[]) AS a(ts_uuid, ts, count, sum, min, max, quality)
+), buckets AS (
+	SELECT
+		ts_uuid,
+		tsdata_bucket(ts, sqlc.arg(bucket_months)::int, sqlc.arg(bucket_width)::bigint, sqlc.arg(origin)::timestamptz, sqlc.arg(timezone)::text) AS ts,
+		SUM(count) AS count,
+		SUM(sum) AS sum,
+		MIN(min) AS min,
+		MAX(max) AS max,
+		MAX(quality) AS quality
+	FROM parts
+	GROUP BY 1, 2
+)
+SELECT
+	ts_uuid::uuid,
+	(CASE
+		WHEN sqlc.arg(aggregate)::text = 'avg'::text THEN sum / count
+		WHEN sqlc.arg(aggregate)::text = 'min'::text THEN min
+		WHEN sqlc.arg(aggregate)::text = 'max'::text THEN max
+		WHEN sqlc.arg(aggregate)::text = 'count'::text THEN count
+		WHEN sqlc.arg(aggregate)::text = 'sum'::text THEN sum
+	END)::DOUBLE PRECISION AS value,
+	ts::timestamptz,
+	quality
+FROM buckets
+ORDER BY ts ASC;
+
+-- name: ConvertTsDataRollupValues :execrows
+-- The scale must be positive, as min and max would otherwise swap places
+UPDATE tsdata_rollup
+SET sum = sum * sqlc.arg(scale)::DOUBLE PRECISION + count * sqlc.arg(shift)::DOUBLE PRECISION,
+	min = min * sqlc.arg(scale)::DOUBLE PRECISION + sqlc.arg(shift)::DOUBLE PRECISION,
+	max = max * sqlc.arg(scale)::DOUBLE PRECISION + sqlc.arg(shift)::DOUBLE PRECISION
+WHERE ts_uuid = sqlc.arg(ts_uuid);
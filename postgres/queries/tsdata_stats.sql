@@ -0,0 +1,30 @@
+-- name: GetTsDataStats :many
+-- Count, min and max come from the hourly rollups, first and last from the index on tsdata
+SELECT
+	s.uuid::uuid AS ts_uuid,
+	r.count::BIGINT AS count,
+	r.min::DOUBLE PRECISION AS min,
+	r.max::DOUBLE PRECISION AS max,
+	f.ts::timestamptz AS first,
+	l.ts::timestamptz AS last
+FROM unnest(sqlc.arg(ts_uuids)::uuid[]) AS s(uuid)
+CROSS JOIN LATERAL (
+	SELECT COALESCE(SUM(count), 0) AS count, MIN(min) AS min, MAX(max) AS max
+	FROM tsdata_rollup
+	WHERE tsdata_rollup.ts_uuid = s.uuid
+	AND width = 3600
+) AS r
+LEFT JOIN LATERAL (
+	SELECT ts
+	FROM tsdata
+	WHERE tsdata.ts_uuid = s.uuid
+	ORDER BY ts ASC
+	LIMIT 1
+) AS f ON true
+LEFT JOIN LATERAL (
+	SELECT ts
+	FROM tsdata
+	WHERE tsdata.ts_uuid = s.uuid
+	ORDER BY ts DESC
+	LIMIT 1
+) AS l ON true;
@@ -11,6 +11,20 @@ import (
 	"github.com/lib/pq"
 )
 
+const countTimeseriesUsingInput = `-- name: CountTimeseriesUsingInput :one
+SELECT COUNT(*) AS count
+FROM timeseries
+WHERE expression IS NOT NULL
+AND position($1::text IN expression) > 0
+`
+
+func (q *Queries) CountTimeseriesUsingInput(ctx context.Context, input string) (int64, error) {
+	row := q.queryRow(ctx, q.countTimeseriesUsingInputStmt, countTimeseriesUsingInput, input)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createTimeseries = `-- name: CreateTimeseries :one
 WITH t AS (
 	INSERT INTO timeseries(
@@ -20,7 +34,11 @@ WITH t AS (
 		lower_bound,
 		upper_bound,
 		created_by,
-		tags
+		tags,
+		retention,
+		expression,
+		kind,
+		rollover
 	) VALUES (
 		NULLIF($1::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
 		$2,
@@ -28,8 +46,12 @@ WITH t AS (
 		$4,
 		$5,
 		$6,
-		$7
-	) RETURNING uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+		$7,
+		$8,
+		$9,
+		$10,
+		$11
+	) RETURNING uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover
 ), grp AS (
 	SELECT groups.uuid
 	FROM groups, user_groups
@@ -53,7 +75,7 @@ WITH t AS (
 		(SELECT uuid FROM grp), 0, 'allow', 'delete','timeseries/'||(SELECT uuid FROM t)||'/%'
 	)
 )
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover
 FROM t LIMIT 1
 `
 
@@ -65,6 +87,10 @@ type CreateTimeseriesParams struct {
 	UpperBound sql.NullFloat64
 	CreatedBy  uuid.UUID
 	Tags       []string
+	Retention  sql.NullInt64
+	Expression sql.NullString
+	Kind       string
+	Rollover   sql.NullFloat64
 }
 
 type CreateTimeseriesRow struct {
@@ -76,6 +102,10 @@ type CreateTimeseriesRow struct {
 	UpperBound sql.NullFloat64
 	CreatedBy  uuid.UUID
 	Tags       []string
+	Retention  sql.NullInt64
+	Expression sql.NullString
+	Kind       string
+	Rollover   sql.NullFloat64
 }
 
 func (q *Queries) CreateTimeseries(ctx context.Context, arg CreateTimeseriesParams) (CreateTimeseriesRow, error) {
@@ -87,6 +117,10 @@ func (q *Queries) CreateTimeseries(ctx context.Context, arg CreateTimeseriesPara
 		arg.UpperBound,
 		arg.CreatedBy,
 		pq.Array(arg.Tags),
+		arg.Retention,
+		arg.Expression,
+		arg.Kind,
+		arg.Rollover,
 	)
 	var i CreateTimeseriesRow
 	err := row.Scan(
@@ -98,6 +132,10 @@ func (q *Queries) CreateTimeseries(ctx context.Context, arg CreateTimeseriesPara
 		&i.UpperBound,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Retention,
+		&i.Expression,
+		&i.Kind,
+		&i.Rollover,
 	)
 	return i, err
 }
@@ -142,13 +180,13 @@ WITH usr AS (
 	AND user_groups.user_uuid = (SELECT uuid FROM usr)
 	AND action = 'read'
 )
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
 EXCEPT
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -182,6 +220,199 @@ func (q *Queries) FindTimeseries(ctx context.Context, arg FindTimeseriesParams)
 			&i.UpperBound,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Retention,
+			&i.Expression,
+			&i.Kind,
+			&i.Rollover,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findTimeseriesByEachTag = `-- name: FindTimeseriesByEachTag :many
+-- Time series carrying each of the tags, optionally only the time series of one thing
+SELECT tag::TEXT AS tag, timeseries.uuid
+FROM timeseries, unnest($1::TEXT[]) AS tag
+WHERE timeseries.tags @> ARRAY[tag]
+AND ($2::boolean = true OR timeseries.thing_uuid = $3::uuid)
+ORDER BY tag, timeseries.uuid
+`
+
+type FindTimeseriesByEachTagParams struct {
+	Tags      []string
+	ThingNull bool
+	ThingUuid uuid.UUID
+}
+
+type FindTimeseriesByEachTagRow struct {
+	Tag  string
+	Uuid uuid.UUID
+}
+
+func (q *Queries) FindTimeseriesByEachTag(ctx context.Context, arg FindTimeseriesByEachTagParams) ([]FindTimeseriesByEachTagRow, error) {
+	rows, err := q.query(ctx, q.findTimeseriesByEachTagStmt, findTimeseriesByEachTag, pq.Array(arg.Tags), arg.ThingNull, arg.ThingUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindTimeseriesByEachTagRow{}
+	for rows.Next() {
+		var i FindTimeseriesByEachTagRow
+		if err := rows.Scan(&i.Tag, &i.Uuid); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findTimeseriesBySelector = `-- name: FindTimeseriesBySelector :many
+-- Time series matching every given selector, where the user has read access to the data
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+)
+SELECT timeseries.uuid, timeseries.thing_uuid, timeseries.name, timeseries.si_unit, timeseries.lower_bound, timeseries.upper_bound, timeseries.created_by, timeseries.tags, timeseries.retention, timeseries.expression, timeseries.kind, timeseries.rollover
+FROM timeseries, usr
+WHERE (cardinality($2::TEXT[]) = 0 OR timeseries.tags && $2::TEXT[])
+AND timeseries.tags @> $3::TEXT[]
+AND ($4::boolean = true OR timeseries.thing_uuid = $5::uuid)
+AND user_has_access(usr.uuid, 'read', 'timeseries/'||timeseries.uuid||'/data')
+ORDER BY timeseries.name, timeseries.uuid
+LIMIT $6::BIGINT
+`
+
+type FindTimeseriesBySelectorParams struct {
+	Token     []byte
+	AnyTags   []string
+	AllTags   []string
+	ThingNull bool
+	ThingUuid uuid.UUID
+	ArgLimit  int64
+}
+
+func (q *Queries) FindTimeseriesBySelector(ctx context.Context, arg FindTimeseriesBySelectorParams) ([]Timeseries, error) {
+	rows, err := q.query(ctx, q.findTimeseriesBySelectorStmt, findTimeseriesBySelector,
+		arg.Token,
+		pq.Array(arg.AnyTags),
+		pq.Array(arg.AllTags),
+		arg.ThingNull,
+		arg.ThingUuid,
+		arg.ArgLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Timeseries{}
+	for rows.Next() {
+		var i Timeseries
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.ThingUuid,
+			&i.Name,
+			&i.SiUnit,
+			&i.LowerBound,
+			&i.UpperBound,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Retention,
+			&i.Expression,
+			&i.Kind,
+			&i.Rollover,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findTimeseriesByTagPrefix = `-- name: FindTimeseriesByTagPrefix :many
+-- Time series with a tag starting with the prefix and every given tag, where the user has read access to the data.
+-- A page of time series, in the order of name and uuid, after the time series given by after_name and after_uuid.
+-- The prefix must not contain the wildcards of LIKE.
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+)
+SELECT timeseries.uuid, timeseries.thing_uuid, timeseries.name, timeseries.si_unit, timeseries.lower_bound, timeseries.upper_bound, timeseries.created_by, timeseries.tags, timeseries.retention, timeseries.expression, timeseries.kind, timeseries.rollover
+FROM timeseries, usr
+WHERE EXISTS (
+	SELECT 1 FROM unnest(timeseries.tags) AS tag WHERE tag LIKE $2::TEXT || '%'
+)
+AND timeseries.tags @> $3::TEXT[]
+AND (timeseries.name, timeseries.uuid) > ($4::TEXT, $5::uuid)
+AND user_has_access(usr.uuid, 'read', 'timeseries/'||timeseries.uuid||'/data')
+ORDER BY timeseries.name, timeseries.uuid
+LIMIT $6::BIGINT
+`
+
+type FindTimeseriesByTagPrefixParams struct {
+	Token     []byte
+	TagPrefix string
+	AllTags   []string
+	AfterName string
+	AfterUuid uuid.UUID
+	ArgLimit  int64
+}
+
+func (q *Queries) FindTimeseriesByTagPrefix(ctx context.Context, arg FindTimeseriesByTagPrefixParams) ([]Timeseries, error) {
+	rows, err := q.query(ctx, q.findTimeseriesByTagPrefixStmt, findTimeseriesByTagPrefix,
+		arg.Token,
+		arg.TagPrefix,
+		pq.Array(arg.AllTags),
+		arg.AfterName,
+		arg.AfterUuid,
+		arg.ArgLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Timeseries{}
+	for rows.Next() {
+		var i Timeseries
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.ThingUuid,
+			&i.Name,
+			&i.SiUnit,
+			&i.LowerBound,
+			&i.UpperBound,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Retention,
+			&i.Expression,
+			&i.Kind,
+			&i.Rollover,
 		); err != nil {
 			return nil, err
 		}
@@ -210,14 +441,14 @@ WITH usr AS (
 	AND user_groups.user_uuid = (SELECT uuid FROM usr)
 	AND action = 'read'
 )
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
 AND $4 && timeseries.tags
 EXCEPT
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -258,6 +489,10 @@ func (q *Queries) FindTimeseriesByTags(ctx context.Context, arg FindTimeseriesBy
 			&i.UpperBound,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Retention,
+			&i.Expression,
+			&i.Kind,
+			&i.Rollover,
 		); err != nil {
 			return nil, err
 		}
@@ -273,7 +508,7 @@ func (q *Queries) FindTimeseriesByTags(ctx context.Context, arg FindTimeseriesBy
 }
 
 const findTimeseriesByThing = `-- name: FindTimeseriesByThing :many
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags FROM timeseries
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover FROM timeseries
 WHERE $1 = timeseries.thing_uuid
 ORDER BY name
 `
@@ -296,6 +531,10 @@ func (q *Queries) FindTimeseriesByThing(ctx context.Context, thingUuid interface
 			&i.UpperBound,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Retention,
+			&i.Expression,
+			&i.Kind,
+			&i.Rollover,
 		); err != nil {
 			return nil, err
 		}
@@ -311,7 +550,7 @@ func (q *Queries) FindTimeseriesByThing(ctx context.Context, thingUuid interface
 }
 
 const findTimeseriesByUUID = `-- name: FindTimeseriesByUUID :one
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags FROM timeseries
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover FROM timeseries
 WHERE $1 = timeseries.uuid
 LIMIT 1
 `
@@ -328,12 +567,51 @@ func (q *Queries) FindTimeseriesByUUID(ctx context.Context, tsUuid interface{})
 		&i.UpperBound,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Retention,
+		&i.Expression,
+		&i.Kind,
+		&i.Rollover,
 	)
 	return i, err
 }
 
+const findTimeseriesWithRetention = `-- name: FindTimeseriesWithRetention :many
+SELECT uuid, retention::BIGINT AS retention
+FROM timeseries
+WHERE retention IS NOT NULL
+ORDER BY uuid
+`
+
+type FindTimeseriesWithRetentionRow struct {
+	Uuid      uuid.UUID
+	Retention int64
+}
+
+func (q *Queries) FindTimeseriesWithRetention(ctx context.Context) ([]FindTimeseriesWithRetentionRow, error) {
+	rows, err := q.query(ctx, q.findTimeseriesWithRetentionStmt, findTimeseriesWithRetention)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindTimeseriesWithRetentionRow{}
+	for rows.Next() {
+		var i FindTimeseriesWithRetentionRow
+		if err := rows.Scan(&i.Uuid, &i.Retention); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTimeseriesByUUID = `-- name: GetTimeseriesByUUID :one
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags FROM timeseries
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover FROM timeseries
 WHERE uuid = $1
 LIMIT 1
 `
@@ -350,10 +628,56 @@ func (q *Queries) GetTimeseriesByUUID(ctx context.Context, uuid uuid.UUID) (Time
 		&i.UpperBound,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Retention,
+		&i.Expression,
+		&i.Kind,
+		&i.Rollover,
 	)
 	return i, err
 }
 
+const getTimeseriesByUUIDs = `-- name: GetTimeseriesByUUIDs :many
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, retention, expression, kind, rollover FROM timeseries
+WHERE uuid = ANY($1::uuid[])
+ORDER BY uuid
+`
+
+func (q *Queries) GetTimeseriesByUUIDs(ctx context.Context, uuids []uuid.UUID) ([]Timeseries, error) {
+	rows, err := q.query(ctx, q.getTimeseriesByUUIDsStmt, getTimeseriesByUUIDs, pq.Array(uuids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Timeseries{}
+	for rows.Next() {
+		var i Timeseries
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.ThingUuid,
+			&i.Name,
+			&i.SiUnit,
+			&i.LowerBound,
+			&i.UpperBound,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Retention,
+			&i.Expression,
+			&i.Kind,
+			&i.Rollover,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUnitFromTimeseries = `-- name: GetUnitFromTimeseries :one
 SELECT si_unit FROM timeseries
 WHERE uuid = $1
@@ -367,6 +691,122 @@ func (q *Queries) GetUnitFromTimeseries(ctx context.Context, uuid uuid.UUID) (st
 	return si_unit, err
 }
 
+const lockTimeseriesTag = `-- name: LockTimeseriesTag :exec
+-- Serializes the creation of time series carrying a tag until the end of the transaction
+SELECT pg_advisory_xact_lock(hashtext('timeseries_tag'), hashtext($1::text))
+`
+
+func (q *Queries) LockTimeseriesTag(ctx context.Context, tag string) error {
+	_, err := q.exec(ctx, q.lockTimeseriesTagStmt, lockTimeseriesTag, tag)
+	return err
+}
+
+const searchTimeseries = `-- name: SearchTimeseries :many
+-- Time series where the name or a tag contains the text, where the user has read access to the data
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+)
+SELECT timeseries.uuid, timeseries.thing_uuid, timeseries.name, timeseries.si_unit, timeseries.lower_bound, timeseries.upper_bound, timeseries.created_by, timeseries.tags, timeseries.retention, timeseries.expression, timeseries.kind, timeseries.rollover
+FROM timeseries, usr
+WHERE (
+	strpos(lower(timeseries.name), lower($2::TEXT)) > 0
+	OR EXISTS (
+		SELECT 1
+		FROM unnest(timeseries.tags) AS tag
+		WHERE strpos(lower(tag), lower($2::TEXT)) > 0
+	)
+)
+AND user_has_access(usr.uuid, 'read', 'timeseries/'||timeseries.uuid||'/data')
+ORDER BY timeseries.name, timeseries.uuid
+LIMIT $3::BIGINT
+`
+
+type SearchTimeseriesParams struct {
+	Token    []byte
+	Text     string
+	ArgLimit int64
+}
+
+func (q *Queries) SearchTimeseries(ctx context.Context, arg SearchTimeseriesParams) ([]Timeseries, error) {
+	rows, err := q.query(ctx, q.searchTimeseriesStmt, searchTimeseries, arg.Token, arg.Text, arg.ArgLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Timeseries{}
+	for rows.Next() {
+		var i Timeseries
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.ThingUuid,
+			&i.Name,
+			&i.SiUnit,
+			&i.LowerBound,
+			&i.UpperBound,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Retention,
+			&i.Expression,
+			&i.Kind,
+			&i.Rollover,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setTimeseriesExpression = `-- name: SetTimeseriesExpression :execrows
+UPDATE timeseries
+SET expression = $1
+WHERE timeseries.uuid = $2
+`
+
+type SetTimeseriesExpressionParams struct {
+	Expression sql.NullString
+	Uuid       uuid.UUID
+}
+
+func (q *Queries) SetTimeseriesExpression(ctx context.Context, arg SetTimeseriesExpressionParams) (int64, error) {
+	result, err := q.exec(ctx, q.setTimeseriesExpressionStmt, setTimeseriesExpression, arg.Expression, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setTimeseriesKind = `-- name: SetTimeseriesKind :execrows
+UPDATE timeseries
+SET kind = $1,
+	rollover = $2
+WHERE timeseries.uuid = $3
+`
+
+type SetTimeseriesKindParams struct {
+	Kind     string
+	Rollover sql.NullFloat64
+	Uuid     uuid.UUID
+}
+
+func (q *Queries) SetTimeseriesKind(ctx context.Context, arg SetTimeseriesKindParams) (int64, error) {
+	result, err := q.exec(ctx, q.setTimeseriesKindStmt, setTimeseriesKind, arg.Kind, arg.Rollover, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setTimeseriesLowerBound = `-- name: SetTimeseriesLowerBound :execrows
 UPDATE timeseries
 SET lower_bound = $1
@@ -405,6 +845,25 @@ func (q *Queries) SetTimeseriesName(ctx context.Context, arg SetTimeseriesNamePa
 	return result.RowsAffected()
 }
 
+const setTimeseriesRetention = `-- name: SetTimeseriesRetention :execrows
+UPDATE timeseries
+SET retention = $1
+WHERE timeseries.uuid = $2
+`
+
+type SetTimeseriesRetentionParams struct {
+	Retention sql.NullInt64
+	Uuid      uuid.UUID
+}
+
+func (q *Queries) SetTimeseriesRetention(ctx context.Context, arg SetTimeseriesRetentionParams) (int64, error) {
+	result, err := q.exec(ctx, q.setTimeseriesRetentionStmt, setTimeseriesRetention, arg.Retention, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setTimeseriesSiUnit = `-- name: SetTimeseriesSiUnit :execrows
 UPDATE timeseries
 SET si_unit = $1
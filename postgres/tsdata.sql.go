@@ -5,12 +5,33 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+const convertTsDataValues = `-- name: ConvertTsDataValues :execrows
+UPDATE tsdata
+SET value = value * $1::DOUBLE PRECISION + $2::DOUBLE PRECISION
+WHERE ts_uuid = $3
+`
+
+type ConvertTsDataValuesParams struct {
+	Scale  float64
+	Shift  float64
+	TsUuid uuid.UUID
+}
+
+func (q *Queries) ConvertTsDataValues(ctx context.Context, arg ConvertTsDataValuesParams) (int64, error) {
+	result, err := q.exec(ctx, q.convertTsDataValuesStmt, convertTsDataValues, arg.Scale, arg.Shift, arg.TsUuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createTsData = `-- name: CreateTsData :execrows
 INSERT INTO tsdata(ts_uuid, value, ts, created_by)
 VALUES (
@@ -88,30 +109,71 @@ func (q *Queries) DeleteTsDataRange(ctx context.Context, arg DeleteTsDataRangePa
 	return result.RowsAffected()
 }
 
+const disableTsDataNotify = `-- name: DisableTsDataNotify :exec
+-- Turns off the notifications of changed data points until the end of the transaction
+SELECT set_config('selfhost.tsdata_notify', 'off', true)
+`
+
+func (q *Queries) DisableTsDataNotify(ctx context.Context) error {
+	_, err := q.exec(ctx, q.disableTsDataNotifyStmt, disableTsDataNotify)
+	return err
+}
+
+const getTsDataFirstTimestampBefore = `-- name: GetTsDataFirstTimestampBefore :one
+SELECT ts
+FROM tsdata
+WHERE ts_uuid = $1
+AND ts < $2
+ORDER BY ts ASC
+LIMIT 1
+`
+
+type GetTsDataFirstTimestampBeforeParams struct {
+	TsUuid uuid.UUID
+	Before time.Time
+}
+
+func (q *Queries) GetTsDataFirstTimestampBefore(ctx context.Context, arg GetTsDataFirstTimestampBeforeParams) (time.Time, error) {
+	row := q.queryRow(ctx, q.getTsDataFirstTimestampBeforeStmt, getTsDataFirstTimestampBefore, arg.TsUuid, arg.Before)
+	var ts time.Time
+	err := row.Scan(&ts)
+	return ts, err
+}
+
 const getTsDataRange = `-- name: GetTsDataRange :many
 SELECT	ts_uuid,
 	value,
-	ts
+	ts,
+	quality
 FROM tsdata
 WHERE ts_uuid = ANY($1::uuid[])
 AND ts BETWEEN $2 AND $3
+-- Data points of an excluded quality are left out, plain measurements never are
+AND (quality IS NULL OR quality <> ALL(COALESCE($4::SMALLINT[], '{}')))
 ORDER BY ts ASC
 `
 
 type GetTsDataRangeParams struct {
-	TsUuids []uuid.UUID
-	Start   time.Time
-	Stop    time.Time
+	TsUuids        []uuid.UUID
+	Start          time.Time
+	Stop           time.Time
+	ExcludeQuality []int16
 }
 
 type GetTsDataRangeRow struct {
-	TsUuid uuid.UUID
-	Value  float64
-	Ts     time.Time
+	TsUuid  uuid.UUID
+	Value   float64
+	Ts      time.Time
+	Quality sql.NullInt32
 }
 
 func (q *Queries) GetTsDataRange(ctx context.Context, arg GetTsDataRangeParams) ([]GetTsDataRangeRow, error) {
-	rows, err := q.query(ctx, q.getTsDataRangeStmt, getTsDataRange, pq.Array(arg.TsUuids), arg.Start, arg.Stop)
+	rows, err := q.query(ctx, q.getTsDataRangeStmt, getTsDataRange,
+		pq.Array(arg.TsUuids),
+		arg.Start,
+		arg.Stop,
+		pq.Array(arg.ExcludeQuality),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +181,12 @@ func (q *Queries) GetTsDataRange(ctx context.Context, arg GetTsDataRangeParams)
 	items := []GetTsDataRangeRow{}
 	for rows.Next() {
 		var i GetTsDataRangeRow
-		if err := rows.Scan(&i.TsUuid, &i.Value, &i.Ts); err != nil {
+		if err := rows.Scan(
+			&i.TsUuid,
+			&i.Value,
+			&i.Ts,
+			&i.Quality,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -134,67 +201,130 @@ func (q *Queries) GetTsDataRange(ctx context.Context, arg GetTsDataRangeParams)
 }
 
 const getTsDataRangeAgg = `-- name: GetTsDataRangeAgg :many
-WITH tsdata_trunc AS (
+WITH tsdata_points AS (
+	SELECT
+	points.ts_uuid,
+	points.value,
+	points.quality,
+	points.ts AS ts_raw,
+	tsdata_bucket(points.ts, $2::int, $3::bigint, $4::timestamptz, $5::text) AS ts,
+	counters.ts_uuid IS NOT NULL AS counter,
+	counters.rollover
+	FROM tsdata AS points
+	-- Time series of the kind counter, with 0 for no rollover value
+	LEFT JOIN unnest($6::uuid[], $7::DOUBLE PRECISION[]) AS counters(ts_uuid, rollover)
+	ON counters.ts_uuid = points.ts_uuid
+	WHERE points.ts_uuid = ANY($8::uuid[])
+	AND points.ts BETWEEN $9::timestamptz AND $10::timestamptz
+	-- Data points of an excluded quality are left out, plain measurements never are
+	AND (points.quality IS NULL OR points.quality <> ALL(COALESCE($11::SMALLINT[], '{}')))
+), tsdata_trunc AS (
 	SELECT
-       	ts_uuid,
-       	value,
-	CASE
-		WHEN $2::text = 'minute5' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 5 * interval '5 min') AT time zone $3::text
-		WHEN $2::text = 'minute10' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 10 * interval '10 min') AT time zone $3::text
-		WHEN $2::text = 'minute15' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 15 * interval '15 min') AT time zone $3::text
-		WHEN $2::text = 'minute20' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 20 * interval '20 min') AT time zone $3::text
-		WHEN $2::text = 'minute30' THEN
-		  (date_trunc('hour', ts) + date_part('minute', ts)::int / 30 * interval '30 min') AT time zone $3::text
-		ELSE
-		  date_trunc($2::text, ts AT time zone $3::text) AT time zone $3::text
-	END AS ts
-	FROM tsdata
-	WHERE ts_uuid = ANY($4::uuid[])
-	AND ts BETWEEN ($5::timestamptz AT time zone $3::text)
-		AND ($6::timestamptz AT time zone $3::text)
+	ts_uuid,
+	value,
+	quality,
+	ts_raw,
+	ts,
+	counter,
+	-- Increase of a counter since the data point before, compensated for resets and rollovers
+	(CASE WHEN counter THEN tsdata_counter_increase(lag(value) OVER w, value, NULLIF(rollover, 0)) END) AS increase,
+	-- The data point before is in the same bucket
+	COALESCE(lag(ts) OVER w = ts, false) AS continued
+	FROM tsdata_points
+	WINDOW w AS (PARTITION BY ts_uuid ORDER BY ts_raw)
+), tsdata_agg AS (
+	-- Every aggregate in the select list is computed, FILTER keeps the costly ones idle unless requested
+	SELECT
+		ts_uuid,
+		(CASE
+			WHEN $1::text = 'avg'::text THEN AVG(value)
+			WHEN $1::text = 'min'::text THEN MIN(value)
+			WHEN $1::text = 'max'::text THEN MAX(value)
+			WHEN $1::text = 'count'::text THEN COUNT(value)
+			-- The sum of a counter is its increase since the data point before the bucket
+			WHEN $1::text = 'sum'::text AND counter THEN SUM(increase)
+			WHEN $1::text = 'sum'::text THEN SUM(value)
+			WHEN $1::text = 'first'::text THEN
+				(array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE $1::text = 'first'::text))[1]
+			WHEN $1::text = 'last'::text THEN
+				(array_agg(value ORDER BY ts_raw DESC) FILTER (WHERE $1::text = 'last'::text))[1]
+			-- The delta and rate of a counter are its increase from the first to the last data point of the bucket
+			WHEN $1::text = 'delta'::text AND counter THEN
+				COALESCE(SUM(increase) FILTER (WHERE continued), 0)
+			WHEN $1::text = 'delta'::text THEN
+				(array_agg(value ORDER BY ts_raw DESC) FILTER (WHERE $1::text IN ('delta', 'rate')))[1]
+				- (array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE $1::text IN ('delta', 'rate')))[1]
+			WHEN $1::text = 'rate'::text AND counter THEN
+				COALESCE(SUM(increase) FILTER (WHERE continued), 0)
+				/ NULLIF(EXTRACT(EPOCH FROM MAX(ts_raw) - MIN(ts_raw)), 0)
+			WHEN $1::text = 'rate'::text THEN
+				((array_agg(value ORDER BY ts_raw DESC) FILTER (WHERE $1::text IN ('delta', 'rate')))[1]
+				- (array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE $1::text IN ('delta', 'rate')))[1])
+				/ NULLIF(EXTRACT(EPOCH FROM MAX(ts_raw) - MIN(ts_raw)), 0)
+			WHEN $1::text = 'integral'::text THEN
+				tsdata_integral(
+					array_agg(value ORDER BY ts_raw ASC) FILTER (WHERE $1::text = 'integral'::text),
+					array_agg(ts_raw ORDER BY ts_raw ASC) FILTER (WHERE $1::text = 'integral'::text)
+				) / 3600
+			WHEN $1::text = 'median'::text THEN
+				percentile_cont(0.5) WITHIN GROUP (ORDER BY value) FILTER (WHERE $1::text = 'median'::text)
+			WHEN $1::text = 'p95'::text THEN
+				percentile_cont(0.95) WITHIN GROUP (ORDER BY value) FILTER (WHERE $1::text = 'p95'::text)
+			WHEN $1::text = 'p99'::text THEN
+				percentile_cont(0.99) WITHIN GROUP (ORDER BY value) FILTER (WHERE $1::text = 'p99'::text)
+			WHEN $1::text = 'stddev'::text THEN STDDEV_SAMP(value)
+		END)::DOUBLE PRECISION AS value,
+		-- The most severe quality of the bucket
+		MAX(quality) AS quality,
+		ts
+	FROM tsdata_trunc
+	GROUP BY ts_uuid, ts, counter
 )
 SELECT
         ts_uuid::uuid,
-	(CASE
-		WHEN $1::text = 'avg'::text THEN AVG(value)
-		WHEN $1::text = 'min'::text THEN MIN(value)
-		WHEN $1::text = 'max'::text THEN MAX(value)
-		WHEN $1::text = 'count'::text THEN COUNT(value)
-		WHEN $1::text = 'sum'::text THEN SUM(value)
-	END)::DOUBLE PRECISION AS value,
-        ts::timestamptz
-FROM tsdata_trunc
-GROUP BY ts_uuid, ts
+	value::DOUBLE PRECISION,
+        ts::timestamptz,
+	quality
+FROM tsdata_agg
+-- Skip buckets where the aggregate is undefined, such as rate or stddev of a single value
+WHERE value IS NOT NULL
 ORDER BY ts ASC
 `
 
 type GetTsDataRangeAggParams struct {
-	Aggregate string
-	Truncate  string
-	Timezone  string
-	TsUuids   []uuid.UUID
-	Start     time.Time
-	Stop      time.Time
+	Aggregate        string
+	BucketMonths     int32
+	BucketWidth      int64
+	Origin           time.Time
+	Timezone         string
+	CounterUuids     []uuid.UUID
+	CounterRollovers []float64
+	TsUuids          []uuid.UUID
+	Start            time.Time
+	Stop             time.Time
+	ExcludeQuality   []int16
 }
 
 type GetTsDataRangeAggRow struct {
-	TsUuid uuid.UUID
-	Value  float64
-	Ts     time.Time
+	TsUuid  uuid.UUID
+	Value   float64
+	Ts      time.Time
+	Quality sql.NullInt32
 }
 
 func (q *Queries) GetTsDataRangeAgg(ctx context.Context, arg GetTsDataRangeAggParams) ([]GetTsDataRangeAggRow, error) {
 	rows, err := q.query(ctx, q.getTsDataRangeAggStmt, getTsDataRangeAgg,
 		arg.Aggregate,
-		arg.Truncate,
+		arg.BucketMonths,
+		arg.BucketWidth,
+		arg.Origin,
 		arg.Timezone,
+		pq.Array(arg.CounterUuids),
+		pq.Array(arg.CounterRollovers),
 		pq.Array(arg.TsUuids),
 		arg.Start,
 		arg.Stop,
+		pq.Array(arg.ExcludeQuality),
 	)
 	if err != nil {
 		return nil, err
@@ -203,7 +333,12 @@ func (q *Queries) GetTsDataRangeAgg(ctx context.Context, arg GetTsDataRangeAggPa
 	items := []GetTsDataRangeAggRow{}
 	for rows.Next() {
 		var i GetTsDataRangeAggRow
-		if err := rows.Scan(&i.TsUuid, &i.Value, &i.Ts); err != nil {
+		if err := rows.Scan(
+			&i.TsUuid,
+			&i.Value,
+			&i.Ts,
+			&i.Quality,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -216,3 +351,24 @@ func (q *Queries) GetTsDataRangeAgg(ctx context.Context, arg GetTsDataRangeAggPa
 	}
 	return items, nil
 }
+
+const getTsDataValueBefore = `-- name: GetTsDataValueBefore :one
+SELECT value
+FROM tsdata
+WHERE ts_uuid = $1
+AND ts < $2
+ORDER BY ts DESC
+LIMIT 1
+`
+
+type GetTsDataValueBeforeParams struct {
+	TsUuid uuid.UUID
+	Before time.Time
+}
+
+func (q *Queries) GetTsDataValueBefore(ctx context.Context, arg GetTsDataValueBeforeParams) (float64, error) {
+	row := q.queryRow(ctx, q.getTsDataValueBeforeStmt, getTsDataValueBefore, arg.TsUuid, arg.Before)
+	var value float64
+	err := row.Scan(&value)
+	return value, err
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// ForEachTsDataRange executes the GetTsDataRange query and calls fn for each row,
+// in timestamp order, without collecting the result in memory.
+func (q *Queries) ForEachTsDataRange(ctx context.Context, arg GetTsDataRangeParams, fn func(GetTsDataRangeRow) error) error {
+	rows, err := q.query(ctx, q.getTsDataRangeStmt, getTsDataRange,
+		pq.Array(arg.TsUuids),
+		arg.Start,
+		arg.Stop,
+		pq.Array(arg.ExcludeQuality),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i GetTsDataRangeRow
+		if err := rows.Scan(
+			&i.TsUuid,
+			&i.Value,
+			&i.Ts,
+			&i.Quality,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+// ForEachTsDataRangeAgg executes the GetTsDataRangeAgg query and calls fn for each row,
+// in timestamp order, without collecting the result in memory.
+func (q *Queries) ForEachTsDataRangeAgg(ctx context.Context, arg GetTsDataRangeAggParams, fn func(GetTsDataRangeAggRow) error) error {
+	rows, err := q.query(ctx, q.getTsDataRangeAggStmt, getTsDataRangeAgg,
+		arg.Aggregate,
+		arg.BucketMonths,
+		arg.BucketWidth,
+		arg.Origin,
+		arg.Timezone,
+		pq.Array(arg.CounterUuids),
+		pq.Array(arg.CounterRollovers),
+		pq.Array(arg.TsUuids),
+		arg.Start,
+		arg.Stop,
+		pq.Array(arg.ExcludeQuality),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i GetTsDataRangeAggRow
+		if err := rows.Scan(
+			&i.TsUuid,
+			&i.Value,
+			&i.Ts,
+			&i.Quality,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+// ForEachTsDataRangeAggRollup executes the GetTsDataRangeAggRollup query and calls fn for each row,
+// in timestamp order, without collecting the result in memory.
+func (q *Queries) ForEachTsDataRangeAggRollup(ctx context.Context, arg GetTsDataRangeAggRollupParams, fn func(GetTsDataRangeAggRollupRow) error) error {
+	rows, err := q.query(ctx, q.getTsDataRangeAggRollupStmt, getTsDataRangeAggRollup,
+		arg.RollupWidth,
+		pq.Array(arg.TsUuids),
+		arg.RollupStart,
+		arg.RollupStop,
+		arg.Start,
+		arg.Stop,
+		arg.BucketMonths,
+		arg.BucketWidth,
+		arg.Origin,
+		arg.Timezone,
+		arg.Aggregate,
+		pq.Array(arg.ArchivedUuids),
+		pq.Array(arg.ArchivedTs),
+		pq.Array(arg.ArchivedCount),
+		pq.Array(arg.ArchivedSum),
+		pq.Array(arg.ArchivedMin),
+		pq.Array(arg.ArchivedMax),
+		pq.Array(arg.ArchivedQuality),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i GetTsDataRangeAggRollupRow
+		if err := rows.Scan(
+			&i.TsUuid,
+			&i.Value,
+			&i.Ts,
+			&i.Quality,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}